@@ -0,0 +1,49 @@
+//go:build (all || data_sources || data_auditstream) && (!exclude_data_sources || !exclude_data_auditstream)
+// +build all data_sources data_auditstream
+// +build !exclude_data_sources !exclude_data_auditstream
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataSourceAuditStream_basic(t *testing.T) {
+	tfNode := "data.azuredevops_auditstream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_SPLUNK_URL", "AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"})
+		},
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataSourceAuditStreamBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_auditstream_splunk.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "consumer_type", "Splunk"),
+					resource.TestCheckResourceAttr(tfNode, "status", "enabled"),
+					resource.TestCheckResourceAttr(tfNode, "consumer_inputs.SplunkUrl", os.Getenv("AZDO_TEST_SPLUNK_URL")),
+					resource.TestCheckNoResourceAttr(tfNode, "consumer_inputs.SplunkEventCollectorToken"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataSourceAuditStreamBasic() string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_splunk" "test" {
+  url                   = "%s"
+  event_collector_token = "%s"
+}
+
+data "azuredevops_auditstream" "test" {
+  stream_id = azuredevops_auditstream_splunk.test.id
+}`, os.Getenv("AZDO_TEST_SPLUNK_URL"), os.Getenv("AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"))
+}
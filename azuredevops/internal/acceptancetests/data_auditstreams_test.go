@@ -0,0 +1,69 @@
+//go:build (all || data_sources || data_auditstreams) && (!exclude_data_sources || !exclude_data_auditstreams)
+// +build all data_sources data_auditstreams
+// +build !exclude_data_sources !exclude_data_auditstreams
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataSourceAuditStreams_basic(t *testing.T) {
+	tfNode := "data.azuredevops_auditstreams.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_SPLUNK_URL", "AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"})
+		},
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataSourceAuditStreamsBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					checkAuditStreamsContainsSplunkStream(tfNode, "azuredevops_auditstream_splunk.test"),
+				),
+			},
+		},
+	})
+}
+
+func checkAuditStreamsContainsSplunkStream(tfNode string, streamNode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		streams := s.RootModule().Resources[tfNode].Primary.Attributes
+		streamID := s.RootModule().Resources[streamNode].Primary.ID
+
+		count, err := strconv.Atoi(streams["streams.#"])
+		if err != nil {
+			return err
+		}
+		for i := 0; i < count; i++ {
+			if streams[fmt.Sprintf("streams.%d.id", i)] == streamID {
+				if consumerType := streams[fmt.Sprintf("streams.%d.consumer_type", i)]; consumerType != "Splunk" {
+					return fmt.Errorf("Audit stream %s has ConsumerType=%s, but expected ConsumerType=Splunk", streamID, consumerType)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("Audit stream %s not found in %s", streamID, tfNode)
+	}
+}
+
+func hclDataSourceAuditStreamsBasic() string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_splunk" "test" {
+  url                   = "%s"
+  event_collector_token = "%s"
+}
+
+data "azuredevops_auditstreams" "test" {
+  depends_on = [azuredevops_auditstream_splunk.test]
+}`, os.Getenv("AZDO_TEST_SPLUNK_URL"), os.Getenv("AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"))
+}
@@ -28,6 +28,10 @@ func TestAccBuildDefinition_DataSource(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(tfNode, "name", buildDefinitionName),
 					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_queue_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_pool_id"),
+					resource.TestCheckResourceAttr(tfNode, "repository.0.yml_path", "azure-pipelines.yml"),
+					resource.TestCheckResourceAttr(tfNode, "variable_groups.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,57 @@
+//go:build (all || data_sources || data_deleted_feeds) && (!exclude_data_sources || !exclude_data_deleted_feeds)
+// +build all data_sources data_deleted_feeds
+// +build !exclude_data_sources !exclude_data_deleted_feeds
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDeletedFeeds_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_deleted_feeds.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeletedFeedResource(projectName, feedName),
+			},
+			{
+				Config: hclDataSourceDeletedFeeds(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "feeds.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "feeds.0.name", feedName),
+					resource.TestCheckResourceAttrSet(tfNode, "feeds.0.deleted_date"),
+				),
+			},
+		},
+	})
+}
+
+// the feed is kept in the recycle bin when it is destroyed, which is then left behind with the project
+func hclDeletedFeedResource(projectName string, feedName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_feed" "test" {
+  name       = "%s"
+  project_id = azuredevops_project.project.id
+}`, testutils.HclProjectResource(projectName), feedName)
+}
+
+func hclDataSourceDeletedFeeds(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_deleted_feeds" "test" {
+  project_id = azuredevops_project.project.id
+}`, testutils.HclProjectResource(projectName))
+}
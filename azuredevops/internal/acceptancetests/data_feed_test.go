@@ -0,0 +1,75 @@
+//go:build (all || data_sources || data_feed) && (!exclude_data_sources || !exclude_data_feed)
+// +build all data_sources data_feed
+// +build !exclude_data_sources !exclude_data_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeed_DataSource_ByName(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_feed.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataSourceFeedByName(projectName, feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "feed_id", "azuredevops_feed.test", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "project_id", "azuredevops_project.project", "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", feedName),
+					resource.TestCheckResourceAttrSet(tfNode, "url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFeed_DataSource_ById(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_feed.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataSourceFeedById(feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", feedName),
+					resource.TestCheckResourceAttr(tfNode, "project_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func hclDataSourceFeedByName(projectName string, feedName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_feed" "test" {
+  name       = azuredevops_feed.test.name
+  project_id = azuredevops_project.project.id
+}`, testutils.HclFeedResource(projectName, feedName))
+}
+
+func hclDataSourceFeedById(feedName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_feed" "test" {
+  feed_id = azuredevops_feed.test.id
+}`, testutils.HclFeedResource("", feedName))
+}
@@ -0,0 +1,46 @@
+//go:build (all || data_sources || data_feeds) && (!exclude_data_sources || !exclude_data_feeds)
+// +build all data_sources data_feeds
+// +build !exclude_data_sources !exclude_data_feeds
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeeds_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_feeds.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataSourceFeeds(projectName, feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "feeds.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "feeds.0.id", "azuredevops_feed.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "feeds.0.name", feedName),
+					resource.TestCheckResourceAttrSet(tfNode, "feeds.0.url"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataSourceFeeds(projectName string, feedName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_feeds" "test" {
+  project_id  = azuredevops_project.project.id
+  name_prefix = azuredevops_feed.test.name
+}`, testutils.HclFeedResource(projectName, feedName))
+}
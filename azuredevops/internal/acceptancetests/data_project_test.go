@@ -59,6 +59,8 @@ func TestAccProject_DataSource(t *testing.T) {
 							resource.TestCheckResourceAttr(tfNode, "version_control", "Git"),
 							resource.TestCheckResourceAttr(tfNode, "visibility", "private"),
 							resource.TestCheckResourceAttr(tfNode, "work_item_template", "Agile"),
+							resource.TestCheckResourceAttrSet(tfNode, "default_team_id"),
+							resource.TestCheckResourceAttrSet(tfNode, "url"),
 						),
 					},
 				},
@@ -0,0 +1,59 @@
+//go:build (all || resource_auditstream_azuremonitorlogs) && !exclude_auditstreams
+// +build all resource_auditstream_azuremonitorlogs
+// +build !exclude_auditstreams
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAuditStreamAzureMonitorLogs_basic(t *testing.T) {
+	resourceType := "azuredevops_auditstream_azuremonitorlogs"
+	tfNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_LOG_ANALYTICS_WORKSPACE_ID", "AZDO_TEST_LOG_ANALYTICS_SHARED_KEY"})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckAuditStreamDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAuditStreamAzureMonitorLogsResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "AzureMonitorLogs"),
+					resource.TestCheckResourceAttr(tfNode, "workspace_id", os.Getenv("AZDO_TEST_LOG_ANALYTICS_WORKSPACE_ID")),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "display_name"),
+				),
+			},
+			{
+				Config: hclAuditStreamAzureMonitorLogsResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "AzureMonitorLogs"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_key", "days_to_backfill", "skip_backfill_wait"},
+			},
+		},
+	})
+}
+
+func hclAuditStreamAzureMonitorLogsResource(enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_azuremonitorlogs" "test" {
+  workspace_id = "%s"
+  shared_key   = "%s"
+  enabled      = %t
+}`, os.Getenv("AZDO_TEST_LOG_ANALYTICS_WORKSPACE_ID"), os.Getenv("AZDO_TEST_LOG_ANALYTICS_SHARED_KEY"), enabled)
+}
@@ -0,0 +1,58 @@
+//go:build (all || resource_auditstream_datadog) && !exclude_auditstreams
+// +build all resource_auditstream_datadog
+// +build !exclude_auditstreams
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAuditStreamDatadog_basic(t *testing.T) {
+	resourceType := "azuredevops_auditstream_datadog"
+	tfNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_DATADOG_API_KEY"})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckAuditStreamDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAuditStreamDatadogResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "Datadog"),
+					resource.TestCheckResourceAttr(tfNode, "site", "datadoghq.com"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "display_name"),
+				),
+			},
+			{
+				Config: hclAuditStreamDatadogResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "Datadog"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "days_to_backfill", "skip_backfill_wait"},
+			},
+		},
+	})
+}
+
+func hclAuditStreamDatadogResource(enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_datadog" "test" {
+  api_key = "%s"
+  enabled = %t
+}`, os.Getenv("AZDO_TEST_DATADOG_API_KEY"), enabled)
+}
@@ -0,0 +1,59 @@
+//go:build (all || resource_auditstream_eventgrid) && !exclude_auditstreams
+// +build all resource_auditstream_eventgrid
+// +build !exclude_auditstreams
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAuditStreamEventGrid_basic(t *testing.T) {
+	resourceType := "azuredevops_auditstream_eventgrid"
+	tfNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_EVENT_GRID_TOPIC_URL", "AZDO_TEST_EVENT_GRID_ACCESS_KEY"})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckAuditStreamDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAuditStreamEventGridResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "AzureEventGrid"),
+					resource.TestCheckResourceAttr(tfNode, "topic_url", os.Getenv("AZDO_TEST_EVENT_GRID_TOPIC_URL")),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "display_name"),
+				),
+			},
+			{
+				Config: hclAuditStreamEventGridResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "AzureEventGrid"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "days_to_backfill", "skip_backfill_wait"},
+			},
+		},
+	})
+}
+
+func hclAuditStreamEventGridResource(enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_eventgrid" "test" {
+  topic_url  = "%s"
+  access_key = "%s"
+  enabled    = %t
+}`, os.Getenv("AZDO_TEST_EVENT_GRID_TOPIC_URL"), os.Getenv("AZDO_TEST_EVENT_GRID_ACCESS_KEY"), enabled)
+}
@@ -0,0 +1,72 @@
+//go:build (all || resource_auditstream_splunk) && !exclude_auditstreams
+// +build all resource_auditstream_splunk
+// +build !exclude_auditstreams
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAuditStreamSplunk_basic(t *testing.T) {
+	resourceType := "azuredevops_auditstream_splunk"
+	tfNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_SPLUNK_URL", "AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckAuditStreamDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAuditStreamSplunkResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "Splunk"),
+					resource.TestCheckResourceAttr(tfNode, "url", os.Getenv("AZDO_TEST_SPLUNK_URL")),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "display_name"),
+				),
+			},
+			{
+				Config: hclAuditStreamSplunkResourceWithIndex(false),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckAuditStreamExists(tfNode, "Splunk"),
+					resource.TestCheckResourceAttr(tfNode, "enabled", "false"),
+					resource.TestCheckResourceAttr(tfNode, "index", "azure_devops"),
+					resource.TestCheckResourceAttr(tfNode, "sourcetype", "azure:devops:audit"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"event_collector_token", "days_to_backfill", "skip_backfill_wait"},
+			},
+		},
+	})
+}
+
+func hclAuditStreamSplunkResource(enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_splunk" "test" {
+  url                   = "%s"
+  event_collector_token = "%s"
+  enabled               = %t
+}`, os.Getenv("AZDO_TEST_SPLUNK_URL"), os.Getenv("AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"), enabled)
+}
+
+func hclAuditStreamSplunkResourceWithIndex(enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_auditstream_splunk" "test" {
+  url                   = "%s"
+  event_collector_token = "%s"
+  index                 = "azure_devops"
+  sourcetype            = "azure:devops:audit"
+  enabled               = %t
+}`, os.Getenv("AZDO_TEST_SPLUNK_URL"), os.Getenv("AZDO_TEST_SPLUNK_EVENT_COLLECTOR_TOKEN"), enabled)
+}
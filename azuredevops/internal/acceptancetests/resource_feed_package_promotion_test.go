@@ -0,0 +1,49 @@
+//go:build (all || resource_feed_package_promotion) && !exclude_feed
+// +build all resource_feed_package_promotion
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// The package version cannot be published with Terraform, so the test promotes
+// an existing NuGet package version of an Organization scoped feed.
+func TestAccFeedPackagePromotion_nuget(t *testing.T) {
+	tfNode := "azuredevops_feed_package_promotion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_FEED_ID", "AZDO_TEST_NUGET_PACKAGE_NAME", "AZDO_TEST_NUGET_PACKAGE_VERSION"})
+		},
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPackagePromotionResource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "feed_id", os.Getenv("AZDO_TEST_FEED_ID")),
+					resource.TestCheckResourceAttr(tfNode, "package_name", os.Getenv("AZDO_TEST_NUGET_PACKAGE_NAME")),
+					resource.TestCheckResourceAttr(tfNode, "view", "Release"),
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedPackagePromotionResource() string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed_package_promotion" "test" {
+  feed_id         = "%s"
+  protocol        = "nuget"
+  package_name    = "%s"
+  package_version = "%s"
+  view            = "Release"
+}`, os.Getenv("AZDO_TEST_FEED_ID"), os.Getenv("AZDO_TEST_NUGET_PACKAGE_NAME"), os.Getenv("AZDO_TEST_NUGET_PACKAGE_VERSION"))
+}
@@ -0,0 +1,114 @@
+//go:build (all || resource_feed_permission) && !exclude_feed
+// +build all resource_feed_permission
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeedPermission_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+	groupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPermissionResource(projectName, feedName, groupName, "reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role", "reader"),
+					resource.TestCheckResourceAttrSet(tfNode, "identity_id"),
+				),
+			},
+			{
+				Config: hclFeedPermissionResource(projectName, feedName, groupName, "contributor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role", "contributor"),
+				),
+			},
+			{
+				Config: hclFeedPermissionResource(projectName, feedName, groupName, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role", "none"),
+				),
+			},
+			{
+				Config: hclFeedPermissionResource(projectName, feedName, groupName, "contributor"),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name"},
+			},
+		},
+	})
+}
+
+func TestAccFeedPermission_principalName(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+	groupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPermissionResourceByPrincipalName(projectName, feedName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role", "reader"),
+					resource.TestCheckResourceAttrSet(tfNode, "identity_descriptor"),
+					resource.TestCheckResourceAttrSet(tfNode, "identity_id"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedPermissionResource(projectName string, feedName string, groupName string, role string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_group" "test" {
+  scope        = azuredevops_project.project.id
+  display_name = "%s"
+}
+
+resource "azuredevops_feed_permission" "test" {
+  feed_id             = azuredevops_feed.test.id
+  project_id          = azuredevops_project.project.id
+  identity_descriptor = azuredevops_group.test.descriptor
+  role                = "%s"
+}`, testutils.HclFeedResource(projectName, feedName), groupName, role)
+}
+
+func hclFeedPermissionResourceByPrincipalName(projectName string, feedName string, groupName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_group" "test" {
+  scope        = azuredevops_project.project.id
+  display_name = "%s"
+}
+
+resource "azuredevops_feed_permission" "test" {
+  feed_id        = azuredevops_feed.test.id
+  project_id     = azuredevops_project.project.id
+  principal_name = azuredevops_group.test.principal_name
+  role           = "reader"
+}`, testutils.HclFeedResource(projectName, feedName), groupName)
+}
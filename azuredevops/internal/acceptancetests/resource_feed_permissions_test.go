@@ -0,0 +1,81 @@
+//go:build (all || resource_feed_permissions) && !exclude_feed
+// +build all resource_feed_permissions
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeedPermissions_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+	groupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPermissionsResource(projectName, feedName, groupName, "reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permission.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "permission.*", map[string]string{"role": "reader"}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "permission.*", map[string]string{"role": "administrator"}),
+				),
+			},
+			{
+				Config: hclFeedPermissionsResource(projectName, feedName, groupName, "contributor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permission.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "permission.*", map[string]string{"role": "contributor"}),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify: true,
+				// without a configuration, the identity descriptors are imported as returned by the API
+				ImportStateVerifyIgnore: []string{"permission"},
+			},
+		},
+	})
+}
+
+func hclFeedPermissionsResource(projectName string, feedName string, groupName string, role string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_group" "test" {
+  scope        = azuredevops_project.project.id
+  display_name = "%s"
+}
+
+data "azuredevops_group" "administrators" {
+  project_id = azuredevops_project.project.id
+  name       = "Project Administrators"
+}
+
+resource "azuredevops_feed_permissions" "test" {
+  feed_id    = azuredevops_feed.test.id
+  project_id = azuredevops_project.project.id
+
+  permission {
+    identity_descriptor = azuredevops_group.test.descriptor
+    role                = "%s"
+  }
+
+  permission {
+    identity_descriptor = data.azuredevops_group.administrators.descriptor
+    role                = "administrator"
+  }
+}`, testutils.HclFeedResource(projectName, feedName), groupName, role)
+}
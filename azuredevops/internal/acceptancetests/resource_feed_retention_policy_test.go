@@ -0,0 +1,57 @@
+//go:build (all || resource_feed_retention_policy) && !exclude_feed
+// +build all resource_feed_retention_policy
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeedRetentionPolicy_basic(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed_retention_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedRetentionPolicyResource(feedName, 20, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "feed_id", "azuredevops_feed.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "count_limit", "20"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_recently_downloaded_packages", "30"),
+				),
+			},
+			{
+				Config: hclFeedRetentionPolicyResource(feedName, 50, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "count_limit", "50"),
+					resource.TestCheckResourceAttr(tfNode, "days_to_keep_recently_downloaded_packages", "60"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclFeedRetentionPolicyResource(feedName string, countLimit int, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_feed_retention_policy" "test" {
+  feed_id                                   = azuredevops_feed.test.id
+  count_limit                               = %d
+  days_to_keep_recently_downloaded_packages = %d
+}`, testutils.HclFeedResource("", feedName), countLimit, days)
+}
@@ -0,0 +1,263 @@
+//go:build (all || resource_feed) && !exclude_feed
+// +build all resource_feed
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeed_organizationScope(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclFeedResource("", feedName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+					resource.TestCheckResourceAttr(tfNode, "name", feedName),
+					resource.TestCheckResourceAttr(tfNode, "project_id", ""),
+					resource.TestCheckResourceAttr(tfNode, "scope", "organization"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"features"},
+			},
+		},
+	})
+}
+
+func TestAccFeed_projectScope(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclFeedResource(projectName, feedName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+					resource.TestCheckResourceAttrPair(tfNode, "project_id", "azuredevops_project.project", "id"),
+					resource.TestCheckResourceAttr(tfNode, "scope", "project"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"features"},
+			},
+		},
+	})
+}
+
+func TestAccFeed_replacedWhenMovedToOrganization(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclFeedResource(projectName, feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "scope", "project"),
+				),
+			},
+			{
+				Config: hclFeedResourceOrganizationScope(projectName, feedName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+					resource.TestCheckResourceAttr(tfNode, "project_id", ""),
+					resource.TestCheckResourceAttr(tfNode, "scope", "organization"),
+				),
+			},
+		},
+	})
+}
+
+// keeps the project of the first step, so only the feed is replaced
+func hclFeedResourceOrganizationScope(projectName string, feedName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_feed" "test" {
+  name = "%s"
+
+  features {
+    permanent_delete = true
+  }
+}`, testutils.HclProjectResource(projectName), feedName)
+}
+
+func TestAccFeed_upstreamSources(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedResourceWithUpstreamSources(feedName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.0.name", "npmjs"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.1.name", "Maven Central"),
+					resource.TestCheckResourceAttrSet(tfNode, "upstream_sources.0.id"),
+				),
+			},
+			{
+				Config: hclFeedResourceWithUpstreamSources(feedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.0.name", "Maven Central"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.1.name", "npmjs"),
+				),
+			},
+			{
+				Config: testutils.HclFeedResource("", feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "upstream_sources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedResourceWithUpstreamSources(feedName string, npmFirst bool) string {
+	npm := `
+  upstream_sources {
+    name     = "npmjs"
+    protocol = "npm"
+    location = "https://registry.npmjs.org/"
+  }`
+	maven := `
+  upstream_sources {
+    name     = "Maven Central"
+    protocol = "maven"
+    location = "https://repo.maven.apache.org/maven2/"
+  }`
+	sources := npm + maven
+	if !npmFirst {
+		sources = maven + npm
+	}
+	return fmt.Sprintf(`
+resource "azuredevops_feed" "test" {
+  name = "%s"
+%s
+
+  features {
+    permanent_delete = true
+  }
+}`, feedName, sources)
+}
+
+func TestAccFeed_update(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	renamedFeedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclFeedResource("", feedName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+					resource.TestCheckResourceAttr(tfNode, "badges_enabled", "false"),
+					resource.TestCheckResourceAttr(tfNode, "hide_deleted_package_versions", "true"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_enabled", "true"),
+				),
+			},
+			{
+				Config: hclFeedResourceWithSettings(renamedFeedName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, renamedFeedName),
+					resource.TestCheckResourceAttr(tfNode, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(tfNode, "badges_enabled", "true"),
+					resource.TestCheckResourceAttr(tfNode, "hide_deleted_package_versions", "false"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedResourceWithSettings(feedName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed" "test" {
+  name                          = "%s"
+  description                   = "Managed by Terraform"
+  badges_enabled                = true
+  hide_deleted_package_versions = false
+  upstream_enabled              = false
+
+  features {
+    permanent_delete = true
+  }
+}`, feedName)
+}
+
+func TestAccFeed_restoreIfDeleted(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedResourceRestoreIfDeleted(feedName, false),
+			},
+			{
+				// destroys the feed, which is kept in the recycle bin
+				Config: "# no resources",
+			},
+			{
+				Config: hclFeedResourceRestoreIfDeleted(feedName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckFeedExists(tfNode, feedName),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedResourceRestoreIfDeleted(feedName string, permanentDelete bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed" "test" {
+  name               = "%s"
+  restore_if_deleted = true
+
+  features {
+    permanent_delete = %t
+  }
+}`, feedName, permanentDelete)
+}
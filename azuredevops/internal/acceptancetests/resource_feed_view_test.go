@@ -0,0 +1,70 @@
+//go:build (all || resource_feed_view) && !exclude_feed
+// +build all resource_feed_view
+// +build !exclude_feed
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeedView_basic(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed_view.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedViewResource(feedName, "Prerelease", "private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "feed_id", "azuredevops_feed.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", "Prerelease"),
+					resource.TestCheckResourceAttr(tfNode, "visibility", "private"),
+					resource.TestCheckResourceAttrSet(tfNode, "url"),
+				),
+			},
+			{
+				Config: hclFeedViewResource(feedName, "Beta", "collection"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", "Beta"),
+					resource.TestCheckResourceAttr(tfNode, "visibility", "collection"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: feedViewImportID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func feedViewImportID(tfNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return "", fmt.Errorf("Did not find a feed view in the TF state")
+		}
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["feed_id"], res.Primary.ID), nil
+	}
+}
+
+func hclFeedViewResource(feedName string, viewName string, visibility string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_feed_view" "test" {
+  feed_id    = azuredevops_feed.test.id
+  name       = "%s"
+  visibility = "%s"
+}`, testutils.HclFeedResource("", feedName), viewName, visibility)
+}
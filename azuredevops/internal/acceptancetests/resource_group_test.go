@@ -20,6 +20,7 @@ import (
 func TestAccGroupResource_CreateAndUpdate(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
 	groupName := testutils.GenerateResourceName()
+	groupNameUpdated := testutils.GenerateResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testutils.PreCheck(t, nil) },
@@ -32,6 +33,15 @@ func TestAccGroupResource_CreateAndUpdate(t *testing.T) {
 					checkGroupExists(groupName),
 					resource.TestCheckResourceAttrSet("azuredevops_group.test", "scope"),
 					resource.TestCheckResourceAttr("azuredevops_group.test", "display_name", groupName),
+					resource.TestCheckResourceAttrSet("azuredevops_group.test", "group_id"),
+				),
+			},
+			{
+				Config: hclGroupWithDescription(projectName, groupNameUpdated, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGroupExists(groupNameUpdated),
+					resource.TestCheckResourceAttr("azuredevops_group.test", "display_name", groupNameUpdated),
+					resource.TestCheckResourceAttr("azuredevops_group.test", "description", "updated description"),
 				),
 			},
 			{
@@ -113,3 +123,21 @@ resource "azuredevops_group" "test" {
 `, projectName, groupName)
 
 }
+
+func hclGroupWithDescription(projectName, groupName, description string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "%[1]s-description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_group" "test" {
+  scope        = azuredevops_project.test.id
+  display_name = "%[2]s"
+  description  = "%[3]s"
+}
+`, projectName, groupName, description)
+}
@@ -0,0 +1,63 @@
+//go:build (all || core || resource_membership_propagation_wait) && !exclude_resource_membership_propagation_wait
+// +build all core resource_membership_propagation_wait
+// +build !exclude_resource_membership_propagation_wait
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccMembershipPropagationWait_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	groupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_membership_propagation_wait.wait"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclMembershipPropagationWait(projectName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "subject_descriptor", "azuredevops_group.member", "descriptor"),
+					resource.TestCheckResourceAttrPair(tfNode, "container_descriptor", "data.azuredevops_group.group", "descriptor"),
+				),
+			},
+		},
+	})
+}
+
+func hclMembershipPropagationWait(projectName string, groupName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "group" {
+  project_id = azuredevops_project.project.id
+  name       = "Build Administrators"
+}
+
+resource "azuredevops_group" "member" {
+  scope        = azuredevops_project.project.id
+  display_name = "%s"
+}
+
+resource "azuredevops_group_membership" "membership" {
+  group   = data.azuredevops_group.group.descriptor
+  members = [azuredevops_group.member.descriptor]
+}
+
+resource "azuredevops_membership_propagation_wait" "wait" {
+  subject_descriptor   = azuredevops_group.member.descriptor
+  container_descriptor = data.azuredevops_group.group.descriptor
+
+  triggers = {
+    membership = azuredevops_group_membership.membership.id
+  }
+}`, testutils.HclProjectResource(projectName), groupName)
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointArgoCD_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_argocd"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointArgoCD_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointArgoCD_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_basic_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_argocd"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointArgoCD_basic_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -61,7 +61,7 @@ func TestAccServiceEndpointArgoCD_basic_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_complete_token(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -73,7 +73,7 @@ func TestAccServiceEndpointArgoCD_complete_token(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointArgoCDResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -88,7 +88,7 @@ func TestAccServiceEndpointArgoCD_complete_token(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_complete_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -100,7 +100,7 @@ func TestAccServiceEndpointArgoCD_complete_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceCompleteUsernamePassword(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointArgoCDResourceCompleteUsernamePassword(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -115,7 +115,7 @@ func TestAccServiceEndpointArgoCD_complete_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -129,14 +129,14 @@ func TestAccServiceEndpointArgoCD_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasic(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasic(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointArgoCDResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointArgoCDResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -151,7 +151,7 @@ func TestAccServiceEndpointArgoCD_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_update_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -165,14 +165,14 @@ func TestAccServiceEndpointArgoCD_update_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointArgoCDResourceUpdateUsernamePassword(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointArgoCDResourceUpdateUsernamePassword(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -187,7 +187,7 @@ func TestAccServiceEndpointArgoCD_update_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_argocd"
 	tfSvcEpNode := resourceType + ".test"
@@ -198,13 +198,13 @@ func TestAccServiceEndpointArgoCD_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointArgoCDResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointArgoCDResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
@@ -212,7 +212,7 @@ func TestAccServiceEndpointArgoCD_RequiresImportErrorStep(t *testing.T) {
 }
 
 func TestAccServiceEndpointArgoCD_RequiresImportErrorStepUsernamePassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_argocd"
 	tfSvcEpNode := resourceType + ".test"
@@ -223,23 +223,23 @@ func TestAccServiceEndpointArgoCD_RequiresImportErrorStepUsernamePassword(t *tes
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointArgoCDResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointArgoCDResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointArgoCDResourceBasic(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceBasic(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	authentication_token {
 		token			   	   = "redacted"
@@ -248,14 +248,14 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	description 		   = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	authentication_basic {
 		username			   = "u"
@@ -265,14 +265,14 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	description 		   = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceCompleteUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceCompleteUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_basic {
@@ -282,14 +282,14 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	url			   		   = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_token {
@@ -298,14 +298,14 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	  url			   		   = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_token {
@@ -314,14 +314,14 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	  url			   		   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceUpdateUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArgoCDResourceUpdateUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_argocd" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_basic {
@@ -331,12 +331,12 @@ resource "azuredevops_serviceendpoint_argocd" "test" {
 	url			   		   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArgoCDResourceRequiresImport(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointArgoCDResourceBasic(projectName, serviceEndpointName, description)
+func hclSvcEndpointArgoCDResourceRequiresImport(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointArgoCDResourceBasic(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_argocd" "import" {
@@ -350,8 +350,8 @@ resource "azuredevops_serviceendpoint_argocd" "import" {
 }
 `, template)
 }
-func hclSvcEndpointArgoCDResourceRequiresImportUsernamePassword(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectName, serviceEndpointName, description)
+func hclSvcEndpointArgoCDResourceRequiresImportUsernamePassword(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointArgoCDResourceBasicUsernamePassword(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_argocd" "import" {
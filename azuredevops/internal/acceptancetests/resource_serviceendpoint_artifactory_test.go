@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointArtifactory_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_artifactory"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointArtifactory_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointArtifactory_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_basic_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_artifactory"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointArtifactory_basic_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -61,7 +61,7 @@ func TestAccServiceEndpointArtifactory_basic_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_complete_token(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -73,7 +73,7 @@ func TestAccServiceEndpointArtifactory_complete_token(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointArtifactoryResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -88,7 +88,7 @@ func TestAccServiceEndpointArtifactory_complete_token(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_complete_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -100,7 +100,7 @@ func TestAccServiceEndpointArtifactory_complete_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceCompleteUsernamePassword(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointArtifactoryResourceCompleteUsernamePassword(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -115,7 +115,7 @@ func TestAccServiceEndpointArtifactory_complete_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -129,14 +129,14 @@ func TestAccServiceEndpointArtifactory_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasic(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasic(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointArtifactoryResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointArtifactoryResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -151,7 +151,7 @@ func TestAccServiceEndpointArtifactory_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_update_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -165,14 +165,14 @@ func TestAccServiceEndpointArtifactory_update_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointArtifactoryResourceUpdateUsernamePassword(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointArtifactoryResourceUpdateUsernamePassword(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -187,7 +187,7 @@ func TestAccServiceEndpointArtifactory_update_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_artifactory"
 	tfSvcEpNode := resourceType + ".test"
@@ -198,13 +198,13 @@ func TestAccServiceEndpointArtifactory_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointArtifactoryResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointArtifactoryResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
@@ -212,7 +212,7 @@ func TestAccServiceEndpointArtifactory_RequiresImportErrorStep(t *testing.T) {
 }
 
 func TestAccServiceEndpointArtifactory_RequiresImportErrorStepUsernamePassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_artifactory"
 	tfSvcEpNode := resourceType + ".test"
@@ -223,23 +223,23 @@ func TestAccServiceEndpointArtifactory_RequiresImportErrorStepUsernamePassword(t
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointArtifactoryResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointArtifactoryResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointArtifactoryResourceBasic(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceBasic(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	authentication_token {
 		token			   	   = "redacted"
@@ -248,14 +248,14 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	description 		   = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	authentication_basic {
 		username			   = "u"
@@ -265,14 +265,14 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	description 		   = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceCompleteUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceCompleteUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_basic {
@@ -282,14 +282,14 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	url			   		   = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_token {
@@ -298,14 +298,14 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	  url			   		   = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_token {
@@ -314,14 +314,14 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	  url			   		   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceUpdateUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointArtifactoryResourceUpdateUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_artifactory" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	authentication_basic {
@@ -331,12 +331,12 @@ resource "azuredevops_serviceendpoint_artifactory" "test" {
 	url			   		   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointArtifactoryResourceRequiresImport(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointArtifactoryResourceBasic(projectName, serviceEndpointName, description)
+func hclSvcEndpointArtifactoryResourceRequiresImport(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointArtifactoryResourceBasic(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_artifactory" "import" {
@@ -350,8 +350,8 @@ resource "azuredevops_serviceendpoint_artifactory" "import" {
 }
 `, template)
 }
-func hclSvcEndpointArtifactoryResourceRequiresImportUsernamePassword(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectName, serviceEndpointName, description)
+func hclSvcEndpointArtifactoryResourceRequiresImportUsernamePassword(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointArtifactoryResourceBasicUsernamePassword(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_artifactory" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointAws_Basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_aws"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointAws_Basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAwsResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointAwsResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,8 +37,32 @@ func TestAccServiceEndpointAws_Basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceEndpointAws_Oidc(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_aws"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointAwsResourceOidc(projectID, serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "use_oidc", "true"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "oidc_audience", "api://AzureADTokenExchange"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "oidc_subject"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceEndpointAws_Complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 	sessionToken := "foobar"
@@ -54,7 +78,7 @@ func TestAccServiceEndpointAws_Complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAwsResourceComplete(projectName, serviceEndpointName, description, sessionToken, rta, rsn, externalId),
+				Config: hclSvcEndpointAwsResourceComplete(projectID, serviceEndpointName, description, sessionToken, rta, rsn, externalId),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -73,7 +97,7 @@ func TestAccServiceEndpointAws_Complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointAws_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -87,14 +111,14 @@ func TestAccServiceEndpointAws_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAwsResource(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointAwsResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointAwsResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointAwsResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -107,7 +131,7 @@ func TestAccServiceEndpointAws_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointAws_requiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_aws"
@@ -118,7 +142,7 @@ func TestAccServiceEndpointAws_requiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAwsResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointAwsResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -126,35 +150,48 @@ func TestAccServiceEndpointAws_requiresImportErrorStep(t *testing.T) {
 				),
 			},
 			{
-				Config:      hclSvcEndpointAwsResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointAwsResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointAwsResource(projectName string, serviceEndpointName string) string {
-	return hclSvcEndpointAwsResourceUpdate(projectName, serviceEndpointName, "description")
+func hclSvcEndpointAwsResource(projectID string, serviceEndpointName string) string {
+	return hclSvcEndpointAwsResourceUpdate(projectID, serviceEndpointName, "description")
 }
 
-func hclSvcEndpointAwsResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointAwsResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 	resource "azuredevops_serviceendpoint_aws" "test" {
-		project_id             = azuredevops_project.project.id
+		project_id             = data.azuredevops_project.project.id
 		access_key_id          = "0000"
 		secret_access_key      = "secretkey"
 		service_endpoint_name  = "%s"
 		description            = "%s"
 	}`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
+
+func hclSvcEndpointAwsResourceOidc(projectID string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+	resource "azuredevops_serviceendpoint_aws" "test" {
+		project_id             = data.azuredevops_project.project.id
+		service_endpoint_name  = "%s"
+		role_to_assume         = "arn:aws:iam::000000000000:role/azure-devops"
+		use_oidc               = true
+	}`, serviceEndpointName)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointAwsResourceComplete(projectName string, serviceEndpointName string, description string, sessionToken string, rta string, rsn string, externalId string) string {
+func hclSvcEndpointAwsResourceComplete(projectID string, serviceEndpointName string, description string, sessionToken string, rta string, rsn string, externalId string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 	resource "azuredevops_serviceendpoint_aws" "test" {
-		project_id             = azuredevops_project.project.id
+		project_id             = data.azuredevops_project.project.id
 		access_key_id          = "0000"
 		secret_access_key      = "secretkey"
 		service_endpoint_name  = "%s"
@@ -166,12 +203,12 @@ func hclSvcEndpointAwsResourceComplete(projectName string, serviceEndpointName s
 		external_id = "%s"
 	}`, serviceEndpointName, description, sessionToken, rta, rsn, externalId)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointAwsResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointAwsResource(projectName, serviceEndpointName)
+func hclSvcEndpointAwsResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointAwsResource(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 	%s
 	resource "azuredevops_serviceendpoint_aws" "import" {
@@ -14,7 +14,7 @@ import (
 // validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
 // underlying terraform state.
 func TestAccServiceEndpointAzureCR_CreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -28,7 +28,7 @@ func TestAccServiceEndpointAzureCR_CreateAndUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointAzureCRResource(projectName, serviceEndpointNameFirst),
+				Config: testutils.HclServiceEndpointAzureCRResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "azurecr_spn_tenantid"),
@@ -39,7 +39,7 @@ func TestAccServiceEndpointAzureCR_CreateAndUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testutils.HclServiceEndpointAzureCRResource(projectName, serviceEndpointNameSecond),
+				Config: testutils.HclServiceEndpointAzureCRResource(projectID, serviceEndpointNameSecond),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "azurecr_spn_tenantid"),
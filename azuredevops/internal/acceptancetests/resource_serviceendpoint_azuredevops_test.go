@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointAzureDevOps_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_azuredevops"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointAzureDevOps_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointAzureDevOps_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointAzureDevOps_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointAzureDevOps_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAzureDevOpsResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointAzureDevOpsResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -63,7 +63,7 @@ func TestAccServiceEndpointAzureDevOps_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointAzureDevOps_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -79,14 +79,14 @@ func TestAccServiceEndpointAzureDevOps_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointAzureDevOpsResourceUpdate(projectName, serviceEndpointNameSecond, orgUrl, releaseApiUrl, description),
+				Config: hclSvcEndpointAzureDevOpsResourceUpdate(projectID, serviceEndpointNameSecond, orgUrl, releaseApiUrl, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -101,7 +101,7 @@ func TestAccServiceEndpointAzureDevOps_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointAzureDevOps_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_azuredevops"
 	tfSvcEpNode := resourceType + ".test"
@@ -112,35 +112,35 @@ func TestAccServiceEndpointAzureDevOps_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointAzureDevOpsResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointAzureDevOpsResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointAzureDevOpsResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointAzureDevOpsResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointAzureDevOpsResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_azuredevops" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	personal_access_token  = "0000000000000000000000000000000000000000000000000000"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointAzureDevOpsResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointAzureDevOpsResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_azuredevops" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	org_url			   	   = "https://dev.azure.com/myorganization"
@@ -148,14 +148,14 @@ resource "azuredevops_serviceendpoint_azuredevops" "test" {
 	personal_access_token  = "0000000000000000000000000000000000000000000000000000"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointAzureDevOpsResourceUpdate(projectName string, serviceEndpointName string, orgUrl string, releaseApiUrl string, description string) string {
+func hclSvcEndpointAzureDevOpsResourceUpdate(projectID string, serviceEndpointName string, orgUrl string, releaseApiUrl string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_azuredevops" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	org_url			   	   = "%s"
@@ -163,12 +163,12 @@ resource "azuredevops_serviceendpoint_azuredevops" "test" {
 	personal_access_token  = "0000000000000000000000000000000000000000000000000000"
 }`, serviceEndpointName, description, orgUrl, releaseApiUrl)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointAzureDevOpsResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointAzureDevOpsResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointAzureDevOpsResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointAzureDevOpsResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_azuredevops" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointBitBucket_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_bitbucket"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointBitBucket_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointBitBucketResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointBitBucketResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointBitBucket_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointBitBucket_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointBitBucket_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointBitBucketResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointBitBucketResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -64,7 +64,7 @@ func TestAccServiceEndpointBitBucket_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointBitBucket_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -78,14 +78,14 @@ func TestAccServiceEndpointBitBucket_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointBitBucketResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointBitBucketResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointBitBucketResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointBitBucketResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -101,7 +101,7 @@ func TestAccServiceEndpointBitBucket_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointBitBucket_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_bitbucket"
 	tfSvcEpNode := resourceType + ".test"
@@ -112,62 +112,62 @@ func TestAccServiceEndpointBitBucket_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointBitBucketResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointBitBucketResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointBitBucketResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointBitBucketResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointBitBucketResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointBitBucketResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_bitbucket" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	username			   = "username"
 	password			   = "password"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointBitBucketResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointBitBucketResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_bitbucket" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	username			   = "username"
 	password			   = "password"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointBitBucketResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointBitBucketResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_bitbucket" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	username			   = "username"
 	password			   = "password"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointBitBucketResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointBitBucketResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointBitBucketResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointBitBucketResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_bitbucket" "import" {
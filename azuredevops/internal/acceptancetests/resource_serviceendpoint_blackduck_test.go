@@ -0,0 +1,88 @@
+//go:build (all || resource_serviceendpoint_blackduck) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_blackduck
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointBlackDuck_basic(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_blackduck"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointBlackDuckResource(projectID, serviceEndpointName, t.Name(), "https://blackduck.contoso.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://blackduck.contoso.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointBlackDuck_update(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointNameFirst := testutils.GenerateResourceName()
+	serviceEndpointNameSecond := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_blackduck"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointBlackDuckResource(projectID, serviceEndpointNameFirst, t.Name(), "https://blackduck.contoso.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			},
+			{
+				Config: hclSvcEndpointBlackDuckResource(projectID, serviceEndpointNameSecond, t.Name(), "https://blackduck2.contoso.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://blackduck2.contoso.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func hclSvcEndpointBlackDuckResource(projectID string, serviceEndpointName string, description string, url string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_blackduck" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  url                   = "%s"
+  api_token             = "redacted"
+}`, serviceEndpointName, description, url)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
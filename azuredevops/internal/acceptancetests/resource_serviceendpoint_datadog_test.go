@@ -0,0 +1,89 @@
+//go:build (all || resource_serviceendpoint_datadog) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_datadog
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointDatadog_basic(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_datadog"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointDatadogResource(projectID, serviceEndpointName, t.Name(), "https://api.datadoghq.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://api.datadoghq.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointDatadog_update(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointNameFirst := testutils.GenerateResourceName()
+	serviceEndpointNameSecond := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_datadog"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointDatadogResource(projectID, serviceEndpointNameFirst, t.Name(), "https://api.datadoghq.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			},
+			{
+				Config: hclSvcEndpointDatadogResource(projectID, serviceEndpointNameSecond, t.Name(), "https://api.datadoghq.eu"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://api.datadoghq.eu"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "app_key"},
+			},
+		},
+	})
+}
+
+func hclSvcEndpointDatadogResource(projectID string, serviceEndpointName string, description string, url string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_datadog" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  url                   = "%s"
+  api_key               = "redacted"
+  app_key               = "redacted"
+}`, serviceEndpointName, description, url)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointExternalTFS_PersonalTokenBasic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_externaltfs"
 	tfSvcEpNode := resourceType + ".serviceendpoint"
@@ -23,7 +23,7 @@ func TestAccServiceEndpointExternalTFS_PersonalTokenBasic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -38,7 +38,7 @@ func TestAccServiceEndpointExternalTFS_PersonalTokenBasic(t *testing.T) {
 }
 
 func TestAccServiceEndpointExternalTFS_PersonalTokenUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	description := "Managed by Terraform"
@@ -50,7 +50,7 @@ func TestAccServiceEndpointExternalTFS_PersonalTokenUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -60,7 +60,7 @@ func TestAccServiceEndpointExternalTFS_PersonalTokenUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: hclSvcEndpointExternalTFSResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointExternalTFSResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -75,7 +75,7 @@ func TestAccServiceEndpointExternalTFS_PersonalTokenUpdate(t *testing.T) {
 }
 
 func TestAccServiceEndpointExternalTFS_CreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_externaltfs"
@@ -86,7 +86,7 @@ func TestAccServiceEndpointExternalTFS_CreateAndUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -97,7 +97,7 @@ func TestAccServiceEndpointExternalTFS_CreateAndUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointNameSecond),
+				Config: hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointNameSecond),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -119,7 +119,7 @@ func TestAccServiceEndpointExternalTFS_CreateAndUpdate(t *testing.T) {
 }
 
 func TestAccServiceEndpointExternalTFS_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_externaltfs"
 	tfSvcEpNode := resourceType + ".serviceendpoint"
@@ -129,24 +129,24 @@ func TestAccServiceEndpointExternalTFS_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointExternalTFSResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointExternalTFSResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointExternalTFSResourceBasic(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func hclSvcEndpointExternalTFSResourceBasic(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_externaltfs" "serviceendpoint" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%[1]s"
   connection_url        = "https://dev.azure.com/myorganization"
   auth_personal {
@@ -156,11 +156,11 @@ resource "azuredevops_serviceendpoint_externaltfs" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointExternalTFSResourceUpdate(projectName string, serviceEndpointName string, description string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func hclSvcEndpointExternalTFSResourceUpdate(projectID string, serviceEndpointName string, description string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_externaltfs" "serviceendpoint" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%[1]s"
   connection_url        = "https://dev.azure.com/myorganization"
   auth_personal {
@@ -171,8 +171,8 @@ resource "azuredevops_serviceendpoint_externaltfs" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointExternalTFSResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointExternalTFSResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointExternalTFSResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointExternalTFSResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_externaltfs" "import" {
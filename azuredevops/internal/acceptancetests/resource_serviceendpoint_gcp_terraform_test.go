@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointGcpTerraform_Basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_gcp_terraform"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointGcpTerraform_Basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGcpTerraformResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGcpTerraformResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -39,7 +39,7 @@ func TestAccServiceEndpointGcpTerraform_Basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointGcpTerraform_Complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 	scope := "scope"
@@ -55,7 +55,7 @@ func TestAccServiceEndpointGcpTerraform_Complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGcpTerraformResourceComplete(projectName, serviceEndpointName, description, clientEmail, scope, tokenUri, projectId),
+				Config: hclSvcEndpointGcpTerraformResourceComplete(projectID, serviceEndpointName, description, clientEmail, scope, tokenUri, projectId),
 
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
@@ -75,7 +75,7 @@ func TestAccServiceEndpointGcpTerraform_Complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointGcpTerraform_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -90,14 +90,14 @@ func TestAccServiceEndpointGcpTerraform_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGcpTerraformResource(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointGcpTerraformResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointGcpTerraformResourceUpdate(projectName, serviceEndpointNameSecond, description, tokenUri),
+				Config: hclSvcEndpointGcpTerraformResourceUpdate(projectID, serviceEndpointNameSecond, description, tokenUri),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -111,7 +111,7 @@ func TestAccServiceEndpointGcpTerraform_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointGcpTerraform_requiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_gcp_terraform"
@@ -122,7 +122,7 @@ func TestAccServiceEndpointGcpTerraform_requiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGcpTerraformResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGcpTerraformResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -130,21 +130,21 @@ func TestAccServiceEndpointGcpTerraform_requiresImportErrorStep(t *testing.T) {
 				),
 			},
 			{
-				Config:      hclSvcEndpointGcpTerraformResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointGcpTerraformResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointGcpTerraformResource(projectName string, serviceEndpointName string) string {
-	return hclSvcEndpointGcpTerraformResourceUpdate(projectName, serviceEndpointName, "description", "tokenUri")
+func hclSvcEndpointGcpTerraformResource(projectID string, serviceEndpointName string) string {
+	return hclSvcEndpointGcpTerraformResourceUpdate(projectID, serviceEndpointName, "description", "tokenUri")
 }
 
-func hclSvcEndpointGcpTerraformResourceUpdate(projectName string, serviceEndpointName string, description string, tokenUri string) string {
+func hclSvcEndpointGcpTerraformResourceUpdate(projectID string, serviceEndpointName string, description string, tokenUri string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_gcp_terraform" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   private_key           = "secretkey"
   token_uri             = "%s"
   service_endpoint_name = "%s"
@@ -152,14 +152,14 @@ resource "azuredevops_serviceendpoint_gcp_terraform" "test" {
   gcp_project_id        = "project_id"
 }`, tokenUri, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGcpTerraformResourceComplete(projectName string, serviceEndpointName string, description string, clientEmail string, scope string, tokenUri string, projectId string) string {
+func hclSvcEndpointGcpTerraformResourceComplete(projectID string, serviceEndpointName string, description string, clientEmail string, scope string, tokenUri string, projectId string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_gcp_terraform" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   private_key           = "secretkey"
   token_uri             = "%s"
   service_endpoint_name = "%s"
@@ -170,12 +170,12 @@ resource "azuredevops_serviceendpoint_gcp_terraform" "test" {
 
 }`, tokenUri, serviceEndpointName, description, clientEmail, scope, projectId)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGcpTerraformResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointGcpTerraformResource(projectName, serviceEndpointName)
+func hclSvcEndpointGcpTerraformResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointGcpTerraformResource(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 	%s
 resource "azuredevops_serviceendpoint_gcp_terraform" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointGenericGit_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_generic_git"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointGenericGit_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericGitResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGenericGitResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -38,7 +38,7 @@ func TestAccServiceEndpointGenericGit_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointGenericGit_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -50,7 +50,7 @@ func TestAccServiceEndpointGenericGit_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericGitResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointGenericGitResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -67,7 +67,7 @@ func TestAccServiceEndpointGenericGit_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointGenericGit_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -81,7 +81,7 @@ func TestAccServiceEndpointGenericGit_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericGitResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointGenericGitResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
@@ -90,7 +90,7 @@ func TestAccServiceEndpointGenericGit_update(t *testing.T) {
 				),
 			},
 			{
-				Config: hclSvcEndpointGenericGitResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointGenericGitResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -107,7 +107,7 @@ func TestAccServiceEndpointGenericGit_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointGenericGit_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_generic_git"
 	tfSvcEpNode := resourceType + ".test"
@@ -118,35 +118,35 @@ func TestAccServiceEndpointGenericGit_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericGitResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGenericGitResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointGenericGitResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointGenericGitResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointGenericGitResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointGenericGitResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic_git" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	repository_url        = "https://dev.azure.com/org/project/_git/repository"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericGitResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointGenericGitResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic_git" "test" {
-	project_id              = azuredevops_project.project.id
+	project_id              = data.azuredevops_project.project.id
 	service_endpoint_name   = "%s"
 	description             = "%s"
 	repository_url          = "https://dev.azure.com/org/project/_git/repository"
@@ -155,14 +155,14 @@ resource "azuredevops_serviceendpoint_generic_git" "test" {
 	enable_pipelines_access = true
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericGitResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointGenericGitResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic_git" "test" {
-	project_id              = azuredevops_project.project.id
+	project_id              = data.azuredevops_project.project.id
 	service_endpoint_name   = "%s"
 	description             = "%s"
 	repository_url          = "https://dev.azure.com/org/project/_git/repository"
@@ -171,12 +171,12 @@ resource "azuredevops_serviceendpoint_generic_git" "test" {
 	enable_pipelines_access = false
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericGitResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointGenericGitResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointGenericGitResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointGenericGitResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_generic_git" "import" {
@@ -0,0 +1,93 @@
+//go:build (all || resource_serviceendpoint_generic_raw) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_generic_raw
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointGenericRaw_basic(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_generic_raw"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointGenericRawResource(projectID, serviceEndpointName, t.Name(), "https://registry.npmjs.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://registry.npmjs.org"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointGenericRaw_update(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointNameFirst := testutils.GenerateResourceName()
+	serviceEndpointNameSecond := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_generic_raw"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointGenericRawResource(projectID, serviceEndpointNameFirst, t.Name(), "https://registry.npmjs.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			},
+			{
+				Config: hclSvcEndpointGenericRawResource(projectID, serviceEndpointNameSecond, t.Name(), "https://registry.contoso.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://registry.contoso.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func hclSvcEndpointGenericRawResource(projectID string, serviceEndpointName string, description string, url string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_generic_raw" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  type                  = "externalnpmregistry"
+  url                   = "%s"
+  authorization_scheme  = "Token"
+
+  parameters = {
+    apitoken = "redacted"
+  }
+}`, serviceEndpointName, description, url)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
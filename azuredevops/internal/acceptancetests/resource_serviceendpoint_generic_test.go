@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointGeneric_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_generic"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointGeneric_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGenericResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointGeneric_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointGeneric_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -49,7 +49,7 @@ func TestAccServiceEndpointGeneric_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointGenericResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -65,7 +65,7 @@ func TestAccServiceEndpointGeneric_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointGeneric_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -79,7 +79,7 @@ func TestAccServiceEndpointGeneric_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointGenericResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
@@ -87,7 +87,7 @@ func TestAccServiceEndpointGeneric_update(t *testing.T) {
 				),
 			},
 			{
-				Config: hclSvcEndpointGenericResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointGenericResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -103,7 +103,7 @@ func TestAccServiceEndpointGeneric_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointGeneric_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_generic"
 	tfSvcEpNode := resourceType + ".test"
@@ -114,35 +114,35 @@ func TestAccServiceEndpointGeneric_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointGenericResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointGenericResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointGenericResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointGenericResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointGenericResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointGenericResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	server_url            = "https://some-server.example.com"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointGenericResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	description           = "%s"
 	server_url            = "https://some-server.example.com"
@@ -150,14 +150,14 @@ resource "azuredevops_serviceendpoint_generic" "test" {
 	password              = "password"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointGenericResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_generic" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	description           = "%s"
 	server_url            = "https://some-server.example.com"
@@ -165,12 +165,12 @@ resource "azuredevops_serviceendpoint_generic" "test" {
 	password              = "password"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointGenericResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointGenericResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointGenericResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointGenericResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_generic" "import" {
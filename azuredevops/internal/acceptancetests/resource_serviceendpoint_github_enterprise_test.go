@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointGitHubEnterprise_PersonalTokenBasic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_github_enterprise"
@@ -23,7 +23,7 @@ func TestAccServiceEndpointGitHubEnterprise_PersonalTokenBasic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: personTokenConfigBasicGithubEnterprise(projectName, serviceEndpointName),
+				Config: personTokenConfigBasicGithubEnterprise(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -38,7 +38,7 @@ func TestAccServiceEndpointGitHubEnterprise_PersonalTokenBasic(t *testing.T) {
 }
 
 func TestAccServiceEndpointGitHubEnterprise_PersonalTokenUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	description := "Manage by Terraform Update"
@@ -50,7 +50,7 @@ func TestAccServiceEndpointGitHubEnterprise_PersonalTokenUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: personTokenConfigBasicGithubEnterprise(projectName, serviceEndpointNameFirst),
+				Config: personTokenConfigBasicGithubEnterprise(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -59,7 +59,7 @@ func TestAccServiceEndpointGitHubEnterprise_PersonalTokenUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: personTokenConfigUpdateGithubEnterprise(projectName, serviceEndpointNameSecond, description),
+				Config: personTokenConfigUpdateGithubEnterprise(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -76,7 +76,7 @@ func TestAccServiceEndpointGitHubEnterprise_PersonalTokenUpdate(t *testing.T) {
 // validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
 // underlying terraform state.
 func TestAccServiceEndpointGitHubEnterprise_CreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -87,7 +87,7 @@ func TestAccServiceEndpointGitHubEnterprise_CreateAndUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointGitHubEnterpriseResource(projectName, serviceEndpointNameFirst),
+				Config: testutils.HclServiceEndpointGitHubEnterpriseResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -97,7 +97,7 @@ func TestAccServiceEndpointGitHubEnterprise_CreateAndUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: testutils.HclServiceEndpointGitHubEnterpriseResource(projectName, serviceEndpointNameSecond),
+				Config: testutils.HclServiceEndpointGitHubEnterpriseResource(projectID, serviceEndpointNameSecond),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -118,12 +118,12 @@ func TestAccServiceEndpointGitHubEnterprise_CreateAndUpdate(t *testing.T) {
 	})
 }
 
-func personTokenConfigBasicGithubEnterprise(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func personTokenConfigBasicGithubEnterprise(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github_enterprise" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	url                    = "https://github.contoso.com"
 	auth_personal {
@@ -134,12 +134,12 @@ resource "azuredevops_serviceendpoint_github_enterprise" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func personTokenConfigUpdateGithubEnterprise(projectName string, serviceEndpointName string, description string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func personTokenConfigUpdateGithubEnterprise(projectID string, serviceEndpointName string, description string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github_enterprise" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	url                    = "https://github.contoso.com"
 	auth_personal {
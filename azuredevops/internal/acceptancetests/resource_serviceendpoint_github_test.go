@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointGitHub_PersonalTokenBasic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_github"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointGitHub_PersonalTokenBasic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: personTokenConfigBasic(projectName, serviceEndpointName),
+				Config: personTokenConfigBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -38,7 +38,7 @@ func TestAccServiceEndpointGitHub_PersonalTokenBasic(t *testing.T) {
 }
 
 func TestAccServiceEndpointGitHub_PersonalTokenUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	description := "Manage by Terraform Update"
@@ -51,7 +51,7 @@ func TestAccServiceEndpointGitHub_PersonalTokenUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: personTokenConfigBasic(projectName, serviceEndpointNameFirst),
+				Config: personTokenConfigBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -59,7 +59,7 @@ func TestAccServiceEndpointGitHub_PersonalTokenUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: personTokenConfigUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: personTokenConfigUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -73,7 +73,7 @@ func TestAccServiceEndpointGitHub_PersonalTokenUpdate(t *testing.T) {
 }
 
 func TestAccServiceEndpointGitHub_OauthBasic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_github"
@@ -84,7 +84,7 @@ func TestAccServiceEndpointGitHub_OauthBasic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: oauthConfigBasic(projectName, serviceEndpointName),
+				Config: oauthConfigBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_oauth.#", "1"),
@@ -96,7 +96,7 @@ func TestAccServiceEndpointGitHub_OauthBasic(t *testing.T) {
 	})
 }
 func TestAccServiceEndpointGitHub_OauthUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	description := "Manage by Terraform Update"
@@ -109,7 +109,7 @@ func TestAccServiceEndpointGitHub_OauthUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: oauthConfigBasic(projectName, serviceEndpointNameFirst),
+				Config: oauthConfigBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_oauth.#", "1"),
@@ -118,7 +118,7 @@ func TestAccServiceEndpointGitHub_OauthUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: oauthConfigUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: oauthConfigUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_oauth.#", "1"),
@@ -134,7 +134,7 @@ func TestAccServiceEndpointGitHub_OauthUpdate(t *testing.T) {
 // validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
 // underlying terraform state.
 func TestAccServiceEndpointGitHub_CreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -146,7 +146,7 @@ func TestAccServiceEndpointGitHub_CreateAndUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointGitHubResource(projectName, serviceEndpointNameFirst),
+				Config: testutils.HclServiceEndpointGitHubResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -155,7 +155,7 @@ func TestAccServiceEndpointGitHub_CreateAndUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: testutils.HclServiceEndpointGitHubResource(projectName, serviceEndpointNameSecond),
+				Config: testutils.HclServiceEndpointGitHubResource(projectID, serviceEndpointNameSecond),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -175,12 +175,12 @@ func TestAccServiceEndpointGitHub_CreateAndUpdate(t *testing.T) {
 	})
 }
 
-func personTokenConfigBasic(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func personTokenConfigBasic(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	auth_personal {
 		personal_access_token= "test_token_basic"
@@ -190,12 +190,12 @@ resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func personTokenConfigUpdate(projectName string, serviceEndpointName string, description string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func personTokenConfigUpdate(projectID string, serviceEndpointName string, description string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	auth_personal {
 		personal_access_token= "test_token_update"
@@ -206,11 +206,11 @@ resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func oauthConfigBasic(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func oauthConfigBasic(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	auth_oauth {
 		oauth_configuration_id= "xxxx-xxxx-xxxx-xxxx-xxxx"
@@ -220,11 +220,11 @@ resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func oauthConfigUpdate(projectName string, serviceEndpointName string, description string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func oauthConfigUpdate(projectID string, serviceEndpointName string, description string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%[1]s"
 	auth_oauth {
 		oauth_configuration_id= "xxx-xxxx-xxxx-xxxx-xxxx"
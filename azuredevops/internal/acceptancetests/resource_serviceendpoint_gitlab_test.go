@@ -0,0 +1,90 @@
+//go:build (all || resource_serviceendpoint_gitlab) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_gitlab
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointGitLab_basic(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_gitlab"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointGitLabResource(projectID, serviceEndpointName, t.Name(), "https://gitlab.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://gitlab.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointGitLab_update(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointNameFirst := testutils.GenerateResourceName()
+	serviceEndpointNameSecond := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_gitlab"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointGitLabResource(projectID, serviceEndpointNameFirst, t.Name(), "https://gitlab.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			},
+			{
+				Config: hclSvcEndpointGitLabResource(projectID, serviceEndpointNameSecond, t.Name(), "https://gitlab.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://gitlab.example.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func hclSvcEndpointGitLabResource(projectID string, serviceEndpointName string, description string, url string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_gitlab" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  url                   = "%s"
+  username              = "username"
+  api_token             = "redacted"
+}`, serviceEndpointName, description, url)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,114 @@
+//go:build (all || resource_serviceendpoint_hashicorp_vault) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_hashicorp_vault
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointHashiCorpVault_basic(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_hashicorp_vault"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointHashiCorpVaultResourceToken(projectID, serviceEndpointName, t.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authentication_token.#", "1"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://vault.example.com:8200"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointHashiCorpVault_update(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointNameFirst := testutils.GenerateResourceName()
+	serviceEndpointNameSecond := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_hashicorp_vault"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointHashiCorpVaultResourceToken(projectID, serviceEndpointNameFirst, t.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authentication_token.#", "1"),
+				),
+			},
+			{
+				Config: hclSvcEndpointHashiCorpVaultResourceAppRole(projectID, serviceEndpointNameSecond, t.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authentication_token.#", "0"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authentication_approle.#", "1"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "namespace", "admin"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authentication_approle.0.secret_id"},
+			},
+		},
+	})
+}
+
+func hclSvcEndpointHashiCorpVaultResourceToken(projectID string, serviceEndpointName string, description string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_hashicorp_vault" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  url                   = "https://vault.example.com:8200"
+
+  authentication_token {
+    token = "redacted"
+  }
+}`, serviceEndpointName, description)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
+
+func hclSvcEndpointHashiCorpVaultResourceAppRole(projectID string, serviceEndpointName string, description string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_hashicorp_vault" "test" {
+  project_id            = data.azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "%s"
+  url                   = "https://vault.example.com:8200"
+  namespace             = "admin"
+
+  authentication_approle {
+    role_id   = "00000000-0000-0000-0000-000000000000"
+    secret_id = "redacted"
+  }
+}`, serviceEndpointName, description)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
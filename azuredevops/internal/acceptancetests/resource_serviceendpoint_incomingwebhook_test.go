@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointIncomingWebhook_Basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_incomingwebhook"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointIncomingWebhook_Basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointIncomingWebhookResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointIncomingWebhookResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointIncomingWebhook_Basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointIncomingWebhook_Complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 	webhookName := "test_webhook_name"
@@ -52,7 +52,7 @@ func TestAccServiceEndpointIncomingWebhook_Complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointIncomingWebhookResourceComplete(projectName, serviceEndpointName, webhookName, secret, httpHeader, description),
+				Config: hclSvcEndpointIncomingWebhookResourceComplete(projectID, serviceEndpointName, webhookName, secret, httpHeader, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -68,7 +68,7 @@ func TestAccServiceEndpointIncomingWebhook_Complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointIncomingWebhook_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	webhookName := "test_webhook_name"
 
@@ -83,14 +83,14 @@ func TestAccServiceEndpointIncomingWebhook_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointIncomingWebhookResource(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointIncomingWebhookResource(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointIncomingWebhookResourceUpdate(projectName, webhookName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointIncomingWebhookResourceUpdate(projectID, webhookName, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -103,7 +103,7 @@ func TestAccServiceEndpointIncomingWebhook_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointIncomingWebhook_requiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_incomingwebhook"
@@ -114,7 +114,7 @@ func TestAccServiceEndpointIncomingWebhook_requiresImportErrorStep(t *testing.T)
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointIncomingWebhookResource(projectName, serviceEndpointName),
+				Config: hclSvcEndpointIncomingWebhookResource(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -122,21 +122,21 @@ func TestAccServiceEndpointIncomingWebhook_requiresImportErrorStep(t *testing.T)
 				),
 			},
 			{
-				Config:      hclSvcEndpointIncomingWebhookResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointIncomingWebhookResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointIncomingWebhookResource(projectName string, serviceEndpointName string) string {
-	return hclSvcEndpointIncomingWebhookResourceUpdate(projectName, "test_webhook_name", serviceEndpointName, "description")
+func hclSvcEndpointIncomingWebhookResource(projectID string, serviceEndpointName string) string {
+	return hclSvcEndpointIncomingWebhookResourceUpdate(projectID, "test_webhook_name", serviceEndpointName, "description")
 }
 
-func hclSvcEndpointIncomingWebhookResourceUpdate(projectName string, webhookName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointIncomingWebhookResourceUpdate(projectID string, webhookName string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 	resource "azuredevops_serviceendpoint_incomingwebhook" "test" {
-		project_id             = azuredevops_project.project.id
+		project_id             = data.azuredevops_project.project.id
 		webhook_name           = "%s"
 		secret			       = "secret1!"
 		http_header			   = "X-Header"
@@ -144,14 +144,14 @@ func hclSvcEndpointIncomingWebhookResourceUpdate(projectName string, webhookName
 		description            = "%s"
 	}`, webhookName, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointIncomingWebhookResourceComplete(projectName string, serviceEndpointName string, webhookName string, secret string, httpHeader string, description string) string {
+func hclSvcEndpointIncomingWebhookResourceComplete(projectID string, serviceEndpointName string, webhookName string, secret string, httpHeader string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 	resource "azuredevops_serviceendpoint_incomingwebhook" "test" {
-		project_id             = azuredevops_project.project.id
+		project_id             = data.azuredevops_project.project.id
 		service_endpoint_name  = "%s"
 		webhook_name           = "%s"
 		secret			       = "%s"
@@ -159,12 +159,12 @@ func hclSvcEndpointIncomingWebhookResourceComplete(projectName string, serviceEn
 		description            = "%s"
 	}`, serviceEndpointName, webhookName, secret, httpHeader, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointIncomingWebhookResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointIncomingWebhookResource(projectName, serviceEndpointName)
+func hclSvcEndpointIncomingWebhookResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointIncomingWebhookResource(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 	%s
 	resource "azuredevops_serviceendpoint_incomingwebhook" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointJenkins_basic_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_jenkins"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointJenkins_basic_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointJenkins_basic_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointJenkins_complete_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointJenkins_complete_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJenkinsResourceCompleteUsernamePassword(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointJenkinsResourceCompleteUsernamePassword(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -64,7 +64,7 @@ func TestAccServiceEndpointJenkins_complete_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointJenkins_update_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -78,14 +78,14 @@ func TestAccServiceEndpointJenkins_update_usernamepassword(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointJenkinsResourceUpdateUsernamePassword(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointJenkinsResourceUpdateUsernamePassword(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -101,7 +101,7 @@ func TestAccServiceEndpointJenkins_update_usernamepassword(t *testing.T) {
 }
 
 func TestAccServiceEndpointJenkins_RequiresImportErrorStepUsernamePassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_jenkins"
 	tfSvcEpNode := resourceType + ".test"
@@ -112,23 +112,23 @@ func TestAccServiceEndpointJenkins_RequiresImportErrorStepUsernamePassword(t *te
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointJenkinsResourceRequiresImportUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointJenkinsResourceRequiresImportUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jenkins" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	username			   = "u"
 	password			   = "redacted"
@@ -137,14 +137,14 @@ resource "azuredevops_serviceendpoint_jenkins" "test" {
 	accept_untrusted_certs  = false
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJenkinsResourceCompleteUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJenkinsResourceCompleteUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jenkins" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	username			   = "u"
@@ -153,14 +153,14 @@ resource "azuredevops_serviceendpoint_jenkins" "test" {
 	accept_untrusted_certs  = false
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJenkinsResourceUpdateUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJenkinsResourceUpdateUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jenkins" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	username			   = "u2"
@@ -169,12 +169,12 @@ resource "azuredevops_serviceendpoint_jenkins" "test" {
 	accept_untrusted_certs  = false
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJenkinsResourceRequiresImportUsernamePassword(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectName, serviceEndpointName, description)
+func hclSvcEndpointJenkinsResourceRequiresImportUsernamePassword(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointJenkinsResourceBasicUsernamePassword(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_jenkins" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointJFrogArtifactoryV2_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_jfrog_artifactory_v2"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_basic_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_jfrog_artifactory_v2"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_basic_usernamepassword(t *testing.
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -61,7 +61,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_basic_usernamepassword(t *testing.
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_complete_token(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -73,7 +73,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_complete_token(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -88,7 +88,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_complete_token(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_complete_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -100,7 +100,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_complete_usernamepassword(t *testi
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceCompleteUsernamePassword(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceCompleteUsernamePassword(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -115,7 +115,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_complete_usernamepassword(t *testi
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -129,14 +129,14 @@ func TestAccServiceEndpointJFrogArtifactoryV2_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -151,7 +151,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_update_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -165,14 +165,14 @@ func TestAccServiceEndpointJFrogArtifactoryV2_update_usernamepassword(t *testing
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceUpdateUsernamePassword(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceUpdateUsernamePassword(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -187,7 +187,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_update_usernamepassword(t *testing
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_jfrog_artifactory_v2"
 	tfSvcEpNode := resourceType + ".test"
@@ -198,13 +198,13 @@ func TestAccServiceEndpointJFrogArtifactoryV2_RequiresImportErrorStep(t *testing
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
@@ -212,7 +212,7 @@ func TestAccServiceEndpointJFrogArtifactoryV2_RequiresImportErrorStep(t *testing
 }
 
 func TestAccServiceEndpointJFrogArtifactoryV2_RequiresImportErrorStepUsernamePassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_jfrog_artifactory_v2"
 	tfSvcEpNode := resourceType + ".test"
@@ -223,23 +223,23 @@ func TestAccServiceEndpointJFrogArtifactoryV2_RequiresImportErrorStepUsernamePas
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   authentication_token {
     token = "redacted"
@@ -248,14 +248,14 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   description = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   authentication_basic {
     username = "u"
@@ -265,14 +265,14 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   description = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceCompleteUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceCompleteUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_basic {
@@ -282,14 +282,14 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   url = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_token {
@@ -298,14 +298,14 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   url = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_token {
@@ -314,14 +314,14 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   url = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceUpdateUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogArtifactoryV2ResourceUpdateUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_basic {
@@ -331,12 +331,12 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "test" {
   url = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectName, serviceEndpointName, description)
+func hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImport(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointJFrogArtifactoryV2ResourceBasic(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "import" {
@@ -350,8 +350,8 @@ resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "import" {
 }
 `, template)
 }
-func hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImportUsernamePassword(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, description)
+func hclSvcEndpointJFrogArtifactoryV2ResourceRequiresImportUsernamePassword(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointJFrogArtifactoryV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_jfrog_artifactory_v2" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointJFrogPlatformV2_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_jfrog_platform_v2"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointJFrogPlatformV2_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointJFrogPlatformV2_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_basic_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_jfrog_platform_v2"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointJFrogPlatformV2_basic_usernamepassword(t *testing.T)
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -61,7 +61,7 @@ func TestAccServiceEndpointJFrogPlatformV2_basic_usernamepassword(t *testing.T)
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_complete_token(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -73,7 +73,7 @@ func TestAccServiceEndpointJFrogPlatformV2_complete_token(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -88,7 +88,7 @@ func TestAccServiceEndpointJFrogPlatformV2_complete_token(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_complete_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := t.Name()
 
@@ -100,7 +100,7 @@ func TestAccServiceEndpointJFrogPlatformV2_complete_usernamepassword(t *testing.
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceCompleteUsernamePassword(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceCompleteUsernamePassword(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -115,7 +115,7 @@ func TestAccServiceEndpointJFrogPlatformV2_complete_usernamepassword(t *testing.
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -129,14 +129,14 @@ func TestAccServiceEndpointJFrogPlatformV2_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -151,7 +151,7 @@ func TestAccServiceEndpointJFrogPlatformV2_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_update_usernamepassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := t.Name()
@@ -165,14 +165,14 @@ func TestAccServiceEndpointJFrogPlatformV2_update_usernamepassword(t *testing.T)
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectName, serviceEndpointNameFirst, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectID, serviceEndpointNameFirst, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceUpdateUsernamePassword(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceUpdateUsernamePassword(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -187,7 +187,7 @@ func TestAccServiceEndpointJFrogPlatformV2_update_usernamepassword(t *testing.T)
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_jfrog_platform_v2"
 	tfSvcEpNode := resourceType + ".test"
@@ -198,13 +198,13 @@ func TestAccServiceEndpointJFrogPlatformV2_RequiresImportErrorStep(t *testing.T)
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasic(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
@@ -212,7 +212,7 @@ func TestAccServiceEndpointJFrogPlatformV2_RequiresImportErrorStep(t *testing.T)
 }
 
 func TestAccServiceEndpointJFrogPlatformV2_RequiresImportErrorStepUsernamePassword(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_jfrog_platform_v2"
 	tfSvcEpNode := resourceType + ".test"
@@ -223,23 +223,23 @@ func TestAccServiceEndpointJFrogPlatformV2_RequiresImportErrorStepUsernamePasswo
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, t.Name()),
+				Config: hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, t.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectName, serviceEndpointName, t.Name()),
+				Config:      hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectID, serviceEndpointName, t.Name()),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceBasic(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceBasic(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   authentication_token {
     token = "redacted"
@@ -248,14 +248,14 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   description = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   authentication_basic {
     username = "u"
@@ -265,14 +265,14 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   description = "%s"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceCompleteUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceCompleteUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_basic {
@@ -282,14 +282,14 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   url = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_token {
@@ -298,14 +298,14 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   url = "https://url.com/1"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_token {
@@ -314,14 +314,14 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   url = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceUpdateUsernamePassword(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointJFrogPlatformV2ResourceUpdateUsernamePassword(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   authentication_basic {
@@ -331,12 +331,12 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "test" {
   url = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointJFrogPlatformV2ResourceBasic(projectName, serviceEndpointName, description)
+func hclSvcEndpointJFrogPlatformV2ResourceRequiresImport(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointJFrogPlatformV2ResourceBasic(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "import" {
@@ -350,8 +350,8 @@ resource "azuredevops_serviceendpoint_jfrog_platform_v2" "import" {
 }
 `, template)
 }
-func hclSvcEndpointJFrogPlatformV2ResourceRequiresImportUsernamePassword(projectName string, serviceEndpointName string, description string) string {
-	template := hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectName, serviceEndpointName, description)
+func hclSvcEndpointJFrogPlatformV2ResourceRequiresImportUsernamePassword(projectID string, serviceEndpointName string, description string) string {
+	template := hclSvcEndpointJFrogPlatformV2ResourceBasicUsernamePassword(projectID, serviceEndpointName, description)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_jfrog_platform_v2" "import" {
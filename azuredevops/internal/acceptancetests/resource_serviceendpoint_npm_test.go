@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointNpm_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_npm"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointNpm_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNpmResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNpmResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,8 +36,31 @@ func TestAccServiceEndpointNpm_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceEndpointNpm_usernamePassword(t *testing.T) {
+	projectID := testutils.AcquireSharedProject(t)
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_npm"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointNpmResourceUsernamePassword(projectID, serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckNoResourceAttr(tfSvcEpNode, "access_token"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceEndpointNpm_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -49,7 +72,7 @@ func TestAccServiceEndpointNpm_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNpmResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointNpmResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -63,7 +86,7 @@ func TestAccServiceEndpointNpm_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointNpm_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -77,14 +100,14 @@ func TestAccServiceEndpointNpm_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNpmResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointNpmResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointNpmResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointNpmResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -98,7 +121,7 @@ func TestAccServiceEndpointNpm_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointNpm_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_npm"
 	tfSvcEpNode := resourceType + ".test"
@@ -109,62 +132,76 @@ func TestAccServiceEndpointNpm_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNpmResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNpmResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointNpmResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointNpmResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointNpmResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointNpmResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_npm" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	access_token          = "redacted"
 	url                   = "http://url.com/"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
+
+func hclSvcEndpointNpmResourceUsernamePassword(projectID string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_npm" "test" {
+	project_id            = data.azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	username              = "username"
+	password              = "redacted"
+	url                   = "https://url.com/"
+}`, serviceEndpointName)
+
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointNpmResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointNpmResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_npm" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	description           = "%s"
 	access_token          = "redacted"
 	url                   = "https://url.com/"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointNpmResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointNpmResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_npm" "test" {
-	project_id            = azuredevops_project.project.id
+	project_id            = data.azuredevops_project.project.id
 	service_endpoint_name = "%s"
 	description           = "%s"
 	access_token          = "redacted2"
 	url                   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointNpmResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointNpmResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointNpmResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointNpmResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_npm" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointNuGet_ApiKey(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_nuget"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointNuGet_ApiKey(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNuGetResourceApiKey(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourceApiKey(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointNuGet_ApiKey(t *testing.T) {
 }
 
 func TestAccServiceEndpointNuGet_PersonalAccessToken(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_nuget"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointNuGet_PersonalAccessToken(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNuGetResourcePersonalAccessToken(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourcePersonalAccessToken(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -60,7 +60,7 @@ func TestAccServiceEndpointNuGet_PersonalAccessToken(t *testing.T) {
 }
 
 func TestAccServiceEndpointNuGet_UnamePwd(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_nuget"
@@ -71,7 +71,7 @@ func TestAccServiceEndpointNuGet_UnamePwd(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNuGetResourceUnamePwd(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourceUnamePwd(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -84,7 +84,7 @@ func TestAccServiceEndpointNuGet_UnamePwd(t *testing.T) {
 }
 
 func TestAccServiceEndpointNuGet_Update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_nuget"
@@ -95,7 +95,7 @@ func TestAccServiceEndpointNuGet_Update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNuGetResourceApiKey(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourceApiKey(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -103,7 +103,7 @@ func TestAccServiceEndpointNuGet_Update(t *testing.T) {
 				),
 			},
 			{
-				Config: hclSvcEndpointNuGetResourcePersonalAccessToken(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourcePersonalAccessToken(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -115,7 +115,7 @@ func TestAccServiceEndpointNuGet_Update(t *testing.T) {
 }
 
 func TestAccServiceEndpointNuGet_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_nuget"
 	tfSvcEpNode := resourceType + ".test"
@@ -126,82 +126,70 @@ func TestAccServiceEndpointNuGet_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointNuGetResourceApiKey(projectName, serviceEndpointName),
+				Config: hclSvcEndpointNuGetResourceApiKey(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointNugGetResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointNugGetResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointNuGetResourceApiKey(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointNuGetResourceApiKey(projectID string, serviceEndpointName string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_nuget" "test" {
-  project_id            = azuredevops_project.test.id
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   api_key               = "apikey"
   feed_url              = "https://api.nuget.org/v3/index.json"
   description           = "%[2]s-description"
-}`, projectName, serviceEndpointName)
+}`, projectID, serviceEndpointName)
 
 }
 
-func hclSvcEndpointNuGetResourcePersonalAccessToken(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointNuGetResourcePersonalAccessToken(projectID string, serviceEndpointName string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_nuget" "test" {
-  project_id            = azuredevops_project.test.id
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   personal_access_token = "pat"
   feed_url              = "https://api.nuget.org/v3/index.json"
   description           = "%[2]s-description"
-}`, projectName, serviceEndpointName)
+}`, projectID, serviceEndpointName)
 
 }
 
-func hclSvcEndpointNuGetResourceUnamePwd(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointNuGetResourceUnamePwd(projectID string, serviceEndpointName string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_nuget" "test" {
-  project_id            = azuredevops_project.test.id
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   username              = "uname"
   password              = "pwd"
   feed_url              = "https://api.nuget.org/v3/index.json"
   description           = "%[2]s-description"
-}`, projectName, serviceEndpointName)
+}`, projectID, serviceEndpointName)
 
 }
 
-func hclSvcEndpointNugGetResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointNuGetResourceApiKey(projectName, serviceEndpointName)
+func hclSvcEndpointNugGetResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointNuGetResourceApiKey(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_nuget" "import" {
@@ -1,5 +1,5 @@
-//go:build (all || azuredevops_serviceendpoint_octopusdeploy) && !exclude_serviceendpoints
-// +build all azuredevops_serviceendpoint_octopusdeploy
+//go:build (all || resource_serviceendpoint_octopus_deploy) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_octopus_deploy
 // +build !exclude_serviceendpoints
 
 package acceptancetests
@@ -13,10 +13,10 @@ import (
 )
 
 func TestAccServiceEndpointOctopusDeploy_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
-	resourceType := "azuredevops_serviceendpoint_octopusdeploy"
+	resourceType := "resource_serviceendpoint_octopus_deploy"
 	tfSvcEpNode := resourceType + ".test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testutils.PreCheck(t, nil) },
@@ -24,7 +24,7 @@ func TestAccServiceEndpointOctopusDeploy_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointOctopusDeployResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointOctopusDeployResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,11 +36,11 @@ func TestAccServiceEndpointOctopusDeploy_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointOctopusDeploy_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
-	resourceType := "azuredevops_serviceendpoint_octopusdeploy"
+	resourceType := "resource_serviceendpoint_octopus_deploy"
 	tfSvcEpNode := resourceType + ".test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testutils.PreCheck(t, nil) },
@@ -48,7 +48,7 @@ func TestAccServiceEndpointOctopusDeploy_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointOctopusDeployResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointOctopusDeployResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -63,13 +63,13 @@ func TestAccServiceEndpointOctopusDeploy_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointOctopusDeploy_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
-	resourceType := "azuredevops_serviceendpoint_octopusdeploy"
+	resourceType := "resource_serviceendpoint_octopus_deploy"
 	tfSvcEpNode := resourceType + ".test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testutils.PreCheck(t, nil) },
@@ -77,14 +77,14 @@ func TestAccServiceEndpointOctopusDeploy_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointOctopusDeployResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointOctopusDeployResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointOctopusDeployResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointOctopusDeployResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -99,9 +99,9 @@ func TestAccServiceEndpointOctopusDeploy_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointOctopusDeploy_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
-	resourceType := "azuredevops_serviceendpoint_octopusdeploy"
+	resourceType := "resource_serviceendpoint_octopus_deploy"
 	tfSvcEpNode := resourceType + ".test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -110,88 +110,76 @@ func TestAccServiceEndpointOctopusDeploy_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointOctopusDeployResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointOctopusDeployResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointOctopusDeployResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointOctopusDeployResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointOctopusDeployResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointOctopusDeployResourceBasic(projectID string, serviceEndpointName string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "Managed by Terraform"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
-resource "azuredevops_serviceendpoint_octopusdeploy" "test" {
-  project_id            = azuredevops_project.test.id
+resource "resource_serviceendpoint_octopus_deploy" "test" {
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   url                   = "https://dev.azure.com"
   api_key               = "000000000000000000000000000000000000"
-}`, projectName, serviceEndpointName)
+}`, projectID, serviceEndpointName)
 }
 
-func hclSvcEndpointOctopusDeployResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointOctopusDeployResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "Managed by Terraform"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
-resource "azuredevops_serviceendpoint_octopusdeploy" "test" {
-  project_id            = azuredevops_project.test.id
+resource "resource_serviceendpoint_octopus_deploy" "test" {
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   url                   = "https://dev.azure.com"
   api_key               = "000000000000000000000000000000000000"
   ignore_ssl_error      = true
   description           = "%[3]s"
-}`, projectName, serviceEndpointName, description)
+}`, projectID, serviceEndpointName, description)
 }
 
-func hclSvcEndpointOctopusDeployResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointOctopusDeployResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "test" {
-  name               = "%[1]s"
-  description        = "Managed by Terraform"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "test" {
+  project_id = "%[1]s"
 }
 
-resource "azuredevops_serviceendpoint_octopusdeploy" "test" {
-  project_id            = azuredevops_project.test.id
+resource "resource_serviceendpoint_octopus_deploy" "test" {
+  project_id            = data.azuredevops_project.test.id
   service_endpoint_name = "%[2]s"
   url                   = "https://dev.azure.com"
   api_key               = "000000000000000000000000000000000000"
   ignore_ssl_error      = true
   description           = "%[3]s"
-}`, projectName, serviceEndpointName, description)
+}`, projectID, serviceEndpointName, description)
 }
 
-func hclSvcEndpointOctopusDeployResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointOctopusDeployResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointOctopusDeployResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointOctopusDeployResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
-resource "azuredevops_serviceendpoint_octopusdeploy" "import" {
-  project_id            = azuredevops_serviceendpoint_octopusdeploy.test.project_id
-  service_endpoint_name = azuredevops_serviceendpoint_octopusdeploy.test.service_endpoint_name
-  description           = azuredevops_serviceendpoint_octopusdeploy.test.description
-  url                   = azuredevops_serviceendpoint_octopusdeploy.test.url
+resource "resource_serviceendpoint_octopus_deploy" "import" {
+  project_id            = resource_serviceendpoint_octopus_deploy.test.project_id
+  service_endpoint_name = resource_serviceendpoint_octopus_deploy.test.service_endpoint_name
+  description           = resource_serviceendpoint_octopus_deploy.test.description
+  url                   = resource_serviceendpoint_octopus_deploy.test.url
   api_key               = "000000000000000000000000000000000000"
-  ignore_ssl_error      = azuredevops_serviceendpoint_octopusdeploy.test.ignore_ssl_error
+  ignore_ssl_error      = resource_serviceendpoint_octopus_deploy.test.ignore_ssl_error
 }
 `, template)
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointRunPipeline_Defaults(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_runpipeline"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointRunPipeline_Defaults(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: azdoResourceSetupSimple(projectName, serviceEndpointName),
+				Config: azdoResourceSetupSimple(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointName),
@@ -37,7 +37,7 @@ func TestAccServiceEndpointRunPipeline_Defaults(t *testing.T) {
 }
 
 func TestAccServiceEndpointRunPipeline_PersonalTokenBasic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_runpipeline"
@@ -48,7 +48,7 @@ func TestAccServiceEndpointRunPipeline_PersonalTokenBasic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: azdoPersonTokenConfigBasic(projectName, serviceEndpointName),
+				Config: azdoPersonTokenConfigBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -64,7 +64,7 @@ func TestAccServiceEndpointRunPipeline_PersonalTokenBasic(t *testing.T) {
 // validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
 // underlying terraform state.
 func TestAccServiceEndpointRunPipeline_PersonalTokenUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 	description := "Manage by Terraform Update"
@@ -78,7 +78,7 @@ func TestAccServiceEndpointRunPipeline_PersonalTokenUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: azdoPersonTokenConfigBasic(projectName, serviceEndpointNameFirst),
+				Config: azdoPersonTokenConfigBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -87,7 +87,7 @@ func TestAccServiceEndpointRunPipeline_PersonalTokenUpdate(t *testing.T) {
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst),
 				),
 			}, {
-				Config: azdoPersonTokenConfigUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: azdoPersonTokenConfigUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.#", "1"),
@@ -101,14 +101,14 @@ func TestAccServiceEndpointRunPipeline_PersonalTokenUpdate(t *testing.T) {
 	})
 }
 
-func azdoResourceSetupSimple(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func azdoResourceSetupSimple(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	serviceEndpointResource := testutils.HclServiceEndpointRunPipelineResourceSimple(serviceEndpointName)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func azdoPersonTokenConfigBasic(projectName string, serviceEndpointName string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func azdoPersonTokenConfigBasic(projectID string, serviceEndpointName string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := testutils.HclServiceEndpointRunPipelineResource(
 		serviceEndpointName,
@@ -119,8 +119,8 @@ func azdoPersonTokenConfigBasic(projectName string, serviceEndpointName string)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func azdoPersonTokenConfigUpdate(projectName string, serviceEndpointName string, updatedDescription string) string {
-	projectResource := testutils.HclProjectResource(projectName)
+func azdoPersonTokenConfigUpdate(projectID string, serviceEndpointName string, updatedDescription string) string {
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 
 	serviceEndpointResource := testutils.HclServiceEndpointRunPipelineResource(
 		serviceEndpointName,
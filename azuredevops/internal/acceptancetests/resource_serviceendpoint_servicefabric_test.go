@@ -14,7 +14,7 @@ import (
 // validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
 // underlying terraform state.
 func TestAccServiceEndpointServiceFabric_CertificateCreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -26,7 +26,7 @@ func TestAccServiceEndpointServiceFabric_CertificateCreateAndUpdate(t *testing.T
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameFirst, "Certificate"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameFirst, "Certificate"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -39,7 +39,7 @@ func TestAccServiceEndpointServiceFabric_CertificateCreateAndUpdate(t *testing.T
 				),
 			},
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameSecond, "Certificate"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameSecond, "Certificate"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -56,7 +56,7 @@ func TestAccServiceEndpointServiceFabric_CertificateCreateAndUpdate(t *testing.T
 }
 
 func TestAccServiceEndpointServiceFabric_UsernamePasswordCreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -68,7 +68,7 @@ func TestAccServiceEndpointServiceFabric_UsernamePasswordCreateAndUpdate(t *test
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameFirst, "UsernamePassword"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameFirst, "UsernamePassword"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -81,7 +81,7 @@ func TestAccServiceEndpointServiceFabric_UsernamePasswordCreateAndUpdate(t *test
 				),
 			},
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameSecond, "UsernamePassword"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameSecond, "UsernamePassword"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -98,7 +98,7 @@ func TestAccServiceEndpointServiceFabric_UsernamePasswordCreateAndUpdate(t *test
 }
 
 func TestAccServiceEndpointServiceFabric_NoneCreateAndUpdate(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 	serviceEndpointNameSecond := testutils.GenerateResourceName()
 
@@ -110,7 +110,7 @@ func TestAccServiceEndpointServiceFabric_NoneCreateAndUpdate(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameFirst, "None"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameFirst, "None"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -121,7 +121,7 @@ func TestAccServiceEndpointServiceFabric_NoneCreateAndUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testutils.HclServiceEndpointServiceFabricResource(projectName, serviceEndpointNameSecond, "None"),
+				Config: testutils.HclServiceEndpointServiceFabricResource(projectID, serviceEndpointNameSecond, "None"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "cluster_endpoint"),
@@ -0,0 +1,76 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_share
+// +build !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointShare_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	targetProjectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_serviceendpoint_share.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointShare(projectName, targetProjectName, serviceEndpointName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "service_endpoint_id"),
+					resource.TestCheckResourceAttr(tfNode, "project_reference.#", "1"),
+				),
+			},
+			{
+				Config: hclServiceEndpointShare(projectName, targetProjectName, serviceEndpointName, "shared-"+serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "project_reference.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "project_reference.*", map[string]string{
+						"service_endpoint_name": "shared-" + serviceEndpointName,
+					}),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclServiceEndpointShare(projectName, targetProjectName, serviceEndpointName, sharedName string) string {
+	sharedNameAttribute := ""
+	if sharedName != "" {
+		sharedNameAttribute = fmt.Sprintf("service_endpoint_name = %q", sharedName)
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_project" "target" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_serviceendpoint_share" "test" {
+  project_id          = azuredevops_project.project.id
+  service_endpoint_id = azuredevops_serviceendpoint_generic.test.id
+
+  project_reference {
+    project_id = azuredevops_project.target.id
+    %s
+  }
+}`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, "https://contoso.com", "username", "password"), targetProjectName, sharedNameAttribute)
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointSonarCloud_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_sonarcloud"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointSonarCloud_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarCloudResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointSonarCloudResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointSonarCloud_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarCloud_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointSonarCloud_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarCloudResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointSonarCloudResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -62,7 +62,7 @@ func TestAccServiceEndpointSonarCloud_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarCloud_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -76,14 +76,14 @@ func TestAccServiceEndpointSonarCloud_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarCloudResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointSonarCloudResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointSonarCloudResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointSonarCloudResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -97,7 +97,7 @@ func TestAccServiceEndpointSonarCloud_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarCloud_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_sonarcloud"
 	tfSvcEpNode := resourceType + ".test"
@@ -108,59 +108,59 @@ func TestAccServiceEndpointSonarCloud_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarCloudResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointSonarCloudResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointSonarCloudResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointSonarCloudResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointSonarCloudResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointSonarCloudResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarcloud" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   token                 = "redacted"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarCloudResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointSonarCloudResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarcloud" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   token                 = "redacted"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarCloudResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointSonarCloudResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarcloud" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   description           = "%s"
   token                 = "redacted2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarCloudResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointSonarCloudResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointSonarCloudResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointSonarCloudResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_sonarcloud" "import" {
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointSonarQube_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_sonarqube"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointSonarQube_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarQubeResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointSonarQubeResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointSonarQube_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarQube_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointSonarQube_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarQubeResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointSonarQubeResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -63,7 +63,7 @@ func TestAccServiceEndpointSonarQube_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarQube_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -77,14 +77,14 @@ func TestAccServiceEndpointSonarQube_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarQubeResourceBasic(projectName, serviceEndpointNameFirst),
+				Config: hclSvcEndpointSonarQubeResourceBasic(projectID, serviceEndpointNameFirst),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointSonarQubeResourceUpdate(projectName, serviceEndpointNameSecond, description),
+				Config: hclSvcEndpointSonarQubeResourceUpdate(projectID, serviceEndpointNameSecond, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -99,7 +99,7 @@ func TestAccServiceEndpointSonarQube_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointSonarQube_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_sonarqube"
 	tfSvcEpNode := resourceType + ".test"
@@ -110,62 +110,62 @@ func TestAccServiceEndpointSonarQube_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSonarQubeResourceBasic(projectName, serviceEndpointName),
+				Config: hclSvcEndpointSonarQubeResourceBasic(projectID, serviceEndpointName),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointSonarQubeResourceRequiresImport(projectName, serviceEndpointName),
+				Config:      hclSvcEndpointSonarQubeResourceRequiresImport(projectID, serviceEndpointName),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointSonarQubeResourceBasic(projectName string, serviceEndpointName string) string {
+func hclSvcEndpointSonarQubeResourceBasic(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarqube" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	token			   	   = "redacted"
 	url			   		   = "http://url.com/"
 }`, serviceEndpointName)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarQubeResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointSonarQubeResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarqube" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	token			   	   = "redacted"
 	url			   		   = "https://url.com/"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarQubeResourceUpdate(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointSonarQubeResourceUpdate(projectID string, serviceEndpointName string, description string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_sonarqube" "test" {
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 	description            = "%s"
 	token			   	   = "redacted2"
 	url			   		   = "https://url.com/2"
 }`, serviceEndpointName, description)
 
-	projectResource := testutils.HclProjectResource(projectName)
+	projectResource := testutils.HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
-func hclSvcEndpointSonarQubeResourceRequiresImport(projectName string, serviceEndpointName string) string {
-	template := hclSvcEndpointSonarQubeResourceBasic(projectName, serviceEndpointName)
+func hclSvcEndpointSonarQubeResourceRequiresImport(projectID string, serviceEndpointName string) string {
+	template := hclSvcEndpointSonarQubeResourceBasic(projectID, serviceEndpointName)
 	return fmt.Sprintf(`
 %s
 resource "azuredevops_serviceendpoint_sonarqube" "import" {
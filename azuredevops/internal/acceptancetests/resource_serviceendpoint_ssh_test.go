@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccServiceEndpointSSH_basic(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 
 	resourceType := "azuredevops_serviceendpoint_ssh"
@@ -24,7 +24,7 @@ func TestAccServiceEndpointSSH_basic(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSSHResourceBasic(projectName, serviceEndpointName, "1.2.3.4", "username"),
+				Config: hclSvcEndpointSSHResourceBasic(projectID, serviceEndpointName, "1.2.3.4", "username"),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -36,7 +36,7 @@ func TestAccServiceEndpointSSH_basic(t *testing.T) {
 }
 
 func TestAccServiceEndpointSSH_complete(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	description := testutils.GenerateResourceName()
 
@@ -48,7 +48,7 @@ func TestAccServiceEndpointSSH_complete(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSSHResourceComplete(projectName, serviceEndpointName, description),
+				Config: hclSvcEndpointSSHResourceComplete(projectID, serviceEndpointName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -66,7 +66,7 @@ func TestAccServiceEndpointSSH_complete(t *testing.T) {
 }
 
 func TestAccServiceEndpointSSH_update(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
 
 	description := testutils.GenerateResourceName()
@@ -80,14 +80,14 @@ func TestAccServiceEndpointSSH_update(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSSHResourceBasic(projectName, serviceEndpointNameFirst, "1.2.3.4", "username"),
+				Config: hclSvcEndpointSSHResourceBasic(projectID, serviceEndpointNameFirst, "1.2.3.4", "username"),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameFirst), resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
 					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
 				),
 			},
 			{
-				Config: hclSvcEndpointSSHResourceUpdate(projectName, serviceEndpointNameSecond, "2.2.3.4", 23, "newname", description),
+				Config: hclSvcEndpointSSHResourceUpdate(projectID, serviceEndpointNameSecond, "2.2.3.4", 23, "newname", description),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointNameSecond),
 					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
@@ -103,7 +103,7 @@ func TestAccServiceEndpointSSH_update(t *testing.T) {
 }
 
 func TestAccServiceEndpointSSH_RequiresImportErrorStep(t *testing.T) {
-	projectName := testutils.GenerateResourceName()
+	projectID := testutils.AcquireSharedProject(t)
 	serviceEndpointName := testutils.GenerateResourceName()
 	resourceType := "azuredevops_serviceendpoint_ssh"
 	tfSvcEpNode := resourceType + ".test"
@@ -114,50 +114,42 @@ func TestAccServiceEndpointSSH_RequiresImportErrorStep(t *testing.T) {
 		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
 		Steps: []resource.TestStep{
 			{
-				Config: hclSvcEndpointSSHResourceBasic(projectName, serviceEndpointName, "1.2.3.4", "username"),
+				Config: hclSvcEndpointSSHResourceBasic(projectID, serviceEndpointName, "1.2.3.4", "username"),
 				Check: resource.ComposeTestCheckFunc(
 					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
 				),
 			},
 			{
-				Config:      hclSvcEndpointSSHResourceRequiresImport(projectName, serviceEndpointName, "1.2.3.4", "username"),
+				Config:      hclSvcEndpointSSHResourceRequiresImport(projectID, serviceEndpointName, "1.2.3.4", "username"),
 				ExpectError: testutils.RequiresImportError(serviceEndpointName),
 			},
 		},
 	})
 }
 
-func hclSvcEndpointSSHResourceBasic(projectName string, serviceEndpointName string, host string, username string) string {
+func hclSvcEndpointSSHResourceBasic(projectID string, serviceEndpointName string, host string, username string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "project" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "project" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_ssh" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%[2]s"
   host                  = "%[3]s"
   username              = "%[4]s"
 }
-`, projectName, serviceEndpointName, host, username)
+`, projectID, serviceEndpointName, host, username)
 }
 
-func hclSvcEndpointSSHResourceComplete(projectName string, serviceEndpointName string, description string) string {
+func hclSvcEndpointSSHResourceComplete(projectID string, serviceEndpointName string, description string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "project" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "project" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_ssh" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%[2]s"
   host                  = "1.2.3.4"
   port                  = 22
@@ -166,21 +158,17 @@ resource "azuredevops_serviceendpoint_ssh" "test" {
   password              = "password"
   description           = "%[3]s"
 }
-`, projectName, serviceEndpointName, description)
+`, projectID, serviceEndpointName, description)
 }
 
-func hclSvcEndpointSSHResourceUpdate(projectName string, serviceEndpointName string, host string, port int, username string, description string) string {
+func hclSvcEndpointSSHResourceUpdate(projectID string, serviceEndpointName string, host string, port int, username string, description string) string {
 	return fmt.Sprintf(`
-resource "azuredevops_project" "project" {
-  name               = "%[1]s"
-  description        = "%[1]s-description"
-  visibility         = "private"
-  version_control    = "Git"
-  work_item_template = "Agile"
+data "azuredevops_project" "project" {
+  project_id = "%[1]s"
 }
 
 resource "azuredevops_serviceendpoint_ssh" "test" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%[2]s"
   host                  = "%[3]s"
   port                  = "%[4]d"
@@ -189,11 +177,11 @@ resource "azuredevops_serviceendpoint_ssh" "test" {
   password              = "password"
   description           = "%[6]s"
 }
-`, projectName, serviceEndpointName, host, port, username, description)
+`, projectID, serviceEndpointName, host, port, username, description)
 }
 
-func hclSvcEndpointSSHResourceRequiresImport(projectName string, serviceEndpointName string, host string, username string) string {
-	template := hclSvcEndpointSSHResourceBasic(projectName, serviceEndpointName, host, username)
+func hclSvcEndpointSSHResourceRequiresImport(projectID string, serviceEndpointName string, host string, username string) string {
+	template := hclSvcEndpointSSHResourceBasic(projectID, serviceEndpointName, host, username)
 	return fmt.Sprintf(`
 %s
 
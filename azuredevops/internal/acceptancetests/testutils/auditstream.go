@@ -0,0 +1,66 @@
+package testutils
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// CheckAuditStreamExists verifies that an audit stream exists in the state,
+// and that it has the expected consumer type when compared against the data in Azure DevOps.
+func CheckAuditStreamExists(tfNode string, expectedConsumerType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceState, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find an audit stream in the state")
+		}
+
+		stream, err := getAuditStreamFromState(resourceState)
+		if err != nil {
+			return err
+		}
+
+		if *stream.ConsumerType != expectedConsumerType {
+			return fmt.Errorf("Audit stream has ConsumerType=%s, but expected ConsumerType=%s", *stream.ConsumerType, expectedConsumerType)
+		}
+
+		return nil
+	}
+}
+
+// CheckAuditStreamDestroyed verifies that all audit streams of the given type in the state are destroyed.
+// This will be invoked *after* terraform destroys the resource but *before* the state is wiped clean.
+func CheckAuditStreamDestroyed(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, resource := range s.RootModule().Resources {
+			if resource.Type != resourceType {
+				continue
+			}
+
+			// indicates the resource exists - this should fail the test
+			stream, err := getAuditStreamFromState(resource)
+			if err == nil && (stream.Status == nil || *stream.Status != audit.AuditStreamStatusValues.Deleted) {
+				return fmt.Errorf("Unexpectedly found an audit stream that should have been deleted")
+			}
+		}
+
+		return nil
+	}
+}
+
+// given a resource from the state, return an audit stream (and error)
+func getAuditStreamFromState(resource *terraform.ResourceState) (*audit.AuditStream, error) {
+	streamID, err := strconv.Atoi(resource.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := GetProvider().Meta().(*client.AggregatedClient)
+	return clients.AuditClient.QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{
+		StreamId: &streamID,
+	})
+}
@@ -0,0 +1,61 @@
+package testutils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// CheckFeedExists verifies that a feed exists in the state,
+// and that it has the expected name when compared against the data in Azure DevOps.
+func CheckFeedExists(tfNode string, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceState, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a feed in the state")
+		}
+
+		getFeed, err := getFeedFromState(resourceState)
+		if err != nil {
+			return err
+		}
+
+		if *getFeed.Name != expectedName {
+			return fmt.Errorf("Feed has Name=%s, but expected Name=%s", *getFeed.Name, expectedName)
+		}
+
+		return nil
+	}
+}
+
+// CheckFeedDestroyed verifies that all feeds in the state are destroyed.
+// This will be invoked *after* terraform destroys the resource but *before* the state is wiped clean.
+func CheckFeedDestroyed(s *terraform.State) error {
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_feed" {
+			continue
+		}
+
+		// indicates the resource exists - this should fail the test
+		if _, err := getFeedFromState(resource); err == nil {
+			return fmt.Errorf("Unexpectedly found a feed that should have been deleted")
+		}
+	}
+
+	return nil
+}
+
+// given a resource from the state, return a feed (and error)
+func getFeedFromState(resource *terraform.ResourceState) (*feed.Feed, error) {
+	feedID := resource.Primary.ID
+	projectID := resource.Primary.Attributes["project_id"]
+
+	clients := GetProvider().Meta().(*client.AggregatedClient)
+	return clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+		FeedId:  &feedID,
+		Project: &projectID,
+	})
+}
@@ -347,26 +347,26 @@ resource "azuredevops_serviceendpoint_runpipeline" "serviceendpoint" {
 }
 
 // HclServiceEndpointDockerRegistryResource HCL describing an AzDO service endpoint
-func HclServiceEndpointDockerRegistryResource(projectName string, serviceEndpointName string) string {
+func HclServiceEndpointDockerRegistryResource(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_dockerregistry" "serviceendpoint" {
 	docker_email           = "test@email.com"
 	docker_username        = "testuser"
 	docker_password        = "secret"
-	project_id             = azuredevops_project.project.id
+	project_id             = data.azuredevops_project.project.id
 	service_endpoint_name  = "%s"
 
 }`, serviceEndpointName)
 
-	projectResource := HclProjectResource(projectName)
+	projectResource := HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
 // HclServiceEndpointAzureCRResource HCL describing an AzDO service endpoint
-func HclServiceEndpointAzureCRResource(projectName string, serviceEndpointName string) string {
+func HclServiceEndpointAzureCRResource(projectID string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_azurecr" "serviceendpoint" {
-	project_id                = azuredevops_project.project.id
+	project_id                = data.azuredevops_project.project.id
 	service_endpoint_name     = "%s"
 	azurecr_spn_tenantid      = "9c59cbe5-2ca1-4516-b303-8968a070edd2"
 	azurecr_subscription_id   = "3b0fee91-c36d-4d70-b1e9-fc4b9d608c3d"
@@ -375,7 +375,7 @@ resource "azuredevops_serviceendpoint_azurecr" "serviceendpoint" {
 	azurecr_name              = "testacr"
 }`, serviceEndpointName)
 
-	projectResource := HclProjectResource(projectName)
+	projectResource := HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
@@ -574,13 +574,13 @@ resource "azuredevops_serviceendpoint_azurerm" "serviceendpointrm" {
 }
 
 // HclServiceEndpointServiceFabricResource HCL describing an AzDO service endpoint
-func HclServiceEndpointServiceFabricResource(projectName string, serviceEndpointName string, authorizationType string) string {
+func HclServiceEndpointServiceFabricResource(projectID string, serviceEndpointName string, authorizationType string) string {
 	var serviceEndpointResource string
 	switch authorizationType {
 	case "Certificate":
 		serviceEndpointResource = fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   cluster_endpoint      = "tcp://test"
   certificate {
@@ -593,7 +593,7 @@ resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
 	case "UsernamePassword":
 		serviceEndpointResource = fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   cluster_endpoint      = "tcp://test"
   azure_active_directory {
@@ -606,7 +606,7 @@ resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
 	case "None":
 		serviceEndpointResource = fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
-  project_id            = azuredevops_project.project.id
+  project_id            = data.azuredevops_project.project.id
   service_endpoint_name = "%s"
   cluster_endpoint      = "tcp://test"
   none {
@@ -615,7 +615,7 @@ resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
   }
 }`, serviceEndpointName)
 	}
-	projectResource := HclProjectResource(projectName)
+	projectResource := HclSharedProjectDataSource(projectID)
 	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
 }
 
@@ -1193,3 +1193,28 @@ func HclEnvironmentResourceKubernetes(projectName string, environmentName string
 	environmentKubernetesResource := getEnvironmentResourceKubernetes(resourceName)
 	return fmt.Sprintf("%s\n%s\n%s", serviceEndpointResource, azureEnvironmentResource, environmentKubernetesResource)
 }
+
+// HclFeedResource HCL describing a feed, which is project scoped if a project name is given
+func HclFeedResource(projectName string, feedName string) string {
+	if projectName == "" {
+		return fmt.Sprintf(`
+resource "azuredevops_feed" "test" {
+  name = "%s"
+
+  features {
+    permanent_delete = true
+  }
+}`, feedName)
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_feed" "test" {
+  name       = "%s"
+  project_id = azuredevops_project.project.id
+
+  features {
+    permanent_delete = true
+  }
+}`, HclProjectResource(projectName), feedName)
+}
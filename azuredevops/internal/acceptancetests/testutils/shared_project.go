@@ -0,0 +1,127 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// sharedProject is a project provisioned outside of the Terraform state of the individual tests. It is
+// created by the first test acquiring it and deleted once the last test holding a reference has finished.
+type sharedProject struct {
+	mu         sync.Mutex
+	refCount   int
+	generation int
+	provider   *schema.Provider
+	data       *schema.ResourceData
+}
+
+var sharedProjectFixture = &sharedProject{}
+
+// sharedProjectRunID identifies the current test run in the names of its shared projects, so projects
+// left behind by aborted or concurrent runs never collide with the ones of this run
+var sharedProjectRunID = time.Now().UTC().Format("20060102150405") + "-" + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+
+// AcquireSharedProject returns the ID of a project that is shared by all acceptance tests of the current
+// run. Tests that only need a project to host the resources under test should use it instead of creating
+// their own project, and reference it with HclSharedProjectDataSource. The reference is released when the
+// test completes.
+func AcquireSharedProject(t *testing.T) string {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	PreCheck(t, nil)
+
+	projectID, err := sharedProjectFixture.acquire(t)
+	if err != nil {
+		t.Fatalf("Failed to provision the shared project: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := sharedProjectFixture.release(); err != nil {
+			t.Errorf("Failed to delete the shared project %s: %v", projectID, err)
+		}
+	})
+	return projectID
+}
+
+// HclSharedProjectDataSource HCL describing the shared project as an `azuredevops_project` data source
+// named `project`
+func HclSharedProjectDataSource(projectID string) string {
+	return fmt.Sprintf(`
+data "azuredevops_project" "project" {
+  project_id = "%s"
+}`, projectID)
+}
+
+func (p *sharedProject) acquire(t *testing.T) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.refCount == 0 {
+		if err := p.create(t); err != nil {
+			return "", err
+		}
+	}
+	p.refCount++
+	return p.data.Id(), nil
+}
+
+func (p *sharedProject) release() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.refCount--
+	if p.refCount > 0 {
+		return nil
+	}
+
+	r := p.provider.ResourcesMap["azuredevops_project"]
+	diags := r.DeleteContext(context.Background(), p.data, p.provider.Meta())
+	p.data = nil
+	if diags.HasError() {
+		return fmt.Errorf("%v", diags)
+	}
+	return nil
+}
+
+func (p *sharedProject) create(t *testing.T) error {
+	if p.provider == nil {
+		// a dedicated provider instance, so the shared one is still configured by the test framework
+		provider := azuredevops.Provider()
+		diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+		if diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+		p.provider = provider
+	}
+
+	// project deletion is asynchronous, so a project recreated after all references were released gets a new name
+	p.generation++
+	name := fmt.Sprintf("test-acc-shared-%s-%d", sharedProjectRunID, p.generation)
+	r := p.provider.ResourcesMap["azuredevops_project"]
+	data := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":               name,
+		"description":        name + "-description",
+		"visibility":         "private",
+		"version_control":    "Git",
+		"work_item_template": "Agile",
+	})
+
+	diags := r.CreateContext(context.Background(), data, p.provider.Meta().(*client.AggregatedClient))
+	if diags.HasError() {
+		return fmt.Errorf("%v", diags)
+	}
+	p.data = data
+	return nil
+}
@@ -8,28 +8,39 @@ import (
 	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/elastic"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/featuremanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinesapproval"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelineschecks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/advancedsecurity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelineschecksextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tokens"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/upackextras"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -41,29 +52,40 @@ import (
 // allow for mocking to support unit testing of the funcs that invoke the
 // Azure DevOps client.
 type AggregatedClient struct {
-	OrganizationURL               string
-	CoreClient                    core.Client
-	BuildClient                   build.Client
-	PipelinesClient               pipelines.Client
-	GitReposClient                git.Client
-	GraphClient                   graph.Client
-	OperationsClient              operations.Client
-	PipelinesChecksClient         pipelineschecks.Client
-	PipelinePermissionsClient     pipelinepermissions.Client
-	PipelinesChecksClientExtras   pipelineschecksextras.Client
-	PolicyClient                  policy.Client
-	ElasticClient                 elastic.Client
-	ReleaseClient                 release.Client
-	ServiceEndpointClient         serviceendpoint.Client
-	TaskAgentClient               taskagent.Client
-	MemberEntitleManagementClient memberentitlementmanagement.Client
-	FeatureManagementClient       featuremanagement.Client
-	SecurityClient                security.Client
-	IdentityClient                identity.Client
-	WorkItemTrackingClient        workitemtracking.Client
-	ServiceHooksClient            servicehooks.Client
-	Ctx                           context.Context
-	SecurityRolesClient           securityroles.Client
+	OrganizationURL                     string
+	AuditClient                         audit.Client
+	CoreClient                          core.Client
+	BuildClient                         build.Client
+	PipelinesClient                     pipelines.Client
+	GitReposClient                      git.Client
+	GraphClient                         graph.Client
+	OperationsClient                    operations.Client
+	PipelinesApprovalClient             pipelinesapproval.Client
+	PipelinesChecksClient               pipelineschecks.Client
+	PipelinePermissionsClient           pipelinepermissions.Client
+	PipelinesChecksClientExtras         pipelineschecksextras.Client
+	PolicyClient                        policy.Client
+	ElasticClient                       elastic.Client
+	ReleaseClient                       release.Client
+	ServiceEndpointClient               serviceendpoint.Client
+	TaskAgentClient                     taskagent.Client
+	MemberEntitleManagementClient       memberentitlementmanagement.Client
+	MemberEntitleManagementClientExtras memberentitlementmanagementextras.Client
+	FeatureManagementClient             featuremanagement.Client
+	FeedClient                          feed.Client
+	NuGetClient                         nuget.Client
+	NpmClient                           npm.Client
+	MavenClient                         maven.Client
+	PyPiClient                          pypiapi.Client
+	UPackClientExtras                   upackextras.Client
+	SecurityClient                      security.Client
+	IdentityClient                      identity.Client
+	WorkItemTrackingClient              workitemtracking.Client
+	ServiceHooksClient                  servicehooks.Client
+	Ctx                                 context.Context
+	SecurityRolesClient                 securityroles.Client
+	TokensClient                        tokens.Client
+	AdvancedSecurityClient              advancedsecurity.Client
 }
 
 // GetAzdoClient builds and provides a connection to the Azure DevOps API
@@ -86,6 +108,12 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 		return nil, err
 	}
 
+	auditClient, err := audit.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): audit.NewClient failed.")
+		return nil, err
+	}
+
 	buildClient, err := build.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): build.NewClient failed.")
@@ -126,6 +154,12 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 		return nil, err
 	}
 
+	memberentitlementmanagementClientExtras, err := memberentitlementmanagementextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): memberentitlementmanagementextras.NewClient failed.")
+		return nil, err
+	}
+
 	policyClient, err := policy.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): policy.NewClient failed.")
@@ -147,6 +181,42 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 
 	featuremanagementClient := featuremanagement.NewClient(ctx, connection)
 
+	feedClient, err := feed.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): feed.NewClient failed.")
+		return nil, err
+	}
+
+	nugetClient, err := nuget.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): nuget.NewClient failed.")
+		return nil, err
+	}
+
+	npmClient, err := npm.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): npm.NewClient failed.")
+		return nil, err
+	}
+
+	mavenClient, err := maven.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): maven.NewClient failed.")
+		return nil, err
+	}
+
+	pypiClient, err := pypiapi.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pypiapi.NewClient failed.")
+		return nil, err
+	}
+
+	upackClientExtras, err := upackextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): upackextras.NewClient failed.")
+		return nil, err
+	}
+
 	workitemtrackingClient, err := workitemtracking.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): workitemtracking.NewClient failed.")
@@ -155,6 +225,12 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 
 	pipelines := pipelines.NewClient(ctx, connection)
 
+	pipelinesApprovalClient, err := pipelinesapproval.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pipelinesapproval.NewClient failed.")
+		return nil, err
+	}
+
 	pipelinesChecksClient, err := pipelineschecks.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): pipelineschecks.NewClient failed.")
@@ -177,30 +253,45 @@ func GetAzdoClient(azdoTokenProvider func() (string, error), organizationURL str
 
 	securityRolesClient := securityroles.NewClient(ctx, connection)
 
+	tokensClient := tokens.NewClient(ctx, connection)
+
+	advancedSecurityClient := advancedsecurity.NewClient(ctx, connection)
+
 	aggregatedClient := &AggregatedClient{
-		OrganizationURL:               organizationURL,
-		CoreClient:                    coreClient,
-		BuildClient:                   buildClient,
-		ElasticClient:                 elasticClient,
-		GitReposClient:                gitReposClient,
-		GraphClient:                   graphClient,
-		OperationsClient:              operationsClient,
-		PipelinesClient:               pipelines,
-		PipelinesChecksClient:         pipelinesChecksClient,
-		PipelinePermissionsClient:     pipelinepermissionsClient,
-		PipelinesChecksClientExtras:   pipelinesChecksClientExtras,
-		PolicyClient:                  policyClient,
-		ReleaseClient:                 releaseClient,
-		ServiceEndpointClient:         serviceEndpointClient,
-		TaskAgentClient:               taskagentClient,
-		MemberEntitleManagementClient: memberentitlementmanagementClient,
-		FeatureManagementClient:       featuremanagementClient,
-		SecurityClient:                securityClient,
-		IdentityClient:                identityClient,
-		WorkItemTrackingClient:        workitemtrackingClient,
-		ServiceHooksClient:            serviceHooksClient,
-		SecurityRolesClient:           securityRolesClient,
-		Ctx:                           ctx,
+		OrganizationURL:                     organizationURL,
+		AuditClient:                         auditClient,
+		CoreClient:                          coreClient,
+		BuildClient:                         buildClient,
+		ElasticClient:                       elasticClient,
+		GitReposClient:                      gitReposClient,
+		GraphClient:                         graphClient,
+		OperationsClient:                    operationsClient,
+		PipelinesClient:                     pipelines,
+		PipelinesApprovalClient:             pipelinesApprovalClient,
+		PipelinesChecksClient:               pipelinesChecksClient,
+		PipelinePermissionsClient:           pipelinepermissionsClient,
+		PipelinesChecksClientExtras:         pipelinesChecksClientExtras,
+		PolicyClient:                        policyClient,
+		ReleaseClient:                       releaseClient,
+		ServiceEndpointClient:               serviceEndpointClient,
+		TaskAgentClient:                     taskagentClient,
+		MemberEntitleManagementClient:       memberentitlementmanagementClient,
+		MemberEntitleManagementClientExtras: memberentitlementmanagementClientExtras,
+		FeatureManagementClient:             featuremanagementClient,
+		FeedClient:                          feedClient,
+		NuGetClient:                         nugetClient,
+		NpmClient:                           npmClient,
+		MavenClient:                         mavenClient,
+		PyPiClient:                          pypiClient,
+		UPackClientExtras:                   upackClientExtras,
+		SecurityClient:                      securityClient,
+		IdentityClient:                      identityClient,
+		WorkItemTrackingClient:              workitemtrackingClient,
+		ServiceHooksClient:                  serviceHooksClient,
+		SecurityRolesClient:                 securityRolesClient,
+		TokensClient:                        tokensClient,
+		AdvancedSecurityClient:              advancedSecurityClient,
+		Ctx:                                 ctx,
 	}
 
 	log.Printf("getAzdoClient(): Created core, build, operations, and serviceendpoint clients successfully!")
@@ -0,0 +1,178 @@
+package advancedsecurity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/advancedsecurity"
+)
+
+// ResourceAdvancedSecurity schema and implementation for the Advanced Security enablement of a project or repository
+func ResourceAdvancedSecurity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAdvancedSecurityCreateUpdate,
+		Read:   resourceAdvancedSecurityRead,
+		Update: resourceAdvancedSecurityCreateUpdate,
+		Delete: resourceAdvancedSecurityDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAdvancedSecurityImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"block_pushes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_on_create": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"repository_id"},
+			},
+		},
+	}
+}
+
+func resourceAdvancedSecurityCreateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repositoryID := d.Get("repository_id").(string)
+
+	err := updateAdvancedSecurityEnablement(clients, projectID, repositoryID,
+		d.Get("enabled").(bool), d.Get("block_pushes").(bool), d.Get("enable_on_create").(bool))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(advancedSecurityID(projectID, repositoryID))
+	return resourceAdvancedSecurityRead(d, m)
+}
+
+func resourceAdvancedSecurityRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repositoryID := d.Get("repository_id").(string)
+
+	if repositoryID != "" {
+		enablement, err := clients.AdvancedSecurityClient.GetRepositoryEnablement(clients.Ctx, &advancedsecurity.GetRepositoryEnablementArgs{
+			Project:    converter.String(projectID),
+			Repository: converter.String(repositoryID),
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf(" reading Advanced Security enablement of repository %s: %+v", repositoryID, err)
+		}
+		d.Set("enabled", converter.ToBool(enablement.AdvSecEnabled, false))
+		d.Set("block_pushes", converter.ToBool(enablement.BlockPushes, false))
+		return nil
+	}
+
+	enablement, err := clients.AdvancedSecurityClient.GetProjectEnablement(clients.Ctx, &advancedsecurity.GetProjectEnablementArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading Advanced Security enablement of project %s: %+v", projectID, err)
+	}
+	d.Set("enabled", converter.ToBool(enablement.AdvSecEnabled, false))
+	d.Set("block_pushes", converter.ToBool(enablement.BlockPushes, false))
+	d.Set("enable_on_create", converter.ToBool(enablement.EnableOnCreate, false))
+	return nil
+}
+
+// resourceAdvancedSecurityDelete disables Advanced Security, which stops the billing of the committers
+func resourceAdvancedSecurityDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	err := updateAdvancedSecurityEnablement(clients, d.Get("project_id").(string), d.Get("repository_id").(string), false, false, false)
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceAdvancedSecurityImport accepts <project_id> for the enablement of a project and
+// <project_id>/<repository_id> for the enablement of a repository
+func resourceAdvancedSecurityImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf(" Unexpected format of import ID %s, expected <project_id> or <project_id>/<repository_id>", d.Id())
+	}
+	for _, part := range parts {
+		if _, err := uuid.Parse(part); err != nil {
+			return nil, fmt.Errorf(" %s of import ID %s is not a UUID: %+v", part, d.Id(), err)
+		}
+	}
+
+	d.Set("project_id", parts[0])
+	if len(parts) == 2 {
+		d.Set("repository_id", parts[1])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateAdvancedSecurityEnablement(clients *client.AggregatedClient, projectID string, repositoryID string, enabled bool, blockPushes bool, enableOnCreate bool) error {
+	if repositoryID != "" {
+		err := clients.AdvancedSecurityClient.UpdateRepositoryEnablement(clients.Ctx, &advancedsecurity.UpdateRepositoryEnablementArgs{
+			Project:    converter.String(projectID),
+			Repository: converter.String(repositoryID),
+			Settings: &advancedsecurity.RepositoryEnablementUpdate{
+				AdvSecEnabled: converter.Bool(enabled),
+				BlockPushes:   converter.Bool(blockPushes),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf(" updating Advanced Security enablement of repository %s: %+v", repositoryID, err)
+		}
+		return nil
+	}
+
+	err := clients.AdvancedSecurityClient.UpdateProjectEnablement(clients.Ctx, &advancedsecurity.UpdateProjectEnablementArgs{
+		Project: converter.String(projectID),
+		Settings: &advancedsecurity.ProjectEnablementUpdate{
+			AdvSecEnabled:  converter.Bool(enabled),
+			BlockPushes:    converter.Bool(blockPushes),
+			EnableOnCreate: converter.Bool(enableOnCreate),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" updating Advanced Security enablement of project %s: %+v", projectID, err)
+	}
+	return nil
+}
+
+func advancedSecurityID(projectID string, repositoryID string) string {
+	if repositoryID == "" {
+		return projectID
+	}
+	return projectID + "/" + repositoryID
+}
@@ -0,0 +1,139 @@
+//go:build (all || resource_advanced_security) && !exclude_advancedsecurity
+// +build all resource_advanced_security
+// +build !exclude_advancedsecurity
+
+package advancedsecurity
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/advancedsecurity"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	advSecProjectID    = uuid.New().String()
+	advSecRepositoryID = uuid.New().String()
+)
+
+// verifies that the enablement of a repository is updated with the repository API
+func TestAdvancedSecurity_Create_Repository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, map[string]interface{}{
+		"project_id":    advSecProjectID,
+		"repository_id": advSecRepositoryID,
+		"block_pushes":  true,
+	})
+
+	advSecClient.
+		EXPECT().
+		UpdateRepositoryEnablement(clients.Ctx, &advancedsecurity.UpdateRepositoryEnablementArgs{
+			Project:    converter.String(advSecProjectID),
+			Repository: converter.String(advSecRepositoryID),
+			Settings: &advancedsecurity.RepositoryEnablementUpdate{
+				AdvSecEnabled: converter.Bool(true),
+				BlockPushes:   converter.Bool(true),
+			},
+		}).
+		Return(nil).
+		Times(1)
+	advSecClient.
+		EXPECT().
+		GetRepositoryEnablement(clients.Ctx, gomock.Any()).
+		Return(&advancedsecurity.RepositoryEnablementSettings{
+			AdvSecEnabled: converter.Bool(true),
+			BlockPushes:   converter.Bool(true),
+		}, nil).
+		Times(1)
+
+	err := resourceAdvancedSecurityCreateUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, advSecProjectID+"/"+advSecRepositoryID, resourceData.Id())
+	require.True(t, resourceData.Get("enabled").(bool))
+	require.True(t, resourceData.Get("block_pushes").(bool))
+}
+
+// verifies that the create operation fails if the project enablement cannot be updated
+func TestAdvancedSecurity_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, map[string]interface{}{
+		"project_id":       advSecProjectID,
+		"enable_on_create": true,
+	})
+
+	advSecClient.
+		EXPECT().
+		UpdateProjectEnablement(clients.Ctx, &advancedsecurity.UpdateProjectEnablementArgs{
+			Project: converter.String(advSecProjectID),
+			Settings: &advancedsecurity.ProjectEnablementUpdate{
+				AdvSecEnabled:  converter.Bool(true),
+				BlockPushes:    converter.Bool(false),
+				EnableOnCreate: converter.Bool(true),
+			},
+		}).
+		Return(errors.New("UpdateProjectEnablement() Failed")).
+		Times(1)
+
+	err := resourceAdvancedSecurityCreateUpdate(resourceData, clients)
+	require.Regexp(t, ".*UpdateProjectEnablement\\(\\) Failed$", err.Error())
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a repository which no longer exists is removed from the state
+func TestAdvancedSecurity_Read_RepositoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, nil)
+	resourceData.SetId(advSecProjectID + "/" + advSecRepositoryID)
+	resourceData.Set("project_id", advSecProjectID)
+	resourceData.Set("repository_id", advSecRepositoryID)
+
+	advSecClient.
+		EXPECT().
+		GetRepositoryEnablement(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := resourceAdvancedSecurityRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the import accepts project and repository IDs
+func TestAdvancedSecurity_Import(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, nil)
+	resourceData.SetId(advSecProjectID + "/" + advSecRepositoryID)
+	_, err := resourceAdvancedSecurityImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, advSecProjectID, resourceData.Get("project_id"))
+	require.Equal(t, advSecRepositoryID, resourceData.Get("repository_id"))
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, nil)
+	resourceData.SetId("not-a-project/" + advSecRepositoryID)
+	_, err = resourceAdvancedSecurityImport(resourceData, nil)
+	require.NotNil(t, err)
+}
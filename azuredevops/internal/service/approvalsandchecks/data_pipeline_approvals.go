@@ -0,0 +1,199 @@
+package approvalsandchecks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinesapproval"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataPipelineApprovals schema and implementation for pipeline approvals data source
+func DataPipelineApprovals() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePipelineApprovalsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(pipelinesapproval.ApprovalStatusValues.Pending),
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(pipelinesapproval.ApprovalStatusValues.Pending),
+					string(pipelinesapproval.ApprovalStatusValues.Approved),
+					string(pipelinesapproval.ApprovalStatusValues.Rejected),
+					string(pipelinesapproval.ApprovalStatusValues.Skipped),
+					string(pipelinesapproval.ApprovalStatusValues.Canceled),
+					string(pipelinesapproval.ApprovalStatusValues.TimedOut),
+					string(pipelinesapproval.ApprovalStatusValues.All),
+				}, true),
+			},
+			"approvals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instructions": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_required_approvers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"steps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assigned_approver_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"assigned_approver_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"comment": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePipelineApprovalsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	status := d.Get("status").(string)
+
+	approvals, err := clients.PipelinesApprovalClient.QueryApprovals(clients.Ctx, pipelinesapproval.QueryApprovalsArgs{
+		Project: converter.String(projectID),
+		Expand:  &pipelinesapproval.ApprovalDetailsExpandParameterValues.Steps,
+	})
+	if err != nil {
+		return fmt.Errorf(" querying approvals for project with ID %s. Error: %v", projectID, err)
+	}
+
+	results := flattenPipelineApprovals(approvals, status)
+	if err := d.Set("approvals", results); err != nil {
+		return fmt.Errorf(" setting approvals. Error: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("approvals#%s#%s", projectID, strings.ToLower(status)))
+	return nil
+}
+
+func flattenPipelineApprovals(approvals *[]pipelinesapproval.Approval, status string) []interface{} {
+	results := make([]interface{}, 0)
+	if approvals == nil {
+		return results
+	}
+
+	for _, approval := range *approvals {
+		if approval.Id == nil {
+			continue
+		}
+		approvalStatus := ""
+		if approval.Status != nil {
+			approvalStatus = string(*approval.Status)
+		}
+		if !strings.EqualFold(status, string(pipelinesapproval.ApprovalStatusValues.All)) &&
+			!strings.EqualFold(status, approvalStatus) {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"id":                     approval.Id.String(),
+			"status":                 approvalStatus,
+			"instructions":           converter.ToString(approval.Instructions, ""),
+			"min_required_approvers": 0,
+			"created_on":             "",
+			"last_modified_on":       "",
+			"steps":                  flattenPipelineApprovalSteps(approval.Steps),
+		}
+		if approval.MinRequiredApprovers != nil {
+			result["min_required_approvers"] = *approval.MinRequiredApprovers
+		}
+		if approval.CreatedOn != nil {
+			result["created_on"] = approval.CreatedOn.Time.Format(time.RFC3339)
+		}
+		if approval.LastModifiedOn != nil {
+			result["last_modified_on"] = approval.LastModifiedOn.Time.Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func flattenPipelineApprovalSteps(steps *[]pipelinesapproval.ApprovalStep) []interface{} {
+	results := make([]interface{}, 0)
+	if steps == nil {
+		return results
+	}
+
+	for _, step := range *steps {
+		result := map[string]interface{}{
+			"assigned_approver_id":   "",
+			"assigned_approver_name": "",
+			"status":                 "",
+			"comment":                converter.ToString(step.Comment, ""),
+		}
+		if step.AssignedApprover != nil {
+			result["assigned_approver_id"] = converter.ToString(step.AssignedApprover.Id, "")
+			result["assigned_approver_name"] = identityDisplayName(step.AssignedApprover)
+		}
+		if step.Status != nil {
+			result["status"] = string(*step.Status)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func identityDisplayName(identity *webapi.IdentityRef) string {
+	if identity.DisplayName != nil {
+		return *identity.DisplayName
+	}
+	return converter.ToString(identity.UniqueName, "")
+}
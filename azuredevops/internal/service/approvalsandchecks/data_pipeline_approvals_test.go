@@ -0,0 +1,126 @@
+//go:build (all || data_sources || data_pipeline_approvals) && (!exclude_data_sources || !exclude_data_pipeline_approvals)
+// +build all data_sources data_pipeline_approvals
+// +build !exclude_data_sources !exclude_data_pipeline_approvals
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinesapproval"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var approvalsTestProjectID = uuid.New().String()
+
+func getPipelineApprovalsResourceData(t *testing.T, status string) *schema.ResourceData {
+	raw := map[string]interface{}{
+		"project_id": approvalsTestProjectID,
+	}
+	if status != "" {
+		raw["status"] = status
+	}
+	return schema.TestResourceDataRaw(t, DataPipelineApprovals().Schema, raw)
+}
+
+func newTestApproval(status pipelinesapproval.ApprovalStatus) pipelinesapproval.Approval {
+	id := uuid.New()
+	return pipelinesapproval.Approval{
+		Id:                   &id,
+		Status:               &status,
+		Instructions:         converter.String("deploy"),
+		MinRequiredApprovers: converter.Int(1),
+		Steps: &[]pipelinesapproval.ApprovalStep{
+			{
+				AssignedApprover: &webapi.IdentityRef{
+					Id:          converter.String("approver-id"),
+					DisplayName: converter.String("Approver"),
+				},
+				Status: &status,
+			},
+		},
+	}
+}
+
+// verifies that an error on query is not swallowed
+func TestDataPipelineApprovals_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	approvalClient := azdosdkmocks.NewMockPipelinesapprovalClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesApprovalClient: approvalClient, Ctx: context.Background()}
+
+	approvalClient.
+		EXPECT().
+		QueryApprovals(clients.Ctx, pipelinesapproval.QueryApprovalsArgs{
+			Project: converter.String(approvalsTestProjectID),
+			Expand:  &pipelinesapproval.ApprovalDetailsExpandParameterValues.Steps,
+		}).
+		Return(nil, errors.New("QueryApprovals() Failed")).
+		Times(1)
+
+	err := dataSourcePipelineApprovalsRead(getPipelineApprovalsResourceData(t, ""), clients)
+	require.Contains(t, err.Error(), "QueryApprovals() Failed")
+}
+
+// verifies that only pending approvals are returned by default
+func TestDataPipelineApprovals_Read_FiltersPendingByDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	approvalClient := azdosdkmocks.NewMockPipelinesapprovalClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesApprovalClient: approvalClient, Ctx: context.Background()}
+
+	pending := newTestApproval(pipelinesapproval.ApprovalStatusValues.Pending)
+	approved := newTestApproval(pipelinesapproval.ApprovalStatusValues.Approved)
+	approvalClient.
+		EXPECT().
+		QueryApprovals(clients.Ctx, gomock.Any()).
+		Return(&[]pipelinesapproval.Approval{pending, approved}, nil).
+		Times(1)
+
+	resourceData := getPipelineApprovalsResourceData(t, "")
+	err := dataSourcePipelineApprovalsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	approvals := resourceData.Get("approvals").([]interface{})
+	require.Len(t, approvals, 1)
+	approval := approvals[0].(map[string]interface{})
+	require.Equal(t, pending.Id.String(), approval["id"])
+	require.Equal(t, "pending", approval["status"])
+	steps := approval["steps"].([]interface{})
+	require.Len(t, steps, 1)
+	require.Equal(t, "approver-id", steps[0].(map[string]interface{})["assigned_approver_id"])
+}
+
+// verifies that all approvals are returned for the "all" status
+func TestDataPipelineApprovals_Read_AllStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	approvalClient := azdosdkmocks.NewMockPipelinesapprovalClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesApprovalClient: approvalClient, Ctx: context.Background()}
+
+	approvalClient.
+		EXPECT().
+		QueryApprovals(clients.Ctx, gomock.Any()).
+		Return(&[]pipelinesapproval.Approval{
+			newTestApproval(pipelinesapproval.ApprovalStatusValues.Pending),
+			newTestApproval(pipelinesapproval.ApprovalStatusValues.Rejected),
+		}, nil).
+		Times(1)
+
+	resourceData := getPipelineApprovalsResourceData(t, "all")
+	err := dataSourcePipelineApprovalsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, resourceData.Get("approvals").([]interface{}), 2)
+}
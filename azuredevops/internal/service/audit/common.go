@@ -0,0 +1,268 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+type flatFunc func(d *schema.ResourceData, stream *audit.AuditStream)
+type expandFunc func(d *schema.ResourceData) (*audit.AuditStream, error)
+
+// genBaseAuditStreamResource creates a Resource with the common parts
+// that all audit streams require.
+func genBaseAuditStreamResource(f flatFunc, e expandFunc) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: genAuditStreamCreateFunc(f, e),
+		ReadContext:   genAuditStreamReadFunc(f),
+		UpdateContext: genAuditStreamUpdateFunc(f, e),
+		DeleteContext: genAuditStreamDeleteFunc(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"days_to_backfill": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 90),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"skip_backfill_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
+func doBaseExpansion(d *schema.ResourceData, consumerType string, consumerInputs map[string]string) (*audit.AuditStream, error) {
+	stream := audit.AuditStream{
+		ConsumerType:   converter.String(consumerType),
+		ConsumerInputs: &consumerInputs,
+	}
+
+	if d.Id() != "" {
+		streamID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf(" parsing audit stream ID: %+v", err)
+		}
+		stream.Id = &streamID
+	}
+
+	return &stream, nil
+}
+
+// doBaseFlattening performs the flattening for the 'base' attributes that are defined in the schema, above
+func doBaseFlattening(d *schema.ResourceData, stream *audit.AuditStream) {
+	d.SetId(strconv.Itoa(*stream.Id))
+	d.Set("display_name", stream.DisplayName)
+	if stream.Status != nil {
+		d.Set("enabled", *stream.Status != audit.AuditStreamStatusValues.DisabledByUser)
+	}
+}
+
+func genAuditStreamCreateFunc(flatFunc flatFunc, expandFunc expandFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients := m.(*client.AggregatedClient)
+		stream, err := expandFunc(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		createdStream, err := createAuditStream(ctx, d, clients, stream)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		flatFunc(d, createdStream)
+		return genAuditStreamReadFunc(flatFunc)(ctx, d, m)
+	}
+}
+
+func genAuditStreamReadFunc(flatFunc flatFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients := m.(*client.AggregatedClient)
+		streamID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return diag.Errorf(" parsing audit stream ID: %+v", err)
+		}
+
+		stream, err := clients.AuditClient.QueryStreamById(ctx, audit.QueryStreamByIdArgs{
+			StreamId: &streamID,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf(" looking up audit stream with ID %d: %+v", streamID, err)
+		}
+
+		if stream == nil || stream.Id == nil || (stream.Status != nil && *stream.Status == audit.AuditStreamStatusValues.Deleted) {
+			d.SetId("")
+			return nil
+		}
+
+		flatFunc(d, stream)
+		return nil
+	}
+}
+
+func genAuditStreamUpdateFunc(flatFunc flatFunc, expandFunc expandFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients := m.(*client.AggregatedClient)
+		stream, err := expandFunc(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		updatedStream, err := clients.AuditClient.UpdateStream(ctx, audit.UpdateStreamArgs{
+			Stream: stream,
+		})
+		if err != nil {
+			return diag.Errorf(" updating audit stream in Azure DevOps: %+v", err)
+		}
+
+		if d.HasChange("enabled") {
+			updatedStream, err = updateAuditStreamStatus(ctx, clients, updatedStream.Id, d.Get("enabled").(bool))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		flatFunc(d, updatedStream)
+		return genAuditStreamReadFunc(flatFunc)(ctx, d, m)
+	}
+}
+
+func genAuditStreamDeleteFunc() schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients := m.(*client.AggregatedClient)
+		streamID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return diag.Errorf(" parsing audit stream ID: %+v", err)
+		}
+
+		err = clients.AuditClient.DeleteStream(ctx, audit.DeleteStreamArgs{
+			StreamId: &streamID,
+		})
+		if err != nil {
+			return diag.Errorf(" deleting audit stream with ID %d: %+v", streamID, err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func createAuditStream(ctx context.Context, d *schema.ResourceData, clients *client.AggregatedClient, stream *audit.AuditStream) (*audit.AuditStream, error) {
+	createdStream, err := clients.AuditClient.CreateStream(ctx, audit.CreateStreamArgs{
+		Stream:         stream,
+		DaysToBackfill: converter.Int(d.Get("days_to_backfill").(int)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" creating audit stream in Azure DevOps: %+v", err)
+	}
+
+	// a stream replays the requested days of audit data before it starts delivering new events
+	if !d.Get("skip_backfill_wait").(bool) && createdStream.Status != nil && *createdStream.Status == audit.AuditStreamStatusValues.Backfilling {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				string(audit.AuditStreamStatusValues.Backfilling),
+				string(audit.AuditStreamStatusValues.Unknown),
+			},
+			Target:  []string{string(audit.AuditStreamStatusValues.Enabled)},
+			Refresh: auditStreamStatusRefreshFunc(ctx, clients, createdStream.Id),
+			Timeout: d.Timeout(schema.TimeoutCreate),
+			Delay:   5 * time.Second,
+
+			MinTimeout: 10 * time.Second,
+		}
+		streamValue, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			// the stream would otherwise be left behind and block a new one for the same consumer
+			if deleteErr := clients.AuditClient.DeleteStream(context.Background(), audit.DeleteStreamArgs{
+				StreamId: createdStream.Id,
+			}); deleteErr != nil {
+				return nil, fmt.Errorf(" waiting for audit stream with ID %d to finish backfilling: %+v. Deleting the audit stream also failed: %+v", *createdStream.Id, err, deleteErr)
+			}
+			return nil, fmt.Errorf(" waiting for audit stream with ID %d to finish backfilling: %+v", *createdStream.Id, err)
+		}
+		createdStream = streamValue.(*audit.AuditStream)
+	}
+
+	if !d.Get("enabled").(bool) {
+		return updateAuditStreamStatus(ctx, clients, createdStream.Id, false)
+	}
+	return createdStream, nil
+}
+
+func updateAuditStreamStatus(ctx context.Context, clients *client.AggregatedClient, streamID *int, enabled bool) (*audit.AuditStream, error) {
+	status := audit.AuditStreamStatusValues.DisabledByUser
+	if enabled {
+		status = audit.AuditStreamStatusValues.Enabled
+	}
+
+	stream, err := clients.AuditClient.UpdateStatus(ctx, audit.UpdateStatusArgs{
+		StreamId: streamID,
+		Status:   &status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" updating status of audit stream with ID %d: %+v", *streamID, err)
+	}
+	return stream, nil
+}
+
+func auditStreamStatusRefreshFunc(ctx context.Context, clients *client.AggregatedClient, streamID *int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stream, err := clients.AuditClient.QueryStreamById(ctx, audit.QueryStreamByIdArgs{
+			StreamId: streamID,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if stream == nil {
+			return nil, string(audit.AuditStreamStatusValues.Unknown), nil
+		}
+		if stream.Status == nil {
+			return stream, string(audit.AuditStreamStatusValues.Unknown), nil
+		}
+		return stream, string(*stream.Status), nil
+	}
+}
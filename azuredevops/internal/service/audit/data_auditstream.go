@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// consumer inputs holding secrets, which are never exposed by the data sources
+var secretConsumerInputs = map[string]bool{
+	"SplunkEventCollectorToken": true,
+	"SharedKey":                 true,
+	"EventGridTopicAccessKey":   true,
+	"DatadogApiKey":             true,
+}
+
+// DataAuditStream schema and implementation for audit stream data source
+func DataAuditStream() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuditStreamRead,
+		Schema: map[string]*schema.Schema{
+			"stream_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"stream_id", "consumer_type"},
+			},
+			"consumer_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"stream_id", "consumer_type"},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"consumer_inputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuditStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	var stream *audit.AuditStream
+	if v, ok := d.GetOk("stream_id"); ok {
+		streamID := v.(int)
+		found, err := clients.AuditClient.QueryStreamById(ctx, audit.QueryStreamByIdArgs{
+			StreamId: &streamID,
+		})
+		if err != nil {
+			return diag.Errorf(" looking up audit stream with ID %d: %+v", streamID, err)
+		}
+		if found == nil || found.Id == nil || isAuditStreamDeleted(found) {
+			return diag.Errorf(" Unable to find audit stream with ID %d", streamID)
+		}
+		stream = found
+	} else {
+		consumerType := d.Get("consumer_type").(string)
+		found, err := getAuditStreamByConsumerType(ctx, clients, consumerType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		stream = found
+	}
+
+	d.SetId(strconv.Itoa(*stream.Id))
+	d.Set("stream_id", *stream.Id)
+	d.Set("consumer_type", stream.ConsumerType)
+	d.Set("display_name", stream.DisplayName)
+	d.Set("status_reason", stream.StatusReason)
+	if stream.Status != nil {
+		d.Set("status", string(*stream.Status))
+	}
+	d.Set("consumer_inputs", flattenAuditStreamConsumerInputs(stream.ConsumerInputs))
+	return nil
+}
+
+func getAuditStreamByConsumerType(ctx context.Context, clients *client.AggregatedClient, consumerType string) (*audit.AuditStream, error) {
+	streams, err := clients.AuditClient.QueryAllStreams(ctx, audit.QueryAllStreamsArgs{})
+	if err != nil {
+		return nil, fmt.Errorf(" listing audit streams: %+v", err)
+	}
+
+	var matches []audit.AuditStream
+	if streams != nil {
+		for _, stream := range *streams {
+			if stream.ConsumerType == nil || !strings.EqualFold(*stream.ConsumerType, consumerType) {
+				continue
+			}
+			if stream.Id == nil || isAuditStreamDeleted(&stream) {
+				continue
+			}
+			matches = append(matches, stream)
+		}
+	}
+
+	if len(matches) > 1 {
+		return nil, fmt.Errorf(" Found %d audit streams with consumer type %s, use stream_id to select one", len(matches), consumerType)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf(" Unable to find audit stream with consumer type %s", consumerType)
+	}
+	return &matches[0], nil
+}
+
+func isAuditStreamDeleted(stream *audit.AuditStream) bool {
+	return stream.Status != nil && *stream.Status == audit.AuditStreamStatusValues.Deleted
+}
+
+func flattenAuditStreamConsumerInputs(consumerInputs *map[string]string) map[string]interface{} {
+	inputs := map[string]interface{}{}
+	if consumerInputs == nil {
+		return inputs
+	}
+
+	for k, v := range *consumerInputs {
+		if secretConsumerInputs[k] {
+			continue
+		}
+		inputs[k] = v
+	}
+	return inputs
+}
@@ -0,0 +1,168 @@
+//go:build (all || data_sources || data_auditstream) && (!exclude_data_sources || !exclude_data_auditstream)
+// +build all data_sources data_auditstream
+// +build !exclude_data_sources !exclude_data_auditstream
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var dataTestAuditStreams = []audit.AuditStream{
+	{
+		Id:           converter.Int(1),
+		ConsumerType: converter.String("Splunk"),
+		DisplayName:  converter.String("splunk.example.com"),
+		Status:       &audit.AuditStreamStatusValues.Deleted,
+		ConsumerInputs: &map[string]string{
+			"SplunkUrl": "https://old.example.com:8088",
+		},
+	},
+	{
+		Id:           converter.Int(2),
+		ConsumerType: converter.String("Splunk"),
+		DisplayName:  converter.String("splunk.example.com"),
+		Status:       &audit.AuditStreamStatusValues.Enabled,
+		ConsumerInputs: &map[string]string{
+			"SplunkUrl":                 "https://splunk.example.com:8088",
+			"SplunkEventCollectorToken": "00000000-0000-0000-0000-000000000001",
+		},
+	},
+	{
+		Id:           converter.Int(3),
+		ConsumerType: converter.String("AzureMonitorLogs"),
+		DisplayName:  converter.String("workspace"),
+		Status:       &audit.AuditStreamStatusValues.DisabledByUser,
+		StatusReason: converter.String("Disabled by user"),
+		ConsumerInputs: &map[string]string{
+			"WorkspaceId": "00000000-0000-0000-0000-000000000002",
+		},
+	},
+}
+
+// verifies that a stream is found by its consumer type, skipping deleted streams and secret consumer inputs
+func TestDataSourceAuditStream_Read_ByConsumerType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryAllStreams(clients.Ctx, audit.QueryAllStreamsArgs{}).
+		Return(&dataTestAuditStreams, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStream().Schema, map[string]interface{}{
+		"consumer_type": "splunk",
+	})
+	diags := dataSourceAuditStreamRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "2", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("stream_id"))
+	require.Equal(t, "enabled", resourceData.Get("status"))
+	require.Equal(t, map[string]interface{}{
+		"SplunkUrl": "https://splunk.example.com:8088",
+	}, resourceData.Get("consumer_inputs"))
+}
+
+// verifies that a stream is found by its ID
+func TestDataSourceAuditStream_Read_ById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: converter.Int(3)}).
+		Return(&dataTestAuditStreams[2], nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStream().Schema, map[string]interface{}{
+		"stream_id": 3,
+	})
+	diags := dataSourceAuditStreamRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "AzureMonitorLogs", resourceData.Get("consumer_type"))
+	require.Equal(t, "disabledByUser", resourceData.Get("status"))
+	require.Equal(t, "Disabled by user", resourceData.Get("status_reason"))
+	require.Equal(t, "workspace", resourceData.Get("display_name"))
+}
+
+// verifies that an ambiguous consumer type is reported
+func TestDataSourceAuditStream_Read_MultipleStreamsOfConsumerType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	streams := append([]audit.AuditStream{}, dataTestAuditStreams...)
+	streams[0].Status = &audit.AuditStreamStatusValues.Enabled
+	auditClient.
+		EXPECT().
+		QueryAllStreams(clients.Ctx, audit.QueryAllStreamsArgs{}).
+		Return(&streams, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStream().Schema, map[string]interface{}{
+		"consumer_type": "Splunk",
+	})
+	diags := dataSourceAuditStreamRead(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "Found 2 audit streams with consumer type Splunk")
+}
+
+// verifies that a deleted stream is not returned when looked up by its ID
+func TestDataSourceAuditStream_Read_ByIdDeletedStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: converter.Int(1)}).
+		Return(&dataTestAuditStreams[0], nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStream().Schema, map[string]interface{}{
+		"stream_id": 1,
+	})
+	diags := dataSourceAuditStreamRead(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "Unable to find audit stream with ID 1")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an empty response is reported instead of being dereferenced
+func TestDataSourceAuditStream_Read_ByIdEmptyResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: converter.Int(4)}).
+		Return(nil, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStream().Schema, map[string]interface{}{
+		"stream_id": 4,
+	})
+	diags := dataSourceAuditStreamRead(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "Unable to find audit stream with ID 4")
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// DataAuditStreams schema and implementation for audit streams data source
+func DataAuditStreams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuditStreamsRead,
+		Schema: map[string]*schema.Schema{
+			"streams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"consumer_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_inputs": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuditStreamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	streams, err := clients.AuditClient.QueryAllStreams(ctx, audit.QueryAllStreamsArgs{})
+	if err != nil {
+		return diag.Errorf(" listing audit streams: %+v", err)
+	}
+
+	if err := d.Set("streams", flattenAuditStreams(streams)); err != nil {
+		return diag.Errorf(" setting streams: %+v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+// streams marked for deletion are left out
+func flattenAuditStreams(streams *[]audit.AuditStream) []interface{} {
+	results := make([]interface{}, 0)
+	if streams == nil {
+		return results
+	}
+
+	for _, stream := range *streams {
+		if stream.Status != nil && *stream.Status == audit.AuditStreamStatusValues.Deleted {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"consumer_inputs": flattenAuditStreamConsumerInputs(stream.ConsumerInputs),
+		}
+		if stream.Id != nil {
+			output["id"] = *stream.Id
+		}
+		if stream.ConsumerType != nil {
+			output["consumer_type"] = *stream.ConsumerType
+		}
+		if stream.DisplayName != nil {
+			output["display_name"] = *stream.DisplayName
+		}
+		if stream.Status != nil {
+			output["status"] = string(*stream.Status)
+		}
+		if stream.StatusReason != nil {
+			output["status_reason"] = *stream.StatusReason
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,95 @@
+//go:build (all || data_sources || data_auditstreams) && (!exclude_data_sources || !exclude_data_auditstreams)
+// +build all data_sources data_auditstreams
+// +build !exclude_data_sources !exclude_data_auditstreams
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that all streams except the deleted ones are returned, without secret consumer inputs
+func TestDataSourceAuditStreams_Read_SkipsDeletedStreams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	streams := []audit.AuditStream{
+		{
+			Id:           converter.Int(1),
+			ConsumerType: converter.String("Splunk"),
+			Status:       &audit.AuditStreamStatusValues.Deleted,
+		},
+		{
+			Id:           converter.Int(2),
+			ConsumerType: converter.String("Splunk"),
+			DisplayName:  converter.String("splunk.example.com"),
+			Status:       &audit.AuditStreamStatusValues.Enabled,
+			ConsumerInputs: &map[string]string{
+				"SplunkUrl":                 "https://splunk.example.com:8088",
+				"SplunkEventCollectorToken": "00000000-0000-0000-0000-000000000001",
+			},
+		},
+		{
+			Id:           converter.Int(3),
+			ConsumerType: converter.String("AzureEventGrid"),
+			Status:       &audit.AuditStreamStatusValues.DisabledBySystem,
+			StatusReason: converter.String("Invalid credentials"),
+		},
+	}
+	auditClient.
+		EXPECT().
+		QueryAllStreams(clients.Ctx, audit.QueryAllStreamsArgs{}).
+		Return(&streams, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStreams().Schema, nil)
+	diags := dataSourceAuditStreamsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	result := resourceData.Get("streams").([]interface{})
+	require.Len(t, result, 2)
+	require.Equal(t, map[string]interface{}{
+		"id":            2,
+		"consumer_type": "Splunk",
+		"display_name":  "splunk.example.com",
+		"status":        "enabled",
+		"status_reason": "",
+		"consumer_inputs": map[string]interface{}{
+			"SplunkUrl": "https://splunk.example.com:8088",
+		},
+	}, result[0])
+	require.Equal(t, "disabledBySystem", result[1].(map[string]interface{})["status"])
+	require.Equal(t, "Invalid credentials", result[1].(map[string]interface{})["status_reason"])
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestDataSourceAuditStreams_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryAllStreams(clients.Ctx, audit.QueryAllStreamsArgs{}).
+		Return(nil, errors.New("QueryAllStreams() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAuditStreams().Schema, nil)
+	diags := dataSourceAuditStreamsRead(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "QueryAllStreams() Failed")
+}
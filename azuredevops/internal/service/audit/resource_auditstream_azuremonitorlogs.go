@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+)
+
+const (
+	azureMonitorLogsWorkspaceId = "workspace_id"
+	azureMonitorLogsSharedKey   = "shared_key"
+)
+
+// ResourceAuditStreamAzureMonitorLogs schema and implementation for Azure Monitor Logs audit stream resource
+func ResourceAuditStreamAzureMonitorLogs() *schema.Resource {
+	r := genBaseAuditStreamResource(flattenAzureMonitorLogsAuditStream, expandAzureMonitorLogsAuditStream)
+
+	r.Schema[azureMonitorLogsWorkspaceId] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsUUID,
+		Description:  "The ID of the Log Analytics workspace",
+	}
+
+	r.Schema[azureMonitorLogsSharedKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The primary or secondary key of the Log Analytics workspace",
+	}
+
+	return r
+}
+
+func expandAzureMonitorLogsAuditStream(d *schema.ResourceData) (*audit.AuditStream, error) {
+	return doBaseExpansion(d, "AzureMonitorLogs", map[string]string{
+		"WorkspaceId": d.Get(azureMonitorLogsWorkspaceId).(string),
+		"SharedKey":   d.Get(azureMonitorLogsSharedKey).(string),
+	})
+}
+
+// the shared key is not returned by Azure DevOps, so the configured value is kept
+func flattenAzureMonitorLogsAuditStream(d *schema.ResourceData, stream *audit.AuditStream) {
+	doBaseFlattening(d, stream)
+
+	if stream.ConsumerInputs != nil {
+		d.Set(azureMonitorLogsWorkspaceId, (*stream.ConsumerInputs)["WorkspaceId"])
+	}
+}
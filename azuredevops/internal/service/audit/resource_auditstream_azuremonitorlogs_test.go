@@ -0,0 +1,55 @@
+//go:build (all || resource_auditstream_azuremonitorlogs) && !exclude_auditstreams
+// +build all resource_auditstream_azuremonitorlogs
+// +build !exclude_auditstreams
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var azureMonitorLogsTestStreamID = 7
+
+var azureMonitorLogsTestStream = audit.AuditStream{
+	Id:           &azureMonitorLogsTestStreamID,
+	ConsumerType: converter.String("AzureMonitorLogs"),
+	ConsumerInputs: &map[string]string{
+		"WorkspaceId": "00000000-0000-0000-0000-000000000002",
+		"SharedKey":   "c2hhcmVkLWtleQ==",
+	},
+}
+
+func getAzureMonitorLogsTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceAuditStreamAzureMonitorLogs().Schema, map[string]interface{}{
+		"workspace_id": "00000000-0000-0000-0000-000000000002",
+		"shared_key":   "c2hhcmVkLWtleQ==",
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same audit stream
+func TestAuditStreamAzureMonitorLogs_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := getAzureMonitorLogsTestResourceData(t)
+	flattenAzureMonitorLogsAuditStream(resourceData, &azureMonitorLogsTestStream)
+
+	streamAfterRoundTrip, err := expandAzureMonitorLogsAuditStream(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, azureMonitorLogsTestStream, *streamAfterRoundTrip)
+}
+
+// verifies that the shared key is kept, as Azure DevOps does not return it
+func TestAuditStreamAzureMonitorLogs_Flatten_KeepsSharedKey(t *testing.T) {
+	resourceData := getAzureMonitorLogsTestResourceData(t)
+	stream := azureMonitorLogsTestStream
+	stream.ConsumerInputs = &map[string]string{
+		"WorkspaceId": "00000000-0000-0000-0000-000000000002",
+	}
+	flattenAzureMonitorLogsAuditStream(resourceData, &stream)
+
+	require.Equal(t, "c2hhcmVkLWtleQ==", resourceData.Get("shared_key"))
+	require.Equal(t, "7", resourceData.Id())
+}
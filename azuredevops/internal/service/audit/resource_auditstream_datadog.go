@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+)
+
+const (
+	datadogSite   = "site"
+	datadogApiKey = "api_key"
+)
+
+var datadogSites = []string{
+	"datadoghq.com",
+	"us3.datadoghq.com",
+	"us5.datadoghq.com",
+	"datadoghq.eu",
+	"ap1.datadoghq.com",
+	"ddog-gov.com",
+}
+
+// ResourceAuditStreamDatadog schema and implementation for Datadog audit stream resource
+func ResourceAuditStreamDatadog() *schema.Resource {
+	r := genBaseAuditStreamResource(flattenDatadogAuditStream, expandDatadogAuditStream)
+
+	r.Schema[datadogSite] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "datadoghq.com",
+		ValidateFunc: validation.StringInSlice(datadogSites, false),
+		Description:  "The Datadog site the events are sent to",
+	}
+
+	r.Schema[datadogApiKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The Datadog API key",
+	}
+
+	return r
+}
+
+func expandDatadogAuditStream(d *schema.ResourceData) (*audit.AuditStream, error) {
+	return doBaseExpansion(d, "Datadog", map[string]string{
+		"DatadogSite":   d.Get(datadogSite).(string),
+		"DatadogApiKey": d.Get(datadogApiKey).(string),
+	})
+}
+
+// the API key is not returned by Azure DevOps, so the configured value is kept
+func flattenDatadogAuditStream(d *schema.ResourceData, stream *audit.AuditStream) {
+	doBaseFlattening(d, stream)
+
+	if stream.ConsumerInputs != nil {
+		d.Set(datadogSite, (*stream.ConsumerInputs)["DatadogSite"])
+	}
+}
@@ -0,0 +1,55 @@
+//go:build (all || resource_auditstream_datadog) && !exclude_auditstreams
+// +build all resource_auditstream_datadog
+// +build !exclude_auditstreams
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var datadogTestStreamID = 7
+
+var datadogTestStream = audit.AuditStream{
+	Id:           &datadogTestStreamID,
+	ConsumerType: converter.String("Datadog"),
+	ConsumerInputs: &map[string]string{
+		"DatadogSite":   "datadoghq.eu",
+		"DatadogApiKey": "0123456789abcdef0123456789abcdef",
+	},
+}
+
+func getDatadogTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceAuditStreamDatadog().Schema, map[string]interface{}{
+		"site":    "datadoghq.eu",
+		"api_key": "0123456789abcdef0123456789abcdef",
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same audit stream
+func TestAuditStreamDatadog_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := getDatadogTestResourceData(t)
+	flattenDatadogAuditStream(resourceData, &datadogTestStream)
+
+	streamAfterRoundTrip, err := expandDatadogAuditStream(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, datadogTestStream, *streamAfterRoundTrip)
+}
+
+// verifies that the API key is kept, as Azure DevOps does not return it
+func TestAuditStreamDatadog_Flatten_KeepsApiKey(t *testing.T) {
+	resourceData := getDatadogTestResourceData(t)
+	stream := datadogTestStream
+	stream.ConsumerInputs = &map[string]string{
+		"DatadogSite": "datadoghq.eu",
+	}
+	flattenDatadogAuditStream(resourceData, &stream)
+
+	require.Equal(t, "0123456789abcdef0123456789abcdef", resourceData.Get("api_key"))
+	require.Equal(t, "7", resourceData.Id())
+}
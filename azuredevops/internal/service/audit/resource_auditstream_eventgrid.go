@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+)
+
+const (
+	eventGridTopicUrl       = "topic_url"
+	eventGridTopicAccessKey = "access_key"
+)
+
+// ResourceAuditStreamEventGrid schema and implementation for Azure Event Grid audit stream resource
+func ResourceAuditStreamEventGrid() *schema.Resource {
+	r := genBaseAuditStreamResource(flattenEventGridAuditStream, expandEventGridAuditStream)
+
+	r.Schema[eventGridTopicUrl] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPS,
+		Description:  "The endpoint URL of the Event Grid topic",
+	}
+
+	r.Schema[eventGridTopicAccessKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "An access key of the Event Grid topic",
+	}
+
+	return r
+}
+
+func expandEventGridAuditStream(d *schema.ResourceData) (*audit.AuditStream, error) {
+	return doBaseExpansion(d, "AzureEventGrid", map[string]string{
+		"EventGridTopicHostname":  d.Get(eventGridTopicUrl).(string),
+		"EventGridTopicAccessKey": d.Get(eventGridTopicAccessKey).(string),
+	})
+}
+
+// the access key is not returned by Azure DevOps, so the configured value is kept
+func flattenEventGridAuditStream(d *schema.ResourceData, stream *audit.AuditStream) {
+	doBaseFlattening(d, stream)
+
+	if stream.ConsumerInputs != nil {
+		d.Set(eventGridTopicUrl, (*stream.ConsumerInputs)["EventGridTopicHostname"])
+	}
+}
@@ -0,0 +1,55 @@
+//go:build (all || resource_auditstream_eventgrid) && !exclude_auditstreams
+// +build all resource_auditstream_eventgrid
+// +build !exclude_auditstreams
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var eventGridTestStreamID = 7
+
+var eventGridTestStream = audit.AuditStream{
+	Id:           &eventGridTestStreamID,
+	ConsumerType: converter.String("AzureEventGrid"),
+	ConsumerInputs: &map[string]string{
+		"EventGridTopicHostname":  "https://topic.westeurope-1.eventgrid.azure.net/api/events",
+		"EventGridTopicAccessKey": "YWNjZXNzLWtleQ==",
+	},
+}
+
+func getEventGridTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceAuditStreamEventGrid().Schema, map[string]interface{}{
+		"topic_url":  "https://topic.westeurope-1.eventgrid.azure.net/api/events",
+		"access_key": "YWNjZXNzLWtleQ==",
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same audit stream
+func TestAuditStreamEventGrid_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := getEventGridTestResourceData(t)
+	flattenEventGridAuditStream(resourceData, &eventGridTestStream)
+
+	streamAfterRoundTrip, err := expandEventGridAuditStream(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, eventGridTestStream, *streamAfterRoundTrip)
+}
+
+// verifies that the access key is kept, as Azure DevOps does not return it
+func TestAuditStreamEventGrid_Flatten_KeepsAccessKey(t *testing.T) {
+	resourceData := getEventGridTestResourceData(t)
+	stream := eventGridTestStream
+	stream.ConsumerInputs = &map[string]string{
+		"EventGridTopicHostname": "https://topic.westeurope-1.eventgrid.azure.net/api/events",
+	}
+	flattenEventGridAuditStream(resourceData, &stream)
+
+	require.Equal(t, "YWNjZXNzLWtleQ==", resourceData.Get("access_key"))
+	require.Equal(t, "7", resourceData.Id())
+}
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+)
+
+const (
+	splunkUrl                 = "url"
+	splunkEventCollectorToken = "event_collector_token"
+	splunkIndex               = "index"
+	splunkSource              = "source"
+	splunkSourceType          = "sourcetype"
+)
+
+// optional consumer inputs, which override the defaults of the HTTP event collector
+var splunkOptionalConsumerInputs = map[string]string{
+	splunkIndex:      "SplunkIndex",
+	splunkSource:     "SplunkSource",
+	splunkSourceType: "SplunkSourceType",
+}
+
+// ResourceAuditStreamSplunk schema and implementation for Splunk audit stream resource
+func ResourceAuditStreamSplunk() *schema.Resource {
+	r := genBaseAuditStreamResource(flattenSplunkAuditStream, expandSplunkAuditStream)
+
+	r.Schema[splunkUrl] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPS,
+		Description:  "URL of the Splunk HTTP event collector",
+	}
+
+	r.Schema[splunkEventCollectorToken] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.IsUUID,
+		Description:  "The token of the Splunk HTTP event collector",
+	}
+
+	r.Schema[splunkIndex] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The Splunk index the events are written to",
+	}
+
+	r.Schema[splunkSource] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The source of the events in Splunk",
+	}
+
+	r.Schema[splunkSourceType] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The sourcetype of the events in Splunk",
+	}
+
+	return r
+}
+
+func expandSplunkAuditStream(d *schema.ResourceData) (*audit.AuditStream, error) {
+	consumerInputs := map[string]string{
+		"SplunkUrl":                 d.Get(splunkUrl).(string),
+		"SplunkEventCollectorToken": d.Get(splunkEventCollectorToken).(string),
+	}
+	for key, input := range splunkOptionalConsumerInputs {
+		if v, ok := d.GetOk(key); ok {
+			consumerInputs[input] = v.(string)
+		}
+	}
+	return doBaseExpansion(d, "Splunk", consumerInputs)
+}
+
+// the event collector token is not returned by Azure DevOps, so the configured value is kept
+func flattenSplunkAuditStream(d *schema.ResourceData, stream *audit.AuditStream) {
+	doBaseFlattening(d, stream)
+
+	if stream.ConsumerInputs != nil {
+		d.Set(splunkUrl, (*stream.ConsumerInputs)["SplunkUrl"])
+		for key, input := range splunkOptionalConsumerInputs {
+			d.Set(key, (*stream.ConsumerInputs)[input])
+		}
+	}
+}
@@ -0,0 +1,424 @@
+//go:build (all || resource_auditstream_splunk) && !exclude_auditstreams
+// +build all resource_auditstream_splunk
+// +build !exclude_auditstreams
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/audit"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var splunkTestStreamID = 42
+
+var splunkTestStream = audit.AuditStream{
+	Id:           &splunkTestStreamID,
+	ConsumerType: converter.String("Splunk"),
+	ConsumerInputs: &map[string]string{
+		"SplunkUrl":                 "https://splunk.example.com:8088",
+		"SplunkEventCollectorToken": "00000000-0000-0000-0000-000000000001",
+	},
+}
+
+func getSplunkTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceAuditStreamSplunk().Schema, map[string]interface{}{
+		"url":                   "https://splunk.example.com:8088",
+		"event_collector_token": "00000000-0000-0000-0000-000000000001",
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same audit stream
+func TestAuditStreamSplunk_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := getSplunkTestResourceData(t)
+	flattenSplunkAuditStream(resourceData, &splunkTestStream)
+
+	streamAfterRoundTrip, err := expandSplunkAuditStream(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, splunkTestStream, *streamAfterRoundTrip)
+}
+
+// verifies that the optional index, source and sourcetype are sent as consumer inputs
+func TestAuditStreamSplunk_ExpandFlatten_RoundtripOptionalInputs(t *testing.T) {
+	resourceData := getSplunkTestResourceData(t)
+	stream := splunkTestStream
+	stream.ConsumerInputs = &map[string]string{
+		"SplunkUrl":                 "https://splunk.example.com:8088",
+		"SplunkEventCollectorToken": "00000000-0000-0000-0000-000000000001",
+		"SplunkIndex":               "azure_devops",
+		"SplunkSource":              "audit",
+		"SplunkSourceType":          "azure:devops:audit",
+	}
+	flattenSplunkAuditStream(resourceData, &stream)
+	require.Equal(t, "azure_devops", resourceData.Get("index"))
+	require.Equal(t, "audit", resourceData.Get("source"))
+	require.Equal(t, "azure:devops:audit", resourceData.Get("sourcetype"))
+
+	streamAfterRoundTrip, err := expandSplunkAuditStream(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, stream, *streamAfterRoundTrip)
+}
+
+// verifies that the event collector token is kept, as Azure DevOps does not return it
+func TestAuditStreamSplunk_Flatten_KeepsEventCollectorToken(t *testing.T) {
+	resourceData := getSplunkTestResourceData(t)
+	stream := splunkTestStream
+	stream.ConsumerInputs = &map[string]string{
+		"SplunkUrl": "https://splunk.example.com:8088",
+	}
+	flattenSplunkAuditStream(resourceData, &stream)
+
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", resourceData.Get("event_collector_token"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAuditStreamSplunk_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	expectedArgs := audit.CreateStreamArgs{
+		Stream: &audit.AuditStream{
+			ConsumerType:   splunkTestStream.ConsumerType,
+			ConsumerInputs: splunkTestStream.ConsumerInputs,
+		},
+		DaysToBackfill: converter.Int(0),
+	}
+	auditClient.
+		EXPECT().
+		CreateStream(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateStream() Failed")).
+		Times(1)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "CreateStream() Failed")
+}
+
+// verifies that a stream created with days_to_backfill is only returned once it finished backfilling
+func TestAuditStreamSplunk_Create_WaitsForBackfill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.Set("days_to_backfill", 7)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	backfillingStream := splunkTestStream
+	backfillingStream.Status = &audit.AuditStreamStatusValues.Backfilling
+	enabledStream := splunkTestStream
+	enabledStream.Status = &audit.AuditStreamStatusValues.Enabled
+
+	auditClient.
+		EXPECT().
+		CreateStream(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args audit.CreateStreamArgs) (*audit.AuditStream, error) {
+			require.Equal(t, 7, *args.DaysToBackfill)
+			return &backfillingStream, nil
+		}).
+		Times(1)
+	gomock.InOrder(
+		auditClient.
+			EXPECT().
+			QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+			Return(&backfillingStream, nil).
+			Times(1),
+		auditClient.
+			EXPECT().
+			QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+			Return(&enabledStream, nil).
+			Times(2),
+	)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "42", resourceData.Id())
+	require.True(t, resourceData.Get("enabled").(bool))
+}
+
+// verifies that an empty response while waiting for the backfill keeps waiting instead of failing
+func TestAuditStreamSplunk_Create_WaitsOnEmptyResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.Set("days_to_backfill", 7)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	backfillingStream := splunkTestStream
+	backfillingStream.Status = &audit.AuditStreamStatusValues.Backfilling
+	enabledStream := splunkTestStream
+	enabledStream.Status = &audit.AuditStreamStatusValues.Enabled
+
+	auditClient.
+		EXPECT().
+		CreateStream(clients.Ctx, gomock.Any()).
+		Return(&backfillingStream, nil).
+		Times(1)
+	gomock.InOrder(
+		auditClient.
+			EXPECT().
+			QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+			Return(nil, nil).
+			Times(1),
+		auditClient.
+			EXPECT().
+			QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+			Return(&enabledStream, nil).
+			Times(2),
+	)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "42", resourceData.Id())
+}
+
+// verifies that waiting for the backfill stops when the context is cancelled, deleting the half-created stream
+func TestAuditStreamSplunk_Create_StopsWaitingOnCancelledContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.Set("days_to_backfill", 7)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	backfillingStream := splunkTestStream
+	backfillingStream.Status = &audit.AuditStreamStatusValues.Backfilling
+	auditClient.
+		EXPECT().
+		CreateStream(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ audit.CreateStreamArgs) (*audit.AuditStream, error) {
+			cancel()
+			return &backfillingStream, nil
+		}).
+		Times(1)
+	auditClient.
+		EXPECT().
+		DeleteStream(gomock.Any(), audit.DeleteStreamArgs{StreamId: &splunkTestStreamID}).
+		Return(nil).
+		Times(1)
+
+	diags := r.CreateContext(ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "context canceled")
+}
+
+// verifies that a failure to delete the half-created stream is reported along with the backfill error
+func TestAuditStreamSplunk_Create_ReportsFailedCleanup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.Set("days_to_backfill", 7)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	backfillingStream := splunkTestStream
+	backfillingStream.Status = &audit.AuditStreamStatusValues.Backfilling
+	auditClient.
+		EXPECT().
+		CreateStream(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ audit.CreateStreamArgs) (*audit.AuditStream, error) {
+			cancel()
+			return &backfillingStream, nil
+		}).
+		Times(1)
+	auditClient.
+		EXPECT().
+		DeleteStream(gomock.Any(), audit.DeleteStreamArgs{StreamId: &splunkTestStreamID}).
+		Return(errors.New("DeleteStream() Failed")).
+		Times(1)
+
+	diags := r.CreateContext(ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "context canceled")
+	require.Contains(t, diags[0].Summary, "DeleteStream() Failed")
+}
+
+// verifies that skip_backfill_wait returns the stream without waiting for the backfill
+func TestAuditStreamSplunk_Create_SkipsBackfillWait(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.Set("days_to_backfill", 7)
+	resourceData.Set("skip_backfill_wait", true)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	backfillingStream := splunkTestStream
+	backfillingStream.Status = &audit.AuditStreamStatusValues.Backfilling
+	auditClient.
+		EXPECT().
+		CreateStream(clients.Ctx, gomock.Any()).
+		Return(&backfillingStream, nil).
+		Times(1)
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+		Return(&backfillingStream, nil).
+		Times(1)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "42", resourceData.Id())
+}
+
+// verifies that changing rotation_trigger updates the audit stream in place and sends the event collector token again
+func TestAuditStreamSplunk_Update_RotationTriggerResendsToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	rawConfig := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"url":                   "https://splunk.example.com:8088",
+			"event_collector_token": "00000000-0000-0000-0000-000000000001",
+			"rotation_trigger":      map[string]interface{}{"version": version},
+		}
+	}
+	stateData := schema.TestResourceDataRaw(t, r.Schema, rawConfig("1"))
+	stateData.SetId("42")
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig("2")), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		UpdateStream(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args audit.UpdateStreamArgs) (*audit.AuditStream, error) {
+			require.Equal(t, "00000000-0000-0000-0000-000000000001", (*args.Stream.ConsumerInputs)["SplunkEventCollectorToken"])
+			return nil, errors.New("UpdateStream() Failed")
+		}).
+		Times(1)
+
+	diags := r.UpdateContext(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "UpdateStream() Failed")
+}
+
+// verifies that if an error is produced on update, the error is not swallowed
+func TestAuditStreamSplunk_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.SetId("42")
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		UpdateStream(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UpdateStream() Failed")).
+		Times(1)
+
+	diags := r.UpdateContext(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "UpdateStream() Failed")
+}
+
+// verifies that a stream which is marked for deletion is removed from the state
+func TestAuditStreamSplunk_Read_RemovesDeletedStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.SetId("42")
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	deletedStream := splunkTestStream
+	deletedStream.Status = &audit.AuditStreamStatusValues.Deleted
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+		Return(&deletedStream, nil).
+		Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestAuditStreamSplunk_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.SetId("42")
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		QueryStreamById(clients.Ctx, audit.QueryStreamByIdArgs{StreamId: &splunkTestStreamID}).
+		Return(nil, errors.New("QueryStreamById() Failed")).
+		Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "QueryStreamById() Failed")
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAuditStreamSplunk_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceAuditStreamSplunk()
+	resourceData := getSplunkTestResourceData(t)
+	resourceData.SetId("42")
+
+	auditClient := azdosdkmocks.NewMockAuditClient(ctrl)
+	clients := &client.AggregatedClient{AuditClient: auditClient, Ctx: context.Background()}
+
+	auditClient.
+		EXPECT().
+		DeleteStream(clients.Ctx, audit.DeleteStreamArgs{StreamId: &splunkTestStreamID}).
+		Return(errors.New("DeleteStream() Failed")).
+		Times(1)
+
+	diags := r.DeleteContext(clients.Ctx, resourceData, clients)
+	require.Contains(t, diags[0].Summary, "DeleteStream() Failed")
+}
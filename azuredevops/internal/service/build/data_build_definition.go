@@ -111,6 +111,14 @@ func DataBuildDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"agent_pool_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"agent_queue_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"repository": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -301,7 +309,15 @@ func dataSourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Multiple build definitions with name %s found in project %s", name, projectID)
 	}
 
-	flattenBuildDefinition(d, &(*buildDefinitions)[0], projectID)
+	buildDefinition := &(*buildDefinitions)[0]
+	flattenBuildDefinition(d, buildDefinition, projectID)
+
+	if buildDefinition.Queue != nil {
+		d.Set("agent_queue_id", buildDefinition.Queue.Id)
+		if buildDefinition.Queue.Pool != nil {
+			d.Set("agent_pool_id", buildDefinition.Queue.Pool.Id)
+		}
+	}
 
 	return nil
 }
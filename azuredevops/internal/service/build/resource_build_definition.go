@@ -344,6 +344,26 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"build_completion_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"build_definition_id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"requires_successful_build": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"branch_filter": branchFilter,
+					},
+				},
+			},
 			"features": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -516,21 +536,15 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("variable_groups", flattenVariableGroups(buildDefinition))
 	d.Set(bdVariable, flattenBuildVariables(d, buildDefinition))
 
+	// triggers which have been removed outside of Terraform are cleared to detect the drift
+	triggers := map[build.DefinitionTriggerType][]interface{}{}
 	if buildDefinition.Triggers != nil {
-		triggers := flattenTriggers(buildDefinition.Triggers)
-
-		if triggers[build.DefinitionTriggerTypeValues.ContinuousIntegration] != nil {
-			d.Set("ci_trigger", triggers[build.DefinitionTriggerTypeValues.ContinuousIntegration])
-		}
-
-		if triggers[build.DefinitionTriggerTypeValues.PullRequest] != nil {
-			d.Set("pull_request_trigger", triggers[build.DefinitionTriggerTypeValues.PullRequest])
-		}
-
-		if triggers[build.DefinitionTriggerTypeValues.Schedule] != nil {
-			d.Set("schedules", triggers[build.DefinitionTriggerTypeValues.Schedule])
-		}
+		triggers = flattenTriggers(buildDefinition.Triggers)
 	}
+	d.Set("ci_trigger", triggers[build.DefinitionTriggerTypeValues.ContinuousIntegration])
+	d.Set("pull_request_trigger", triggers[build.DefinitionTriggerTypeValues.PullRequest])
+	d.Set("schedules", triggers[build.DefinitionTriggerTypeValues.Schedule])
+	d.Set("build_completion_trigger", triggers[build.DefinitionTriggerTypeValues.BuildCompletion])
 
 	revision := 0
 	if buildDefinition.Revision != nil {
@@ -607,7 +621,10 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 	// available from the compiler is `interface{}` so we can probe for known
 	// implementations
 	if processMap, ok := buildDefinition.Process.(map[string]interface{}); ok {
-		yamlFilePath = processMap["yamlFilename"].(string)
+		// designer build definitions do not have a YAML file
+		if yamlFilename, ok := processMap["yamlFilename"].(string); ok {
+			yamlFilePath = yamlFilename
+		}
 	}
 	if yamlProcess, ok := buildDefinition.Process.(*build.YamlProcess); ok {
 		yamlFilePath = *yamlProcess.YamlFilename
@@ -731,7 +748,8 @@ func flattenBuildDefinitionScheduleTrigger(ms map[string]interface{}) []interfac
 	schedules := make([]interface{}, 0)
 	for _, schedule := range schedulesResp {
 		schedule := schedule.(map[string]interface{})
-		branchFilter := flattenBuildDefinitionBranchOrPathFilter(schedule["branchFilters"].([]interface{}))
+		branchFilters, _ := schedule["branchFilters"].([]interface{})
+		branchFilter := flattenBuildDefinitionBranchOrPathFilter(branchFilters)
 		scheduleConfig := map[string]interface{}{
 			"branch_filter":              branchFilter,
 			"schedule_only_with_changes": schedule["scheduleOnlyWithChanges"],
@@ -753,6 +771,25 @@ func flattenBuildDefinitionScheduleTrigger(ms map[string]interface{}) []interfac
 	return schedules
 }
 
+func flattenBuildDefinitionBuildCompletionTrigger(m map[string]interface{}) interface{} {
+	definitionID := 0
+	if definition, ok := m["definition"].(map[string]interface{}); ok {
+		switch id := definition["id"].(type) {
+		case float64:
+			definitionID = int(id)
+		case int:
+			definitionID = id
+		}
+	}
+	requiresSuccessfulBuild, _ := m["requiresSuccessfulBuild"].(bool)
+	branchFilters, _ := m["branchFilters"].([]interface{})
+	return map[string]interface{}{
+		"build_definition_id":       definitionID,
+		"requires_successful_build": requiresSuccessfulBuild,
+		"branch_filter":             flattenBuildDefinitionBranchOrPathFilter(branchFilters),
+	}
+}
+
 func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interface{} {
 	buildTriggers := map[build.DefinitionTriggerType][]interface{}{}
 	for _, ds := range *m {
@@ -777,6 +814,11 @@ func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interfa
 		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.Schedule)) {
 			buildTriggers[build.DefinitionTriggerTypeValues.Schedule] = flattenBuildDefinitionScheduleTrigger(trigger)
 		}
+		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.BuildCompletion)) {
+			buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion] = append(
+				buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion],
+				flattenBuildDefinitionBuildCompletionTrigger(trigger))
+		}
 	}
 	return buildTriggers
 }
@@ -937,6 +979,15 @@ func expandBuildDefinitionTrigger(d map[string]interface{}, t build.DefinitionTr
 		}
 		scheduleConfig["daysToBuild"] = DateToDays(d["days_to_build"].([]interface{}))
 		return scheduleConfig
+	case build.DefinitionTriggerTypeValues.BuildCompletion:
+		return map[string]interface{}{
+			"branchFilters": expandBuildDefinitionBranchOrPathFilterSet(d["branch_filter"].(*schema.Set)),
+			"definition": map[string]interface{}{
+				"id": d["build_definition_id"],
+			},
+			"requiresSuccessfulBuild": d["requires_successful_build"],
+			"triggerType":             string(t),
+		}
 	}
 	return nil
 }
@@ -1039,7 +1090,13 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		build.DefinitionTriggerTypeValues.PullRequest,
 	)
 
+	buildCompletionTriggers := expandBuildDefinitionTriggerList(
+		d.Get("build_completion_trigger").([]interface{}),
+		build.DefinitionTriggerTypeValues.BuildCompletion,
+	)
+
 	buildTriggers := append(ciTriggers, pullRequestTriggers...)
+	buildTriggers = append(buildTriggers, buildCompletionTriggers...)
 
 	schedules := expandBuildDefinitionTriggerList(
 		d.Get("schedules").([]interface{}),
@@ -83,9 +83,22 @@ var yamlPrTrigger = map[string]interface{}{
 	"triggerType":                          "pullRequest",
 }
 
+var buildCompletionTrigger = map[string]interface{}{
+	"branchFilters": []interface{}{
+		"+main",
+		"-releases/*",
+	},
+	"definition": map[string]interface{}{
+		"id": 12,
+	},
+	"requiresSuccessfulBuild": true,
+	"triggerType":             "buildCompletion",
+}
+
 var triggerGroups = [][]interface{}{
 	{manualCiTrigger, manualPrTrigger},
 	{yamlCiTrigger, yamlPrTrigger},
+	{buildCompletionTrigger},
 }
 
 // This definition matches the overall structure of what a configured git repository would
@@ -437,6 +450,54 @@ func TestBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
 	}
 }
 
+// verifies that triggers removed outside of Terraform are cleared from the state
+func TestBuildDefinition_Flatten_ClearsRemovedTriggers(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.Set("build_completion_trigger", []interface{}{map[string]interface{}{
+		"build_definition_id":       12,
+		"requires_successful_build": true,
+	}})
+	resourceData.Set("schedules", []interface{}{map[string]interface{}{
+		"days_to_build": []interface{}{"Mon"},
+	}})
+
+	testBuildDefinitionWithoutTriggers := testBuildDefinition
+	testBuildDefinitionWithoutTriggers.Triggers = nil
+	flattenBuildDefinition(resourceData, &testBuildDefinitionWithoutTriggers, testProjectID)
+
+	require.Empty(t, resourceData.Get("build_completion_trigger"))
+	require.Empty(t, resourceData.Get("schedules"))
+	require.Empty(t, resourceData.Get("ci_trigger"))
+}
+
+// verifies that the ID of the triggering build definition is read from the JSON response
+func TestBuildDefinition_Flatten_BuildCompletionTrigger(t *testing.T) {
+	triggers := flattenTriggers(&[]interface{}{map[string]interface{}{
+		"branchFilters":           []interface{}{"+refs/heads/main"},
+		"definition":              map[string]interface{}{"id": float64(12), "name": "upstream"},
+		"requiresSuccessfulBuild": false,
+		"triggerType":             "buildCompletion",
+	}})
+
+	completionTriggers := triggers[build.DefinitionTriggerTypeValues.BuildCompletion]
+	require.Len(t, completionTriggers, 1)
+	completionTrigger := completionTriggers[0].(map[string]interface{})
+	require.Equal(t, 12, completionTrigger["build_definition_id"])
+	require.Equal(t, false, completionTrigger["requires_successful_build"])
+}
+
+// verifies that flattening a designer build definition, which has no YAML file, does not fail
+func TestBuildDefinition_Flatten_DesignerProcessHasNoYamlPath(t *testing.T) {
+	designerBuildDefinition := testBuildDefinition
+	designerBuildDefinition.Process = map[string]interface{}{
+		"type": float64(1),
+	}
+
+	repository := flattenRepository(&designerBuildDefinition).([]map[string]interface{})
+	require.Len(t, repository, 1)
+	require.Equal(t, "", repository[0]["yml_path"])
+}
+
 // verifies that an expand will fail if there is insufficient configuration data found in the resource
 func TestBuildDefinition_Expand_FailsIfNotEnoughData(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
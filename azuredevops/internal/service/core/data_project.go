@@ -55,6 +55,18 @@ func DataProject() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"default_team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_team_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -94,5 +106,13 @@ func dataProjectRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error flattening project: %v", err))
 	}
+
+	d.Set("url", converter.ToString(project.Url, ""))
+	if project.DefaultTeam != nil {
+		if project.DefaultTeam.Id != nil {
+			d.Set("default_team_id", project.DefaultTeam.Id.String())
+		}
+		d.Set("default_team_name", converter.ToString(project.DefaultTeam.Name, ""))
+	}
 	return nil
 }
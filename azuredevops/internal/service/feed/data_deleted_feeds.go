@@ -0,0 +1,99 @@
+package feed
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataDeletedFeeds schema and implementation for the data source of the feeds in the recycle bin
+func DataDeletedFeeds() *schema.Resource {
+	return &schema.Resource{
+		Read: dataDeletedFeedsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"feeds": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deleted_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scheduled_permanent_delete_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataDeletedFeedsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	feeds, err := clients.FeedClient.GetFeedsFromRecycleBin(clients.Ctx, feed.GetFeedsFromRecycleBinArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" listing deleted feeds: %+v", err)
+	}
+
+	if err := d.Set("feeds", flattenDeletedFeeds(feeds)); err != nil {
+		return fmt.Errorf(" setting feeds: %+v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+func flattenDeletedFeeds(feeds *[]feed.Feed) []interface{} {
+	results := make([]interface{}, 0)
+	if feeds == nil {
+		return results
+	}
+
+	for _, f := range *feeds {
+		output := map[string]interface{}{
+			"name": converter.ToString(f.Name, ""),
+		}
+		if f.Id != nil {
+			output["id"] = f.Id.String()
+		}
+		if f.Project != nil && f.Project.Id != nil {
+			output["project_id"] = f.Project.Id.String()
+		}
+		if f.DeletedDate != nil {
+			output["deleted_date"] = f.DeletedDate.Time.Format(time.RFC3339)
+		}
+		if f.ScheduledPermanentDeleteDate != nil {
+			output["scheduled_permanent_delete_date"] = f.ScheduledPermanentDeleteDate.Time.Format(time.RFC3339)
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,75 @@
+//go:build (all || data_sources || data_deleted_feeds) && (!exclude_data_sources || !exclude_data_deleted_feeds)
+// +build all data_sources data_deleted_feeds
+// +build !exclude_data_sources !exclude_data_deleted_feeds
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the feeds in the recycle bin are read with their deletion dates
+func TestDataSourceDeletedFeeds_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedID := uuid.New()
+	deletedDate := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	feedClient.
+		EXPECT().
+		GetFeedsFromRecycleBin(clients.Ctx, feed.GetFeedsFromRecycleBinArgs{
+			Project: converter.String(""),
+		}).
+		Return(&[]feed.Feed{
+			{
+				Id:                           &feedID,
+				Name:                         converter.String("feed"),
+				DeletedDate:                  &azuredevops.Time{Time: deletedDate},
+				ScheduledPermanentDeleteDate: &azuredevops.Time{Time: deletedDate.AddDate(0, 0, 30)},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeletedFeeds().Schema, map[string]interface{}{})
+	err := dataDeletedFeedsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 1, resourceData.Get("feeds.#"))
+	require.Equal(t, feedID.String(), resourceData.Get("feeds.0.id"))
+	require.Equal(t, "2023-05-01T10:00:00Z", resourceData.Get("feeds.0.deleted_date"))
+	require.Equal(t, "2023-05-31T10:00:00Z", resourceData.Get("feeds.0.scheduled_permanent_delete_date"))
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestDataSourceDeletedFeeds_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeedsFromRecycleBin(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetFeedsFromRecycleBin() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeletedFeeds().Schema, map[string]interface{}{})
+	err := dataDeletedFeedsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetFeedsFromRecycleBin() Failed")
+}
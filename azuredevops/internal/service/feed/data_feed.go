@@ -0,0 +1,85 @@
+package feed
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataFeed schema and implementation for feed data source
+func DataFeed() *schema.Resource {
+	return &schema.Resource{
+		Read: dataFeedRead,
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"feed_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"feed_id", "name"},
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"capabilities": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upstream_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataFeedRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	// the API looks feeds up by their name or by their ID
+	feedID := d.Get("feed_id").(string)
+	if feedID == "" {
+		feedID = d.Get("name").(string)
+	}
+
+	getFeed, err := clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+		FeedId:  converter.String(feedID),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading feed %s: %+v", feedID, err)
+	}
+
+	d.SetId(getFeed.Id.String())
+	d.Set("feed_id", getFeed.Id.String())
+	d.Set("name", getFeed.Name)
+	if getFeed.Project != nil && getFeed.Project.Id != nil {
+		d.Set("project_id", getFeed.Project.Id.String())
+	} else {
+		d.Set("project_id", "")
+	}
+	if getFeed.Capabilities != nil {
+		d.Set("capabilities", string(*getFeed.Capabilities))
+	}
+	d.Set("upstream_enabled", converter.ToBool(getFeed.UpstreamEnabled, false))
+	d.Set("url", getFeed.Url)
+	return nil
+}
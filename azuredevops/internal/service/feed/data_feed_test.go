@@ -0,0 +1,79 @@
+//go:build (all || data_sources || data_feed) && (!exclude_data_sources || !exclude_data_feed)
+// +build all data_sources data_feed
+// +build !exclude_data_sources !exclude_data_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a feed is looked up by its name and read into the state
+func TestDataSourceFeed_Read_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedID := uuid.New()
+	projectID := uuid.New()
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, feed.GetFeedArgs{
+			FeedId:  converter.String("feed"),
+			Project: converter.String(projectID.String()),
+		}).
+		Return(&feed.Feed{
+			Id:              &feedID,
+			Name:            converter.String("feed"),
+			Project:         &feed.ProjectReference{Id: &projectID},
+			Capabilities:    &feed.FeedCapabilitiesValues.UpstreamV2,
+			UpstreamEnabled: converter.Bool(true),
+			Url:             converter.String("https://feeds.dev.azure.com/org/_apis/Packaging/Feeds/feed"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataFeed().Schema, map[string]interface{}{
+		"name":       "feed",
+		"project_id": projectID.String(),
+	})
+	err := dataFeedRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, feedID.String(), resourceData.Id())
+	require.Equal(t, feedID.String(), resourceData.Get("feed_id"))
+	require.Equal(t, "upstreamV2", resourceData.Get("capabilities"))
+	require.True(t, resourceData.Get("upstream_enabled").(bool))
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestDataSourceFeed_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetFeed() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataFeed().Schema, map[string]interface{}{
+		"feed_id": uuid.New().String(),
+	})
+	err := dataFeedRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetFeed() Failed")
+}
@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataFeeds schema and implementation for feeds data source
+func DataFeeds() *schema.Resource {
+	return &schema.Resource{
+		Read: dataFeedsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"feeds": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFeedsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	feeds, err := clients.FeedClient.GetFeeds(clients.Ctx, feed.GetFeedsArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" listing feeds: %+v", err)
+	}
+
+	if err := d.Set("feeds", flattenFeeds(feeds, d.Get("name_prefix").(string))); err != nil {
+		return fmt.Errorf(" setting feeds: %+v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+// feeds are matched against the name prefix case-insensitively, like Azure DevOps treats feed names
+func flattenFeeds(feeds *[]feed.Feed, namePrefix string) []interface{} {
+	results := make([]interface{}, 0)
+	if feeds == nil {
+		return results
+	}
+
+	for _, f := range *feeds {
+		name := converter.ToString(f.Name, "")
+		if !strings.HasPrefix(strings.ToLower(name), strings.ToLower(namePrefix)) {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"name": name,
+			"url":  converter.ToString(f.Url, ""),
+		}
+		if f.Id != nil {
+			output["id"] = f.Id.String()
+		}
+		if f.Project != nil && f.Project.Id != nil {
+			output["project_id"] = f.Project.Id.String()
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,81 @@
+//go:build (all || data_sources || data_feeds) && (!exclude_data_sources || !exclude_data_feeds)
+// +build all data_sources data_feeds
+// +build !exclude_data_sources !exclude_data_feeds
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that only the feeds matching the name prefix are returned
+func TestDataSourceFeeds_Read_FiltersByNamePrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	releaseID := uuid.New()
+	feeds := []feed.Feed{
+		{
+			Id:      &releaseID,
+			Name:    converter.String("Release-Packages"),
+			Project: &feed.ProjectReference{Id: &projectID},
+			Url:     converter.String("https://feeds.dev.azure.com/org/_apis/Packaging/Feeds/release"),
+		},
+		{
+			Id:   converter.UUID(uuid.New().String()),
+			Name: converter.String("nightly"),
+		},
+	}
+	feedClient.
+		EXPECT().
+		GetFeeds(clients.Ctx, feed.GetFeedsArgs{
+			Project: converter.String(projectID.String()),
+		}).
+		Return(&feeds, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataFeeds().Schema, map[string]interface{}{
+		"project_id":  projectID.String(),
+		"name_prefix": "release",
+	})
+	err := dataFeedsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 1, resourceData.Get("feeds.#"))
+	require.Equal(t, releaseID.String(), resourceData.Get("feeds.0.id"))
+	require.Equal(t, projectID.String(), resourceData.Get("feeds.0.project_id"))
+	require.Equal(t, "https://feeds.dev.azure.com/org/_apis/Packaging/Feeds/release", resourceData.Get("feeds.0.url"))
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestDataSourceFeeds_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeeds(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetFeeds() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataFeeds().Schema, map[string]interface{}{})
+	err := dataFeedsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetFeeds() Failed")
+}
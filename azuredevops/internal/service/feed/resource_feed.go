@@ -0,0 +1,498 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// package types an upstream source can serve
+var upstreamSourceProtocols = []string{"nuget", "npm", "maven", "pypi", "upack", "cargo"}
+
+const (
+	feedScopeProject      = "project"
+	feedScopeOrganization = "organization"
+)
+
+const (
+	feedPending   = "Pending"
+	feedAvailable = "Available"
+)
+
+// ResourceFeed schema and implementation for feed resource
+func ResourceFeed() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedCreate,
+		Read:   resourceFeedRead,
+		Update: resourceFeedUpdate,
+		Delete: resourceFeedDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"badges_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"hide_deleted_package_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"upstream_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// the feed API cannot move a feed to another project or to the organization
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upstream_sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice(upstreamSourceProtocols, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+						"location": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"upstream_source_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(feed.UpstreamSourceTypeValues.Public),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(feed.UpstreamSourceTypeValues.Public),
+								string(feed.UpstreamSourceTypeValues.Internal),
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"restore_if_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permanent_delete": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFeedCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	upstreamSources, err := expandUpstreamSources(d)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("restore_if_deleted").(bool) {
+		restored, err := restoreDeletedFeed(d, clients, upstreamSources)
+		if err != nil {
+			return err
+		}
+		if restored {
+			if err := waitForFeedAvailable(clients, d.Id(), d.Get("project_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+			return resourceFeedRead(d, m)
+		}
+	}
+
+	createdFeed, err := clients.FeedClient.CreateFeed(clients.Ctx, feed.CreateFeedArgs{
+		Feed: &feed.Feed{
+			Name:                       converter.String(d.Get("name").(string)),
+			Description:                converter.String(d.Get("description").(string)),
+			BadgesEnabled:              converter.Bool(d.Get("badges_enabled").(bool)),
+			HideDeletedPackageVersions: converter.Bool(d.Get("hide_deleted_package_versions").(bool)),
+			UpstreamEnabled:            converter.Bool(d.Get("upstream_enabled").(bool)),
+			UpstreamSources:            upstreamSources,
+		},
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating new feed. Name: %s, Error: %+v", d.Get("name").(string), err)
+	}
+
+	d.SetId(createdFeed.Id.String())
+
+	// a new feed is not always found right after it is created
+	if err := waitForFeedAvailable(clients, d.Id(), d.Get("project_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	return resourceFeedRead(d, m)
+}
+
+// restoreDeletedFeed restores a feed with the configured name from the recycle bin and applies the configured
+// settings to it. It returns false if there is no such feed.
+func restoreDeletedFeed(d *schema.ResourceData, clients *client.AggregatedClient, upstreamSources *[]feed.UpstreamSource) (bool, error) {
+	name := d.Get("name").(string)
+	projectID := d.Get("project_id").(string)
+
+	deletedFeeds, err := clients.FeedClient.GetFeedsFromRecycleBin(clients.Ctx, feed.GetFeedsFromRecycleBinArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return false, fmt.Errorf(" listing deleted feeds: %+v", err)
+	}
+
+	var deletedFeed *feed.Feed
+	if deletedFeeds != nil {
+		for i, f := range *deletedFeeds {
+			if f.Name != nil && strings.EqualFold(*f.Name, name) {
+				deletedFeed = &(*deletedFeeds)[i]
+				break
+			}
+		}
+	}
+	if deletedFeed == nil {
+		return false, nil
+	}
+
+	feedID := deletedFeed.Id.String()
+	err = clients.FeedClient.RestoreDeletedFeed(clients.Ctx, feed.RestoreDeletedFeedArgs{
+		PatchJson: &[]webapi.JsonPatchOperation{
+			{
+				Op:    &webapi.OperationValues.Replace,
+				Path:  converter.String("/isDeleted"),
+				Value: false,
+			},
+		},
+		FeedId:  converter.String(feedID),
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return false, fmt.Errorf(" restoring deleted feed with ID %s: %+v", feedID, err)
+	}
+	d.SetId(feedID)
+
+	if upstreamSources == nil {
+		upstreamSources = &[]feed.UpstreamSource{}
+	}
+	_, err = clients.FeedClient.UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+		Feed: &feed.FeedUpdate{
+			Name:                       converter.String(name),
+			Description:                converter.String(d.Get("description").(string)),
+			BadgesEnabled:              converter.Bool(d.Get("badges_enabled").(bool)),
+			HideDeletedPackageVersions: converter.Bool(d.Get("hide_deleted_package_versions").(bool)),
+			UpstreamEnabled:            converter.Bool(d.Get("upstream_enabled").(bool)),
+			UpstreamSources:            upstreamSources,
+		},
+		FeedId:  converter.String(feedID),
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return true, fmt.Errorf(" updating restored feed with ID %s: %+v", feedID, err)
+	}
+	return true, nil
+}
+
+// waitForFeedAvailable waits until the feed can be read, as feeds are created eventually consistent
+func waitForFeedAvailable(clients *client.AggregatedClient, feedID string, projectID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{feedPending},
+		Target:     []string{feedAvailable},
+		Refresh:    feedAvailableRefreshFunc(clients, feedID, projectID),
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for feed with ID %s to be available: %+v", feedID, err)
+	}
+	return nil
+}
+
+func feedAvailableRefreshFunc(clients *client.AggregatedClient, feedID string, projectID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getFeed, err := clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+			FeedId:  converter.String(feedID),
+			Project: converter.String(projectID),
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return feedPending, feedPending, nil
+			}
+			return nil, "", fmt.Errorf(" reading feed with ID %s: %+v", feedID, err)
+		}
+		return getFeed, feedAvailable, nil
+	}
+}
+
+func resourceFeedRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	getFeed, err := clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+		FeedId:  converter.String(d.Id()),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading feed with ID %s: %+v", d.Id(), err)
+	}
+
+	flattenFeed(d, getFeed)
+	return nil
+}
+
+func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	feedUpdate, err := expandFeedUpdate(d)
+	if err != nil {
+		return err
+	}
+
+	// the features are only used locally, so there is nothing to send if only they changed
+	if feedUpdate != nil {
+		_, err = clients.FeedClient.UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+			Feed:    feedUpdate,
+			FeedId:  converter.String(d.Id()),
+			Project: converter.String(d.Get("project_id").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf(" updating feed with ID %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceFeedRead(d, m)
+}
+
+func resourceFeedDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Id()
+	projectID := d.Get("project_id").(string)
+
+	err := clients.FeedClient.DeleteFeed(clients.Ctx, feed.DeleteFeedArgs{
+		FeedId:  converter.String(feedID),
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf(" deleting feed with ID %s: %+v", feedID, err)
+	}
+
+	// a deleted feed is kept in the recycle bin, unless it is deleted from there as well
+	if isFeedPermanentDelete(d) {
+		err = clients.FeedClient.PermanentDeleteFeed(clients.Ctx, feed.PermanentDeleteFeedArgs{
+			FeedId:  converter.String(feedID),
+			Project: converter.String(projectID),
+		})
+		if err != nil {
+			return fmt.Errorf(" permanently deleting feed with ID %s: %+v", feedID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceFeedImport accepts <feed_id> for an organization scoped feed and
+// <project_id>/<feed_id> for a project scoped feed
+func resourceFeedImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf(" Unexpected format of import ID %s, expected <feed_id> or <project_id>/<feed_id>", d.Id())
+	}
+	for _, part := range parts {
+		if _, err := uuid.Parse(part); err != nil {
+			return nil, fmt.Errorf(" %s of import ID %s is not a UUID: %+v", part, d.Id(), err)
+		}
+	}
+
+	if len(parts) == 2 {
+		d.Set("project_id", parts[0])
+		d.SetId(parts[1])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFeed(d *schema.ResourceData, getFeed *feed.Feed) {
+	d.SetId(getFeed.Id.String())
+	d.Set("name", getFeed.Name)
+	if getFeed.Project != nil && getFeed.Project.Id != nil {
+		d.Set("project_id", getFeed.Project.Id.String())
+		d.Set("scope", feedScopeProject)
+	} else {
+		d.Set("project_id", "")
+		d.Set("scope", feedScopeOrganization)
+	}
+	d.Set("description", getFeed.Description)
+	d.Set("badges_enabled", converter.ToBool(getFeed.BadgesEnabled, false))
+	d.Set("hide_deleted_package_versions", converter.ToBool(getFeed.HideDeletedPackageVersions, false))
+	d.Set("upstream_enabled", converter.ToBool(getFeed.UpstreamEnabled, false))
+	d.Set("upstream_sources", flattenUpstreamSources(getFeed.UpstreamSources))
+}
+
+// expandFeedUpdate only sets the attributes that changed, since UpdateFeed patches the feed.
+// It returns nil if none of them changed.
+func expandFeedUpdate(d *schema.ResourceData) (*feed.FeedUpdate, error) {
+	feedUpdate := feed.FeedUpdate{}
+	changed := false
+
+	if d.HasChange("name") {
+		feedUpdate.Name = converter.String(d.Get("name").(string))
+		changed = true
+	}
+	if d.HasChange("description") {
+		feedUpdate.Description = converter.String(d.Get("description").(string))
+		changed = true
+	}
+	if d.HasChange("badges_enabled") {
+		feedUpdate.BadgesEnabled = converter.Bool(d.Get("badges_enabled").(bool))
+		changed = true
+	}
+	if d.HasChange("hide_deleted_package_versions") {
+		feedUpdate.HideDeletedPackageVersions = converter.Bool(d.Get("hide_deleted_package_versions").(bool))
+		changed = true
+	}
+	if d.HasChange("upstream_enabled") {
+		feedUpdate.UpstreamEnabled = converter.Bool(d.Get("upstream_enabled").(bool))
+		changed = true
+	}
+	if d.HasChange("upstream_sources") {
+		upstreamSources, err := expandUpstreamSources(d)
+		if err != nil {
+			return nil, err
+		}
+		if upstreamSources == nil {
+			upstreamSources = &[]feed.UpstreamSource{}
+		}
+		feedUpdate.UpstreamSources = upstreamSources
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return &feedUpdate, nil
+}
+
+// the order of the upstream sources is the order in which Azure DevOps searches them for packages
+func expandUpstreamSources(d *schema.ResourceData) (*[]feed.UpstreamSource, error) {
+	sources := d.Get("upstream_sources").([]interface{})
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	names := map[string]bool{}
+	upstreamSources := make([]feed.UpstreamSource, 0, len(sources))
+	for _, raw := range sources {
+		source := raw.(map[string]interface{})
+		name := source["name"].(string)
+		if names[strings.ToLower(name)] {
+			return nil, fmt.Errorf(" upstream source %s is configured more than once", name)
+		}
+		names[strings.ToLower(name)] = true
+
+		sourceType := feed.UpstreamSourceType(source["upstream_source_type"].(string))
+		upstreamSources = append(upstreamSources, feed.UpstreamSource{
+			Name:               converter.String(name),
+			Protocol:           converter.String(strings.ToLower(source["protocol"].(string))),
+			Location:           converter.String(source["location"].(string)),
+			UpstreamSourceType: &sourceType,
+		})
+	}
+	return &upstreamSources, nil
+}
+
+// upstream sources that have been removed are kept by Azure DevOps with a deleted date
+func flattenUpstreamSources(upstreamSources *[]feed.UpstreamSource) []interface{} {
+	results := make([]interface{}, 0)
+	if upstreamSources == nil {
+		return results
+	}
+
+	for _, source := range *upstreamSources {
+		if source.DeletedDate != nil {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"name":     converter.ToString(source.Name, ""),
+			"protocol": converter.ToString(source.Protocol, ""),
+			"location": converter.ToString(source.Location, ""),
+		}
+		if source.UpstreamSourceType != nil {
+			output["upstream_source_type"] = string(*source.UpstreamSourceType)
+		}
+		if source.Id != nil {
+			output["id"] = source.Id.String()
+		}
+		results = append(results, output)
+	}
+	return results
+}
+
+func isFeedPermanentDelete(d *schema.ResourceData) bool {
+	features := d.Get("features").([]interface{})
+	if len(features) == 0 || features[0] == nil {
+		return false
+	}
+	return features[0].(map[string]interface{})["permanent_delete"].(bool)
+}
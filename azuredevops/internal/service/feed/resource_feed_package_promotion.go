@@ -0,0 +1,236 @@
+package feed
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/upackextras"
+)
+
+// package types a package version can be promoted for
+var packagePromotionProtocols = []string{"nuget", "npm", "maven", "pypi", "upack"}
+
+// ResourceFeedPackagePromotion schema and implementation for promoting a package version to a feed view
+func ResourceFeedPackagePromotion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedPackagePromotionCreate,
+		Read:   resourceFeedPackagePromotionRead,
+		Delete: resourceFeedPackagePromotionDelete,
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(packagePromotionProtocols, false),
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"package_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"view": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func resourceFeedPackagePromotionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+	packageName := d.Get("package_name").(string)
+	packageVersion := d.Get("package_version").(string)
+	view := d.Get("view").(string)
+
+	err := promotePackageVersion(clients, d)
+	if err != nil {
+		return fmt.Errorf(" promoting %s %s to view %s of feed %s: %+v", packageName, packageVersion, view, feedID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", feedID, view, d.Get("protocol").(string), packageName, packageVersion))
+	return resourceFeedPackagePromotionRead(d, m)
+}
+
+func resourceFeedPackagePromotionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+	packageName := d.Get("package_name").(string)
+	packageVersion := d.Get("package_version").(string)
+
+	packages, err := clients.FeedClient.GetPackages(clients.Ctx, feed.GetPackagesArgs{
+		FeedId:             converter.String(feedID),
+		Project:            converter.String(d.Get("project_id").(string)),
+		ProtocolType:       converter.String(d.Get("protocol").(string)),
+		PackageNameQuery:   converter.String(packageName),
+		IncludeAllVersions: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading package %s of feed %s: %+v", packageName, feedID, err)
+	}
+
+	// the promotion is gone if the package version was deleted, or removed from the view
+	version := findPackageVersion(packages, packageName, packageVersion)
+	if version == nil || !isPackageVersionInView(version, d.Get("view").(string)) {
+		d.SetId("")
+		return nil
+	}
+	return nil
+}
+
+// a package version cannot be taken out of a view, so the promotion is only removed from the state
+func resourceFeedPackagePromotionDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s %s stays in view %s of feed %s, package versions cannot be removed from a view",
+		d.Get("package_name").(string), d.Get("package_version").(string), d.Get("view").(string), d.Get("feed_id").(string))
+	d.SetId("")
+	return nil
+}
+
+// promotePackageVersion adds the package version to the view with the client of the package type
+func promotePackageVersion(clients *client.AggregatedClient, d *schema.ResourceData) error {
+	feedID := converter.String(d.Get("feed_id").(string))
+	project := converter.String(d.Get("project_id").(string))
+	packageName := d.Get("package_name").(string)
+	packageVersion := converter.String(d.Get("package_version").(string))
+	views := &webapi.JsonPatchOperation{
+		Op:    &webapi.OperationValues.Add,
+		Path:  converter.String("/views/-"),
+		Value: d.Get("view").(string),
+	}
+
+	switch d.Get("protocol").(string) {
+	case "nuget":
+		return clients.NuGetClient.UpdatePackageVersion(clients.Ctx, nuget.UpdatePackageVersionArgs{
+			PackageVersionDetails: &nuget.PackageVersionDetails{Views: views},
+			FeedId:                feedID,
+			PackageName:           converter.String(packageName),
+			PackageVersion:        packageVersion,
+			Project:               project,
+		})
+	case "npm":
+		// scoped packages are named @scope/name
+		if strings.HasPrefix(packageName, "@") && strings.Contains(packageName, "/") {
+			parts := strings.SplitN(strings.TrimPrefix(packageName, "@"), "/", 2)
+			_, err := clients.NpmClient.UpdateScopedPackage(clients.Ctx, npm.UpdateScopedPackageArgs{
+				PackageVersionDetails: &npm.PackageVersionDetails{Views: views},
+				FeedId:                feedID,
+				PackageScope:          converter.String(parts[0]),
+				UnscopedPackageName:   converter.String(parts[1]),
+				PackageVersion:        packageVersion,
+				Project:               project,
+			})
+			return err
+		}
+		_, err := clients.NpmClient.UpdatePackage(clients.Ctx, npm.UpdatePackageArgs{
+			PackageVersionDetails: &npm.PackageVersionDetails{Views: views},
+			FeedId:                feedID,
+			PackageName:           converter.String(packageName),
+			PackageVersion:        packageVersion,
+			Project:               project,
+		})
+		return err
+	case "maven":
+		// maven packages are named groupId:artifactId
+		parts := strings.SplitN(packageName, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf(" Unexpected format of maven package name %s, expected <group_id>:<artifact_id>", packageName)
+		}
+		return clients.MavenClient.UpdatePackageVersion(clients.Ctx, maven.UpdatePackageVersionArgs{
+			PackageVersionDetails: &maven.PackageVersionDetails{Views: views},
+			Feed:                  feedID,
+			GroupId:               converter.String(parts[0]),
+			ArtifactId:            converter.String(parts[1]),
+			Version:               packageVersion,
+			Project:               project,
+		})
+	case "pypi":
+		return clients.PyPiClient.UpdatePackageVersion(clients.Ctx, pypiapi.UpdatePackageVersionArgs{
+			PackageVersionDetails: &pypiapi.PackageVersionDetails{Views: views},
+			FeedId:                feedID,
+			PackageName:           converter.String(packageName),
+			PackageVersion:        packageVersion,
+			Project:               project,
+		})
+	case "upack":
+		return clients.UPackClientExtras.UpdatePackageVersion(clients.Ctx, upackextras.UpdatePackageVersionArgs{
+			PackageVersionDetails: &upackextras.PackageVersionDetails{Views: views},
+			FeedId:                feedID,
+			PackageName:           converter.String(packageName),
+			PackageVersion:        packageVersion,
+			Project:               project,
+		})
+	}
+	return fmt.Errorf(" Unsupported package type %s", d.Get("protocol").(string))
+}
+
+func findPackageVersion(packages *[]feed.Package, packageName string, packageVersion string) *feed.MinimalPackageVersion {
+	if packages == nil {
+		return nil
+	}
+
+	for _, p := range *packages {
+		if p.Name == nil || !strings.EqualFold(*p.Name, packageName) || p.Versions == nil {
+			continue
+		}
+		for i, version := range *p.Versions {
+			if version.Version != nil && strings.EqualFold(*version.Version, packageVersion) {
+				return &(*p.Versions)[i]
+			}
+		}
+	}
+	return nil
+}
+
+// the view can be given by its name or its ID
+func isPackageVersionInView(version *feed.MinimalPackageVersion, view string) bool {
+	if version.Views == nil {
+		return false
+	}
+
+	for _, v := range *version.Views {
+		if v.Id != nil && strings.EqualFold(v.Id.String(), view) {
+			return true
+		}
+		if v.Name != nil && strings.EqualFold(*v.Name, strings.TrimPrefix(view, "@")) {
+			return true
+		}
+	}
+	return false
+}
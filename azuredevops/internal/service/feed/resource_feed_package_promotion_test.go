@@ -0,0 +1,170 @@
+//go:build (all || resource_feed_package_promotion) && !exclude_feed
+// +build all resource_feed_package_promotion
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPackagePromotionFeedID = uuid.New()
+var testPackagePromotionViewID = uuid.New()
+
+func getFeedPackagePromotionTestResourceData(t *testing.T, protocol string, packageName string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeedPackagePromotion().Schema, map[string]interface{}{
+		"feed_id":         testPackagePromotionFeedID.String(),
+		"protocol":        protocol,
+		"package_name":    packageName,
+		"package_version": "1.0.0",
+		"view":            "Release",
+	})
+}
+
+func getTestPromotedPackages(viewName string) *[]feed.Package {
+	return &[]feed.Package{
+		{
+			Name: converter.String("Example.Package"),
+			Versions: &[]feed.MinimalPackageVersion{
+				{Version: converter.String("0.9.0")},
+				{
+					Version: converter.String("1.0.0"),
+					Views: &[]feed.FeedView{
+						{Id: &testPackagePromotionViewID, Name: converter.String(viewName)},
+					},
+				},
+			},
+		},
+	}
+}
+
+// verifies that a NuGet package version is added to the view and read back
+func TestFeedPackagePromotion_Create_NuGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	nugetClient := azdosdkmocks.NewMockNugetClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, NuGetClient: nugetClient, Ctx: context.Background()}
+
+	nugetClient.
+		EXPECT().
+		UpdatePackageVersion(clients.Ctx, nuget.UpdatePackageVersionArgs{
+			PackageVersionDetails: &nuget.PackageVersionDetails{
+				Views: &webapi.JsonPatchOperation{
+					Op:    &webapi.OperationValues.Add,
+					Path:  converter.String("/views/-"),
+					Value: "Release",
+				},
+			},
+			FeedId:         converter.String(testPackagePromotionFeedID.String()),
+			PackageName:    converter.String("Example.Package"),
+			PackageVersion: converter.String("1.0.0"),
+			Project:        converter.String(""),
+		}).
+		Return(nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetPackages(clients.Ctx, gomock.Any()).
+		Return(getTestPromotedPackages("Release"), nil).
+		Times(1)
+
+	resourceData := getFeedPackagePromotionTestResourceData(t, "nuget", "Example.Package")
+	err := resourceFeedPackagePromotionCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEqual(t, "", resourceData.Id())
+}
+
+// verifies that scoped npm packages are promoted with their scope
+func TestFeedPackagePromotion_Create_ScopedNpmPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	npmClient := azdosdkmocks.NewMockNpmClient(ctrl)
+	clients := &client.AggregatedClient{NpmClient: npmClient, Ctx: context.Background()}
+
+	npmClient.
+		EXPECT().
+		UpdateScopedPackage(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args npm.UpdateScopedPackageArgs) (*npm.Package, error) {
+			require.Equal(t, "example", *args.PackageScope)
+			require.Equal(t, "package", *args.UnscopedPackageName)
+			return nil, errors.New("UpdateScopedPackage() Failed")
+		}).
+		Times(1)
+
+	err := resourceFeedPackagePromotionCreate(getFeedPackagePromotionTestResourceData(t, "npm", "@example/package"), clients)
+	require.Contains(t, err.Error(), "UpdateScopedPackage() Failed")
+}
+
+// verifies that maven package names must contain the group ID and artifact ID
+func TestFeedPackagePromotion_Create_InvalidMavenPackageName(t *testing.T) {
+	clients := &client.AggregatedClient{Ctx: context.Background()}
+
+	err := resourceFeedPackagePromotionCreate(getFeedPackagePromotionTestResourceData(t, "maven", "artifact"), clients)
+	require.Contains(t, err.Error(), "expected <group_id>:<artifact_id>")
+}
+
+// verifies that a package version no longer in the view is removed from the state
+func TestFeedPackagePromotion_Read_NotInView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetPackages(clients.Ctx, feed.GetPackagesArgs{
+			FeedId:             converter.String(testPackagePromotionFeedID.String()),
+			Project:            converter.String(""),
+			ProtocolType:       converter.String("nuget"),
+			PackageNameQuery:   converter.String("Example.Package"),
+			IncludeAllVersions: converter.Bool(true),
+		}).
+		Return(getTestPromotedPackages("Prerelease"), nil).
+		Times(1)
+
+	resourceData := getFeedPackagePromotionTestResourceData(t, "nuget", "Example.Package")
+	resourceData.SetId("promotion")
+	err := resourceFeedPackagePromotionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the view may be given by its ID
+func TestFeedPackagePromotion_Read_ViewById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetPackages(clients.Ctx, gomock.Any()).
+		Return(getTestPromotedPackages("Release"), nil).
+		Times(1)
+
+	resourceData := getFeedPackagePromotionTestResourceData(t, "nuget", "example.package")
+	resourceData.Set("view", testPackagePromotionViewID.String())
+	resourceData.SetId("promotion")
+	err := resourceFeedPackagePromotionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "promotion", resourceData.Id())
+}
@@ -0,0 +1,294 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+var feedPermissionRoles = []string{
+	string(feed.FeedRoleValues.None),
+	string(feed.FeedRoleValues.Reader),
+	string(feed.FeedRoleValues.Contributor),
+	string(feed.FeedRoleValues.Collaborator),
+	string(feed.FeedRoleValues.Administrator),
+}
+
+// ResourceFeedPermission schema and implementation for the role of an identity on a feed
+func ResourceFeedPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedPermissionCreate,
+		Read:   resourceFeedPermissionRead,
+		Update: resourceFeedPermissionUpdate,
+		Delete: resourceFeedPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedPermissionImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"identity_descriptor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"identity_descriptor", "principal_name"},
+			},
+			"principal_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"identity_descriptor", "principal_name"},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(feedPermissionRoles, false),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"identity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFeedPermissionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	if v, ok := d.GetOk("principal_name"); ok {
+		descriptor, err := getIdentityDescriptorByPrincipalName(clients, v.(string))
+		if err != nil {
+			return err
+		}
+		d.Set("identity_descriptor", descriptor)
+	}
+	identityDescriptor := d.Get("identity_descriptor").(string)
+
+	// the feed may have been created just before and not be found yet
+	if err := waitForFeedAvailable(clients, feedID, d.Get("project_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	existing, err := getFeedPermission(clients, feedID, d.Get("project_id").(string), identityDescriptor)
+	if err != nil {
+		return fmt.Errorf(" reading permissions of feed %s: %+v", feedID, err)
+	}
+	// none is meant to take away a role the identity already has, e.g. one assigned when the feed was created
+	role := feed.FeedRole(d.Get("role").(string))
+	if existing != nil && role != feed.FeedRoleValues.None {
+		return fmt.Errorf(" Feed %s already has the role %s assigned to identity %s, import it to manage it", feedID, *existing.Role, identityDescriptor)
+	}
+
+	err = setFeedPermission(clients, d, role)
+	if err != nil {
+		return fmt.Errorf(" setting role %s on feed %s: %+v", role, feedID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", feedID, identityDescriptor))
+	return resourceFeedPermissionRead(d, m)
+}
+
+func resourceFeedPermissionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	permission, err := getFeedPermission(clients, feedID, d.Get("project_id").(string), d.Get("identity_descriptor").(string))
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading permissions of feed %s: %+v", feedID, err)
+	}
+	if permission == nil {
+		// without an explicit role, the identity is in the state that none describes
+		if d.Get("role").(string) == string(feed.FeedRoleValues.None) {
+			return nil
+		}
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", string(*permission.Role))
+	if permission.IdentityId != nil {
+		d.Set("identity_id", permission.IdentityId.String())
+	}
+	return nil
+}
+
+func resourceFeedPermissionUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	err := setFeedPermission(clients, d, feed.FeedRole(d.Get("role").(string)))
+	if err != nil {
+		return fmt.Errorf(" setting role %s on feed %s: %+v", d.Get("role").(string), d.Get("feed_id").(string), err)
+	}
+	return resourceFeedPermissionRead(d, m)
+}
+
+// removing the explicit role lets the identity fall back to the roles it inherits
+func resourceFeedPermissionDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	err := setFeedPermission(clients, d, feed.FeedRoleValues.None)
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing role from feed %s: %+v", d.Get("feed_id").(string), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceFeedPermissionImport accepts <feed_id>/<identity_descriptor> for an organization scoped feed and
+// <project_id>/<feed_id>/<identity_descriptor> for a project scoped feed
+func resourceFeedPermissionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf(" Unexpected format of import ID %s, expected <feed_id>/<identity_descriptor> or <project_id>/<feed_id>/<identity_descriptor>", d.Id())
+	}
+	for _, part := range parts[:len(parts)-1] {
+		if _, err := uuid.Parse(part); err != nil {
+			return nil, fmt.Errorf(" %s of import ID %s is not a UUID: %+v", part, d.Id(), err)
+		}
+	}
+
+	if len(parts) == 3 {
+		d.Set("project_id", parts[0])
+		parts = parts[1:]
+	}
+	d.Set("feed_id", parts[0])
+	d.Set("identity_descriptor", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getFeedPermission returns the role explicitly assigned to the identity on the feed, or nil if there is none
+func getFeedPermission(clients *client.AggregatedClient, feedID string, projectID string, descriptor string) (*feed.FeedPermission, error) {
+	identityDescriptor, err := getIdentityDescriptor(clients, descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := clients.FeedClient.GetFeedPermissions(clients.Ctx, feed.GetFeedPermissionsArgs{
+		FeedId:                      converter.String(feedID),
+		Project:                     converter.String(projectID),
+		IdentityDescriptor:          converter.String(identityDescriptor),
+		IncludeIds:                  converter.Bool(true),
+		ExcludeInheritedPermissions: converter.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if permissions != nil {
+		for _, permission := range *permissions {
+			if permission.IdentityDescriptor == nil || !strings.EqualFold(*permission.IdentityDescriptor, identityDescriptor) {
+				continue
+			}
+			if permission.Role == nil || *permission.Role == feed.FeedRoleValues.None {
+				continue
+			}
+			return &permission, nil
+		}
+	}
+	return nil, nil
+}
+
+func setFeedPermission(clients *client.AggregatedClient, d *schema.ResourceData, role feed.FeedRole) error {
+	identityDescriptor, err := getIdentityDescriptor(clients, d.Get("identity_descriptor").(string))
+	if err != nil {
+		return err
+	}
+
+	permission := feed.FeedPermission{
+		IdentityDescriptor: converter.String(identityDescriptor),
+		Role:               &role,
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		permission.DisplayName = converter.String(v.(string))
+	}
+
+	_, err = clients.FeedClient.SetFeedPermissions(clients.Ctx, feed.SetFeedPermissionsArgs{
+		FeedId:         converter.String(d.Get("feed_id").(string)),
+		Project:        converter.String(d.Get("project_id").(string)),
+		FeedPermission: &[]feed.FeedPermission{permission},
+	})
+	return err
+}
+
+// getIdentityDescriptor resolves the subject descriptor of a user or group, as exported by the graph resources,
+// to the identity descriptor the feed API works with. Identity descriptors are returned as they are.
+func getIdentityDescriptor(clients *client.AggregatedClient, descriptor string) (string, error) {
+	if strings.Contains(descriptor, ";") {
+		return descriptor, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(descriptor),
+	})
+	if err != nil {
+		return "", err
+	}
+	if identities == nil || len(*identities) == 0 || (*identities)[0].Descriptor == nil {
+		return "", fmt.Errorf(" Unable to find identity with descriptor %s", descriptor)
+	}
+	return *(*identities)[0].Descriptor, nil
+}
+
+// getIdentityDescriptorByPrincipalName looks up the identity descriptor of a user by its UPN, or of a group
+// by its principal name, e.g. [project]\group
+func getIdentityDescriptorByPrincipalName(clients *client.AggregatedClient, principalName string) (string, error) {
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SearchFilter: converter.String("General"),
+		FilterValue:  converter.String(principalName),
+	})
+	if err != nil {
+		return "", fmt.Errorf(" looking up identity %s: %+v", principalName, err)
+	}
+
+	if identities == nil || len(*identities) == 0 {
+		return "", fmt.Errorf(" Unable to find identity with principal name %s", principalName)
+	}
+	if len(*identities) > 1 {
+		return "", fmt.Errorf(" Found %d identities with principal name %s", len(*identities), principalName)
+	}
+	if (*identities)[0].Descriptor == nil {
+		return "", fmt.Errorf(" Identity %s has no descriptor", principalName)
+	}
+	return *(*identities)[0].Descriptor, nil
+}
@@ -0,0 +1,367 @@
+//go:build (all || resource_feed_permission) && !exclude_feed
+// +build all resource_feed_permission
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedPermissionFeedID = uuid.New()
+var testFeedPermissionIdentityID = uuid.New()
+var testFeedPermissionDescriptor = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969-2402986413-2179408616-3-1"
+
+func getFeedPermissionTestResourceData(t *testing.T, role string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeedPermission().Schema, map[string]interface{}{
+		"feed_id":             testFeedPermissionFeedID.String(),
+		"identity_descriptor": testFeedPermissionDescriptor,
+		"role":                role,
+	})
+}
+
+// verifies that an identity that already has an explicit role is not silently taken over
+func TestFeedPermission_Create_RejectsExistingRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feed.Feed{Id: &testFeedPermissionFeedID}, nil).
+		Times(1)
+
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+				Role:               &feed.FeedRoleValues.Contributor,
+			},
+		}, nil).
+		Times(1)
+
+	err := resourceFeedPermissionCreate(getFeedPermissionTestResourceData(t, "reader"), clients)
+	require.Contains(t, err.Error(), "already has the role contributor")
+}
+
+// verifies that the role is set and read back
+func TestFeedPermission_Create_SetsRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feed.Feed{Id: &testFeedPermissionFeedID}, nil).
+		Times(1)
+
+	resourceData := getFeedPermissionTestResourceData(t, "reader")
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(&[]feed.FeedPermission{}, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			SetFeedPermissions(clients.Ctx, feed.SetFeedPermissionsArgs{
+				FeedId:  converter.String(testFeedPermissionFeedID.String()),
+				Project: converter.String(""),
+				FeedPermission: &[]feed.FeedPermission{
+					{
+						IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+						Role:               &feed.FeedRoleValues.Reader,
+					},
+				},
+			}).
+			Return(nil, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(&[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+					IdentityId:         &testFeedPermissionIdentityID,
+					Role:               &feed.FeedRoleValues.Reader,
+				},
+			}, nil).
+			Times(1),
+	)
+
+	err := resourceFeedPermissionCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedPermissionFeedID.String()+"/"+testFeedPermissionDescriptor, resourceData.Id())
+	require.Equal(t, testFeedPermissionIdentityID.String(), resourceData.Get("identity_id"))
+}
+
+// verifies that the subject descriptor of a group is resolved to its identity descriptor
+func TestFeedPermission_Read_ResolvesSubjectDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedPermission().Schema, map[string]interface{}{
+		"feed_id":             testFeedPermissionFeedID.String(),
+		"identity_descriptor": "vssgp.Uy0xLTktMTU1MTM3NDI0NQ",
+		"role":                "reader",
+	})
+	resourceData.SetId(testFeedPermissionFeedID.String() + "/vssgp.Uy0xLTktMTU1MTM3NDI0NQ")
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.Uy0xLTktMTU1MTM3NDI0NQ")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String(testFeedPermissionDescriptor)}}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args feed.GetFeedPermissionsArgs) (*[]feed.FeedPermission, error) {
+			require.Equal(t, testFeedPermissionDescriptor, *args.IdentityDescriptor)
+			return &[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+					Role:               &feed.FeedRoleValues.Administrator,
+				},
+			}, nil
+		}).
+		Times(1)
+
+	err := resourceFeedPermissionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "administrator", resourceData.Get("role"))
+}
+
+// verifies that none takes away a role the identity already has
+func TestFeedPermission_Create_NoneRemovesExistingRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feed.Feed{Id: &testFeedPermissionFeedID}, nil).
+		Times(1)
+
+	resourceData := getFeedPermissionTestResourceData(t, "none")
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(&[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+					Role:               &feed.FeedRoleValues.Contributor,
+				},
+			}, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			SetFeedPermissions(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args feed.SetFeedPermissionsArgs) (*[]feed.FeedPermission, error) {
+				require.Equal(t, feed.FeedRoleValues.None, *(*args.FeedPermission)[0].Role)
+				return nil, nil
+			}).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(&[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+					Role:               &feed.FeedRoleValues.None,
+				},
+			}, nil).
+			Times(1),
+	)
+
+	err := resourceFeedPermissionCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedPermissionFeedID.String()+"/"+testFeedPermissionDescriptor, resourceData.Id())
+	require.Equal(t, "none", resourceData.Get("role"))
+}
+
+// verifies that a role assigned outside of Terraform shows up as drift of none
+func TestFeedPermission_Read_NoneDetectsAssignedRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedPermissionTestResourceData(t, "none")
+	resourceData.SetId(testFeedPermissionFeedID.String() + "/" + testFeedPermissionDescriptor)
+
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+				Role:               &feed.FeedRoleValues.Reader,
+			},
+		}, nil).
+		Times(1)
+
+	err := resourceFeedPermissionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "reader", resourceData.Get("role"))
+}
+
+// verifies that a role removed outside of Terraform removes the resource from the state
+func TestFeedPermission_Read_RemovesMissingRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedPermissionTestResourceData(t, "reader")
+	resourceData.SetId(testFeedPermissionFeedID.String() + "/" + testFeedPermissionDescriptor)
+
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{}, nil).
+		Times(1)
+
+	err := resourceFeedPermissionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestFeedPermission_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedPermissionTestResourceData(t, "reader")
+	resourceData.SetId(testFeedPermissionFeedID.String() + "/" + testFeedPermissionDescriptor)
+
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args feed.SetFeedPermissionsArgs) (*[]feed.FeedPermission, error) {
+			require.Equal(t, feed.FeedRoleValues.None, *(*args.FeedPermission)[0].Role)
+			return nil, errors.New("SetFeedPermissions() Failed")
+		}).
+		Times(1)
+
+	err := resourceFeedPermissionDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "SetFeedPermissions() Failed")
+}
+
+// verifies that a permission of a project scoped feed can be imported
+func TestFeedPermission_Import_ProjectScopedFeed(t *testing.T) {
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedPermission().Schema, nil)
+	resourceData.SetId(projectID + "/" + testFeedPermissionFeedID.String() + "/" + testFeedPermissionDescriptor)
+
+	_, err := resourceFeedPermissionImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, projectID, resourceData.Get("project_id"))
+	require.Equal(t, testFeedPermissionFeedID.String(), resourceData.Get("feed_id"))
+	require.Equal(t, testFeedPermissionDescriptor, resourceData.Get("identity_descriptor"))
+	require.Equal(t, testFeedPermissionFeedID.String()+"/"+testFeedPermissionDescriptor, resourceData.Id())
+}
+
+// verifies that a principal name is resolved to the identity descriptor the role is assigned to
+func TestFeedPermission_Create_ResolvesPrincipalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feed.Feed{Id: &testFeedPermissionFeedID}, nil).
+		Times(1)
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SearchFilter: converter.String("General"),
+			FilterValue:  converter.String("user@example.com"),
+		}).
+		Return(&[]identity.Identity{
+			{Descriptor: converter.String(testFeedPermissionDescriptor)},
+		}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionDescriptor),
+				Role:               &feed.FeedRoleValues.Contributor,
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedPermission().Schema, map[string]interface{}{
+		"feed_id":        testFeedPermissionFeedID.String(),
+		"principal_name": "user@example.com",
+		"role":           "reader",
+	})
+	err := resourceFeedPermissionCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "already has the role contributor")
+	require.Equal(t, testFeedPermissionDescriptor, resourceData.Get("identity_descriptor"))
+}
+
+// verifies that an ambiguous principal name is reported
+func TestFeedPermission_Create_AmbiguousPrincipalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{
+			{Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1")},
+			{Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-2")},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedPermission().Schema, map[string]interface{}{
+		"feed_id":        testFeedPermissionFeedID.String(),
+		"principal_name": "[project]\\Readers",
+		"role":           "reader",
+	})
+	err := resourceFeedPermissionCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "Found 2 identities with principal name [project]\\Readers")
+}
@@ -0,0 +1,303 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceFeedPermissions schema and implementation for the complete set of roles assigned on a feed
+func ResourceFeedPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedPermissionsCreate,
+		Read:   resourceFeedPermissionsRead,
+		Update: resourceFeedPermissionsCreateUpdate,
+		Delete: resourceFeedPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedPermissionsImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"permission": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_descriptor": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Required: true,
+							// none is what removes a role, roles that are not configured are removed anyway
+							ValidateFunc: validation.StringInSlice(feedPermissionRoles[1:], false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFeedPermissionsCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	// the feed may have been created just before and not be found yet
+	if err := waitForFeedAvailable(clients, d.Get("feed_id").(string), d.Get("project_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	return resourceFeedPermissionsCreateUpdate(d, m)
+}
+
+// resourceFeedPermissionsCreateUpdate assigns the configured roles and removes all other explicit roles in one call
+func resourceFeedPermissionsCreateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	configured, err := expandFeedPermissions(clients, d.Get("permission").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf(" resolving identities of feed %s permissions: %+v", feedID, err)
+	}
+
+	existing, err := getExplicitFeedPermissions(clients, feedID, projectID)
+	if err != nil {
+		return fmt.Errorf(" reading permissions of feed %s: %+v", feedID, err)
+	}
+
+	permissions := make([]feed.FeedPermission, 0, len(configured)+len(existing))
+	for _, permission := range configured {
+		permissions = append(permissions, permission)
+	}
+	for _, permission := range existing {
+		if _, ok := configured[strings.ToLower(*permission.IdentityDescriptor)]; ok {
+			continue
+		}
+		permissions = append(permissions, feed.FeedPermission{
+			IdentityDescriptor: permission.IdentityDescriptor,
+			Role:               &feed.FeedRoleValues.None,
+		})
+	}
+
+	_, err = clients.FeedClient.SetFeedPermissions(clients.Ctx, feed.SetFeedPermissionsArgs{
+		FeedId:         converter.String(feedID),
+		Project:        converter.String(projectID),
+		FeedPermission: &permissions,
+	})
+	if err != nil {
+		return fmt.Errorf(" setting permissions of feed %s: %+v", feedID, err)
+	}
+
+	d.SetId(feedID)
+	return resourceFeedPermissionsRead(d, m)
+}
+
+func resourceFeedPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	permissions, err := getExplicitFeedPermissions(clients, feedID, d.Get("project_id").(string))
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading permissions of feed %s: %+v", feedID, err)
+	}
+
+	// keep the descriptors as configured, subject descriptors are resolved to identity descriptors by the API.
+	// Descriptors which cannot be resolved any more are left out, so their roles show up as drift.
+	identityDescriptors, err := getIdentityDescriptors(clients, getConfiguredFeedPermissionDescriptors(d.Get("permission").(*schema.Set)))
+	if err != nil {
+		return fmt.Errorf(" resolving identities of feed %s permissions: %+v", feedID, err)
+	}
+	descriptors := map[string]string{}
+	for configured, identityDescriptor := range identityDescriptors {
+		descriptors[strings.ToLower(identityDescriptor)] = configured
+	}
+
+	results := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		descriptor := *permission.IdentityDescriptor
+		if configured, ok := descriptors[strings.ToLower(descriptor)]; ok {
+			descriptor = configured
+		}
+		results = append(results, map[string]interface{}{
+			"identity_descriptor": descriptor,
+			"role":                string(*permission.Role),
+		})
+	}
+	d.Set("permission", results)
+	return nil
+}
+
+// removing the explicit roles lets the identities fall back to the roles they inherit
+func resourceFeedPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	configured, err := expandFeedPermissions(clients, d.Get("permission").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf(" resolving identities of feed %s permissions: %+v", feedID, err)
+	}
+
+	permissions := make([]feed.FeedPermission, 0, len(configured))
+	for _, permission := range configured {
+		permissions = append(permissions, feed.FeedPermission{
+			IdentityDescriptor: permission.IdentityDescriptor,
+			Role:               &feed.FeedRoleValues.None,
+		})
+	}
+
+	_, err = clients.FeedClient.SetFeedPermissions(clients.Ctx, feed.SetFeedPermissionsArgs{
+		FeedId:         converter.String(feedID),
+		Project:        converter.String(d.Get("project_id").(string)),
+		FeedPermission: &permissions,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing permissions of feed %s: %+v", feedID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceFeedPermissionsImport accepts the same IDs as the feed resource
+func resourceFeedPermissionsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := resourceFeedImport(d, m); err != nil {
+		return nil, err
+	}
+	d.Set("feed_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandFeedPermissions returns the configured roles keyed by the lower case identity descriptor
+func expandFeedPermissions(clients *client.AggregatedClient, set *schema.Set) (map[string]feed.FeedPermission, error) {
+	identityDescriptors, err := getIdentityDescriptors(clients, getConfiguredFeedPermissionDescriptors(set))
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := map[string]feed.FeedPermission{}
+	for _, raw := range set.List() {
+		permission := raw.(map[string]interface{})
+		configured := permission["identity_descriptor"].(string)
+		identityDescriptor, ok := identityDescriptors[configured]
+		if !ok {
+			return nil, fmt.Errorf(" Unable to find identity with descriptor %s", configured)
+		}
+
+		key := strings.ToLower(identityDescriptor)
+		if _, ok := permissions[key]; ok {
+			return nil, fmt.Errorf(" Identity %s is assigned more than one role", configured)
+		}
+		role := feed.FeedRole(permission["role"].(string))
+		permissions[key] = feed.FeedPermission{
+			IdentityDescriptor: converter.String(identityDescriptor),
+			Role:               &role,
+		}
+	}
+	return permissions, nil
+}
+
+func getConfiguredFeedPermissionDescriptors(set *schema.Set) []string {
+	descriptors := make([]string, 0, set.Len())
+	for _, raw := range set.List() {
+		descriptors = append(descriptors, raw.(map[string]interface{})["identity_descriptor"].(string))
+	}
+	return descriptors
+}
+
+// getIdentityDescriptors maps each descriptor to the identity descriptor the feed API works with, resolving all
+// subject descriptors in a single call. Subject descriptors which do not belong to an identity are left out.
+func getIdentityDescriptors(clients *client.AggregatedClient, descriptors []string) (map[string]string, error) {
+	identityDescriptors := map[string]string{}
+	subjectDescriptors := []string{}
+	for _, descriptor := range descriptors {
+		if strings.Contains(descriptor, ";") {
+			identityDescriptors[descriptor] = descriptor
+		} else {
+			subjectDescriptors = append(subjectDescriptors, descriptor)
+		}
+	}
+	if len(subjectDescriptors) == 0 {
+		return identityDescriptors, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(strings.Join(subjectDescriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if identities == nil {
+		return identityDescriptors, nil
+	}
+
+	for _, subjectDescriptor := range subjectDescriptors {
+		for _, item := range *identities {
+			if item.SubjectDescriptor != nil && item.Descriptor != nil &&
+				strings.EqualFold(*item.SubjectDescriptor, subjectDescriptor) {
+				identityDescriptors[subjectDescriptor] = *item.Descriptor
+				break
+			}
+		}
+	}
+	return identityDescriptors, nil
+}
+
+// getExplicitFeedPermissions returns the roles explicitly assigned on the feed, leaving out inherited roles
+func getExplicitFeedPermissions(clients *client.AggregatedClient, feedID string, projectID string) ([]feed.FeedPermission, error) {
+	permissions, err := clients.FeedClient.GetFeedPermissions(clients.Ctx, feed.GetFeedPermissionsArgs{
+		FeedId:                      converter.String(feedID),
+		Project:                     converter.String(projectID),
+		IncludeIds:                  converter.Bool(true),
+		ExcludeInheritedPermissions: converter.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := []feed.FeedPermission{}
+	if permissions == nil {
+		return results, nil
+	}
+	for _, permission := range *permissions {
+		if permission.IdentityDescriptor == nil || permission.Role == nil || *permission.Role == feed.FeedRoleValues.None {
+			continue
+		}
+		if permission.IsInheritedRole != nil && *permission.IsInheritedRole {
+			continue
+		}
+		results = append(results, permission)
+	}
+	return results, nil
+}
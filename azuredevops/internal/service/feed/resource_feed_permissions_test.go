@@ -0,0 +1,264 @@
+//go:build (all || resource_feed_permissions) && !exclude_feed
+// +build all resource_feed_permissions
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedPermissionsFeedID = uuid.New()
+var testFeedPermissionsReaderDescriptor = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969-2402986413-2179408616-3-1"
+var testFeedPermissionsOtherDescriptor = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969-2402986413-2179408616-3-2"
+
+func getFeedPermissionsTestResourceData(t *testing.T, descriptor string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeedPermissions().Schema, map[string]interface{}{
+		"feed_id": testFeedPermissionsFeedID.String(),
+		"permission": []interface{}{
+			map[string]interface{}{
+				"identity_descriptor": descriptor,
+				"role":                "reader",
+			},
+		},
+	})
+}
+
+// verifies that roles which are not configured are removed in the same call that sets the configured roles
+func TestFeedPermissions_Create_RemovesUnconfiguredRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	existing := &[]feed.FeedPermission{
+		{
+			IdentityDescriptor: converter.String(testFeedPermissionsOtherDescriptor),
+			Role:               &feed.FeedRoleValues.Administrator,
+		},
+	}
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			GetFeed(clients.Ctx, gomock.Any()).
+			Return(&feed.Feed{Id: &testFeedPermissionsFeedID}, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(existing, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			SetFeedPermissions(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args feed.SetFeedPermissionsArgs) (*[]feed.FeedPermission, error) {
+				require.Equal(t, testFeedPermissionsFeedID.String(), *args.FeedId)
+				require.ElementsMatch(t, []feed.FeedPermission{
+					{
+						IdentityDescriptor: converter.String(testFeedPermissionsReaderDescriptor),
+						Role:               &feed.FeedRoleValues.Reader,
+					},
+					{
+						IdentityDescriptor: converter.String(testFeedPermissionsOtherDescriptor),
+						Role:               &feed.FeedRoleValues.None,
+					},
+				}, *args.FeedPermission)
+				return nil, nil
+			}).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeedPermissions(clients.Ctx, gomock.Any()).
+			Return(&[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionsReaderDescriptor),
+					Role:               &feed.FeedRoleValues.Reader,
+				},
+			}, nil).
+			Times(1),
+	)
+
+	resourceData := getFeedPermissionsTestResourceData(t, testFeedPermissionsReaderDescriptor)
+	err := resourceFeedPermissionsCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedPermissionsFeedID.String(), resourceData.Id())
+	require.Equal(t, 1, resourceData.Get("permission").(*schema.Set).Len())
+}
+
+// verifies that subject descriptors stay in the state as configured
+func TestFeedPermissions_Read_KeepsSubjectDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	subjectDescriptor := "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String(subjectDescriptor)}).
+		Return(&[]identity.Identity{
+			{
+				Descriptor:        converter.String(testFeedPermissionsReaderDescriptor),
+				SubjectDescriptor: converter.String(subjectDescriptor),
+			},
+		}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionsReaderDescriptor),
+				Role:               &feed.FeedRoleValues.Reader,
+			},
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionsOtherDescriptor),
+				Role:               &feed.FeedRoleValues.Contributor,
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := getFeedPermissionsTestResourceData(t, subjectDescriptor)
+	resourceData.SetId(testFeedPermissionsFeedID.String())
+	err := resourceFeedPermissionsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	descriptors := map[string]string{}
+	for _, raw := range resourceData.Get("permission").(*schema.Set).List() {
+		permission := raw.(map[string]interface{})
+		descriptors[permission["identity_descriptor"].(string)] = permission["role"].(string)
+	}
+	require.Equal(t, map[string]string{
+		subjectDescriptor:                  "reader",
+		testFeedPermissionsOtherDescriptor: "contributor",
+	}, descriptors)
+}
+
+// verifies that all subject descriptors are resolved in one call, and that a descriptor which cannot be
+// resolved any more shows up as drift instead of failing the read
+func TestFeedPermissions_Read_UnresolvableDescriptorIsDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	readerSubjectDescriptor := "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5"
+	deletedSubjectDescriptor := "aad.OGQ5ZmM4ZWEtZDI0My03OTBjLWE2ZGEtNGU0MzkyYzQ5NjNm"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args identity.ReadIdentitiesArgs) (*[]identity.Identity, error) {
+			require.ElementsMatch(t, []string{readerSubjectDescriptor, deletedSubjectDescriptor}, strings.Split(*args.SubjectDescriptors, ","))
+			return &[]identity.Identity{
+				{
+					Descriptor:        converter.String(testFeedPermissionsReaderDescriptor),
+					SubjectDescriptor: converter.String(readerSubjectDescriptor),
+				},
+			}, nil
+		}).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{
+			{
+				IdentityDescriptor: converter.String(testFeedPermissionsReaderDescriptor),
+				Role:               &feed.FeedRoleValues.Reader,
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedPermissions().Schema, map[string]interface{}{
+		"feed_id": testFeedPermissionsFeedID.String(),
+		"permission": []interface{}{
+			map[string]interface{}{
+				"identity_descriptor": readerSubjectDescriptor,
+				"role":                "reader",
+			},
+			map[string]interface{}{
+				"identity_descriptor": deletedSubjectDescriptor,
+				"role":                "contributor",
+			},
+		},
+	})
+	resourceData.SetId(testFeedPermissionsFeedID.String())
+	err := resourceFeedPermissionsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	permissions := resourceData.Get("permission").(*schema.Set).List()
+	require.Len(t, permissions, 1)
+	require.Equal(t, readerSubjectDescriptor, permissions[0].(map[string]interface{})["identity_descriptor"])
+}
+
+// verifies that errors setting the roles are returned
+func TestFeedPermissions_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedPermission{}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("SetFeedPermissions() Failed")).
+		Times(1)
+
+	resourceData := getFeedPermissionsTestResourceData(t, testFeedPermissionsReaderDescriptor)
+	resourceData.SetId(testFeedPermissionsFeedID.String())
+	err := resourceFeedPermissionsCreateUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "SetFeedPermissions() Failed")
+}
+
+// verifies that destroying the resource removes only the configured roles
+func TestFeedPermissions_Delete_RemovesConfiguredRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.Ctx, feed.SetFeedPermissionsArgs{
+			FeedId:  converter.String(testFeedPermissionsFeedID.String()),
+			Project: converter.String(""),
+			FeedPermission: &[]feed.FeedPermission{
+				{
+					IdentityDescriptor: converter.String(testFeedPermissionsReaderDescriptor),
+					Role:               &feed.FeedRoleValues.None,
+				},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	resourceData := getFeedPermissionsTestResourceData(t, testFeedPermissionsReaderDescriptor)
+	resourceData.SetId(testFeedPermissionsFeedID.String())
+	err := resourceFeedPermissionsDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
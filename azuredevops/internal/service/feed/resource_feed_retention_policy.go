@@ -0,0 +1,116 @@
+package feed
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceFeedRetentionPolicy schema and implementation for feed retention policy resource
+func ResourceFeedRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedRetentionPolicyCreateUpdate,
+		Read:   resourceFeedRetentionPolicyRead,
+		Update: resourceFeedRetentionPolicyCreateUpdate,
+		Delete: resourceFeedRetentionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedRetentionPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"count_limit": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"days_to_keep_recently_downloaded_packages": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceFeedRetentionPolicyCreateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	_, err := clients.FeedClient.SetFeedRetentionPolicies(clients.Ctx, feed.SetFeedRetentionPoliciesArgs{
+		Policy: &feed.FeedRetentionPolicy{
+			CountLimit:                           converter.Int(d.Get("count_limit").(int)),
+			DaysToKeepRecentlyDownloadedPackages: converter.Int(d.Get("days_to_keep_recently_downloaded_packages").(int)),
+		},
+		FeedId:  converter.String(feedID),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" setting retention policy of feed %s: %+v", feedID, err)
+	}
+
+	d.SetId(feedID)
+	return resourceFeedRetentionPolicyRead(d, m)
+}
+
+func resourceFeedRetentionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	policy, err := clients.FeedClient.GetFeedRetentionPolicies(clients.Ctx, feed.GetFeedRetentionPoliciesArgs{
+		FeedId:  converter.String(feedID),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading retention policy of feed %s: %+v", feedID, err)
+	}
+
+	d.Set("count_limit", policy.CountLimit)
+	d.Set("days_to_keep_recently_downloaded_packages", policy.DaysToKeepRecentlyDownloadedPackages)
+	return nil
+}
+
+// deleting the retention policy resets the feed to the default policy of Azure DevOps
+func resourceFeedRetentionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	err := clients.FeedClient.DeleteFeedRetentionPolicies(clients.Ctx, feed.DeleteFeedRetentionPoliciesArgs{
+		FeedId:  converter.String(feedID),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" deleting retention policy of feed %s: %+v", feedID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceFeedRetentionPolicyImport accepts the same IDs as the feed resource
+func resourceFeedRetentionPolicyImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := resourceFeedImport(d, m); err != nil {
+		return nil, err
+	}
+	d.Set("feed_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,106 @@
+//go:build (all || resource_feed_retention_policy) && !exclude_feed
+// +build all resource_feed_retention_policy
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedRetentionPolicyFeedID = uuid.New()
+
+func getFeedRetentionPolicyTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeedRetentionPolicy().Schema, map[string]interface{}{
+		"feed_id":     testFeedRetentionPolicyFeedID.String(),
+		"count_limit": 20,
+		"days_to_keep_recently_downloaded_packages": 30,
+	})
+}
+
+// verifies that the configured policy is sent and read back
+func TestFeedRetentionPolicy_Create_SetsPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	policy := feed.FeedRetentionPolicy{
+		CountLimit:                           converter.Int(20),
+		DaysToKeepRecentlyDownloadedPackages: converter.Int(30),
+	}
+	feedClient.
+		EXPECT().
+		SetFeedRetentionPolicies(clients.Ctx, feed.SetFeedRetentionPoliciesArgs{
+			Policy:  &policy,
+			FeedId:  converter.String(testFeedRetentionPolicyFeedID.String()),
+			Project: converter.String(""),
+		}).
+		Return(&policy, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedRetentionPolicies(clients.Ctx, gomock.Any()).
+		Return(&policy, nil).
+		Times(1)
+
+	resourceData := getFeedRetentionPolicyTestResourceData(t)
+	err := resourceFeedRetentionPolicyCreateUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedRetentionPolicyFeedID.String(), resourceData.Id())
+	require.Equal(t, 20, resourceData.Get("count_limit"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestFeedRetentionPolicy_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		SetFeedRetentionPolicies(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("SetFeedRetentionPolicies() Failed")).
+		Times(1)
+
+	err := resourceFeedRetentionPolicyCreateUpdate(getFeedRetentionPolicyTestResourceData(t), clients)
+	require.Contains(t, err.Error(), "SetFeedRetentionPolicies() Failed")
+}
+
+// verifies that deleting the resource resets the policy of the feed
+func TestFeedRetentionPolicy_Delete_ResetsPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		DeleteFeedRetentionPolicies(clients.Ctx, feed.DeleteFeedRetentionPoliciesArgs{
+			FeedId:  converter.String(testFeedRetentionPolicyFeedID.String()),
+			Project: converter.String(""),
+		}).
+		Return(nil).
+		Times(1)
+
+	resourceData := getFeedRetentionPolicyTestResourceData(t)
+	resourceData.SetId(testFeedRetentionPolicyFeedID.String())
+	err := resourceFeedRetentionPolicyDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,576 @@
+//go:build (all || resource_feed) && !exclude_feed
+// +build all resource_feed
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedID = uuid.New()
+var testFeedProjectID = uuid.New()
+
+var testFeed = feed.Feed{
+	Id:   &testFeedID,
+	Name: converter.String("feed"),
+	Project: &feed.ProjectReference{
+		Id: &testFeedProjectID,
+	},
+}
+
+func getFeedTestResourceData(t *testing.T, permanentDelete bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeed().Schema, map[string]interface{}{
+		"name":       "feed",
+		"project_id": testFeedProjectID.String(),
+		"features": []interface{}{
+			map[string]interface{}{"permanent_delete": permanentDelete},
+		},
+	})
+}
+
+// verifies that the feed is read back into the state, including its project
+func TestFeed_Flatten_SetsProject(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, nil)
+	flattenFeed(resourceData, &testFeed)
+
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+	require.Equal(t, "feed", resourceData.Get("name"))
+	require.Equal(t, testFeedProjectID.String(), resourceData.Get("project_id"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestFeed_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	expectedArgs := feed.CreateFeedArgs{
+		Feed: &feed.Feed{
+			Name:                       converter.String("feed"),
+			Description:                converter.String(""),
+			BadgesEnabled:              converter.Bool(false),
+			HideDeletedPackageVersions: converter.Bool(true),
+			UpstreamEnabled:            converter.Bool(true),
+		},
+		Project: converter.String(testFeedProjectID.String()),
+	}
+	feedClient.
+		EXPECT().
+		CreateFeed(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateFeed() Failed")).
+		Times(1)
+
+	err := resourceFeedCreate(getFeedTestResourceData(t, false), clients)
+	require.Contains(t, err.Error(), "CreateFeed() Failed")
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestFeed_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedTestResourceData(t, false)
+	resourceData.SetId(testFeedID.String())
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, feed.GetFeedArgs{
+			FeedId:  converter.String(testFeedID.String()),
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(nil, errors.New("GetFeed() Failed")).
+		Times(1)
+
+	err := resourceFeedRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetFeed() Failed")
+}
+
+// verifies that permanent_delete deletes the feed from the recycle bin as well
+func TestFeed_Delete_PermanentDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedTestResourceData(t, true)
+	resourceData.SetId(testFeedID.String())
+
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			DeleteFeed(clients.Ctx, feed.DeleteFeedArgs{
+				FeedId:  converter.String(testFeedID.String()),
+				Project: converter.String(testFeedProjectID.String()),
+			}).
+			Return(nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			PermanentDeleteFeed(clients.Ctx, feed.PermanentDeleteFeedArgs{
+				FeedId:  converter.String(testFeedID.String()),
+				Project: converter.String(testFeedProjectID.String()),
+			}).
+			Return(nil).
+			Times(1),
+	)
+
+	err := resourceFeedDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that without permanent_delete the feed is left in the recycle bin
+func TestFeed_Delete_KeepsFeedInRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := getFeedTestResourceData(t, false)
+	resourceData.SetId(testFeedID.String())
+
+	feedClient.
+		EXPECT().
+		DeleteFeed(clients.Ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	err := resourceFeedDelete(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that a project scoped feed can be imported
+func TestFeed_Import_ProjectScopedFeed(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, nil)
+	resourceData.SetId(testFeedProjectID.String() + "/" + testFeedID.String())
+
+	_, err := resourceFeedImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+	require.Equal(t, testFeedProjectID.String(), resourceData.Get("project_id"))
+
+	resourceData.SetId("not-a-uuid")
+	_, err = resourceFeedImport(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+func getFeedUpstreamSourcesConfig(sources ...map[string]interface{}) map[string]interface{} {
+	upstreamSources := make([]interface{}, 0, len(sources))
+	for _, source := range sources {
+		upstreamSources = append(upstreamSources, source)
+	}
+	return map[string]interface{}{
+		"name":             "feed",
+		"project_id":       testFeedProjectID.String(),
+		"upstream_sources": upstreamSources,
+	}
+}
+
+var testNuGetUpstreamSource = map[string]interface{}{
+	"name":     "NuGet Gallery",
+	"protocol": "NuGet",
+	"location": "https://api.nuget.org/v3/index.json",
+}
+
+var testNpmUpstreamSource = map[string]interface{}{
+	"name":     "npmjs",
+	"protocol": "npm",
+	"location": "https://registry.npmjs.org/",
+}
+
+// verifies that the upstream sources are created in their configured order
+func TestFeed_Create_SendsUpstreamSourcesInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		CreateFeed(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args feed.CreateFeedArgs) (*feed.Feed, error) {
+			sources := *args.Feed.UpstreamSources
+			require.Len(t, sources, 2)
+			require.Equal(t, "NuGet Gallery", *sources[0].Name)
+			require.Equal(t, "nuget", *sources[0].Protocol)
+			require.Equal(t, feed.UpstreamSourceTypeValues.Public, *sources[0].UpstreamSourceType)
+			require.Equal(t, "npmjs", *sources[1].Name)
+			return nil, errors.New("CreateFeed() Failed")
+		}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, getFeedUpstreamSourcesConfig(testNuGetUpstreamSource, testNpmUpstreamSource))
+	err := resourceFeedCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateFeed() Failed")
+}
+
+// verifies that an upstream source cannot be configured twice
+func TestFeed_Create_RejectsDuplicateUpstreamSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, getFeedUpstreamSourcesConfig(testNpmUpstreamSource, testNpmUpstreamSource))
+	err := resourceFeedCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "upstream source npmjs is configured more than once")
+}
+
+// verifies that removing all upstream sources sends an empty list rather than leaving them untouched
+func TestFeed_Update_RemovesUpstreamSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeed()
+	stateData := schema.TestResourceDataRaw(t, r.Schema, getFeedUpstreamSourcesConfig(testNpmUpstreamSource))
+	stateData.SetId(testFeedID.String())
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(getFeedUpstreamSourcesConfig()), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+			Feed: &feed.FeedUpdate{
+				UpstreamSources: &[]feed.UpstreamSource{},
+			},
+			FeedId:  converter.String(testFeedID.String()),
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(nil, errors.New("UpdateFeed() Failed")).
+		Times(1)
+
+	err = resourceFeedUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateFeed() Failed")
+}
+
+// verifies that upstream sources removed outside of Terraform show up as drift
+func TestFeed_Flatten_SkipsDeletedUpstreamSources(t *testing.T) {
+	sourceType := feed.UpstreamSourceTypeValues.Public
+	withSources := testFeed
+	withSources.UpstreamSources = &[]feed.UpstreamSource{
+		{
+			Name:               converter.String("npmjs"),
+			Protocol:           converter.String("npm"),
+			Location:           converter.String("https://registry.npmjs.org/"),
+			UpstreamSourceType: &sourceType,
+			DeletedDate:        &azuredevops.Time{Time: time.Now()},
+		},
+		{
+			Name:               converter.String("NuGet Gallery"),
+			Protocol:           converter.String("nuget"),
+			Location:           converter.String("https://api.nuget.org/v3/index.json"),
+			UpstreamSourceType: &sourceType,
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, getFeedUpstreamSourcesConfig(testNpmUpstreamSource, testNuGetUpstreamSource))
+	flattenFeed(resourceData, &withSources)
+	require.Equal(t, 1, resourceData.Get("upstream_sources.#"))
+	require.Equal(t, "NuGet Gallery", resourceData.Get("upstream_sources.0.name"))
+	require.Equal(t, "public", resourceData.Get("upstream_sources.0.upstream_source_type"))
+}
+
+// verifies that only the changed attributes are sent when the feed is updated
+func TestFeed_Update_SendsChangedAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeed()
+	stateData := getFeedTestResourceData(t, false)
+	stateData.SetId(testFeedID.String())
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "renamed",
+		"project_id":       testFeedProjectID.String(),
+		"description":      "Release packages",
+		"upstream_enabled": false,
+		"features": []interface{}{
+			map[string]interface{}{"permanent_delete": false},
+		},
+	}), nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+			Feed: &feed.FeedUpdate{
+				Name:            converter.String("renamed"),
+				Description:     converter.String("Release packages"),
+				UpstreamEnabled: converter.Bool(false),
+			},
+			FeedId:  converter.String(testFeedID.String()),
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(nil, errors.New("UpdateFeed() Failed")).
+		Times(1)
+
+	err = resourceFeedUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateFeed() Failed")
+}
+
+// verifies that changing only the local features does not call Azure DevOps to update the feed
+func TestFeed_Update_FeaturesOnlyDoesNotUpdateFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeed()
+	stateData := getFeedTestResourceData(t, false)
+	stateData.SetId(testFeedID.String())
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       "feed",
+		"project_id": testFeedProjectID.String(),
+		"features": []interface{}{
+			map[string]interface{}{"permanent_delete": true},
+		},
+	}), nil)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&testFeed, nil).
+		Times(1)
+
+	err = resourceFeedUpdate(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that a feed with the same name in the recycle bin is restored and configured instead of created
+func TestFeed_Create_RestoresDeletedFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			GetFeedsFromRecycleBin(clients.Ctx, feed.GetFeedsFromRecycleBinArgs{
+				Project: converter.String(testFeedProjectID.String()),
+			}).
+			Return(&[]feed.Feed{
+				{Id: converter.UUID(uuid.New().String()), Name: converter.String("other")},
+				{Id: &testFeedID, Name: converter.String("Feed")},
+			}, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			RestoreDeletedFeed(clients.Ctx, feed.RestoreDeletedFeedArgs{
+				PatchJson: &[]webapi.JsonPatchOperation{
+					{
+						Op:    &webapi.OperationValues.Replace,
+						Path:  converter.String("/isDeleted"),
+						Value: false,
+					},
+				},
+				FeedId:  converter.String(testFeedID.String()),
+				Project: converter.String(testFeedProjectID.String()),
+			}).
+			Return(nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			UpdateFeed(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args feed.UpdateFeedArgs) (*feed.Feed, error) {
+				require.Equal(t, "feed", *args.Feed.Name)
+				require.Empty(t, *args.Feed.UpstreamSources)
+				return &testFeed, nil
+			}).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeed(clients.Ctx, gomock.Any()).
+			Return(&testFeed, nil).
+			Times(2),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, map[string]interface{}{
+		"name":               "feed",
+		"project_id":         testFeedProjectID.String(),
+		"restore_if_deleted": true,
+	})
+	err := resourceFeedCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+}
+
+// verifies that a new feed is created if there is no feed with the same name in the recycle bin
+func TestFeed_Create_NoDeletedFeedToRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			GetFeedsFromRecycleBin(clients.Ctx, gomock.Any()).
+			Return(&[]feed.Feed{}, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			CreateFeed(clients.Ctx, gomock.Any()).
+			Return(nil, errors.New("CreateFeed() Failed")).
+			Times(1),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, map[string]interface{}{
+		"name":               "feed",
+		"restore_if_deleted": true,
+	})
+	err := resourceFeedCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateFeed() Failed")
+}
+
+// verifies that moving a project feed to the organization replaces it
+func TestFeed_Diff_MoveToOrganizationForcesNew(t *testing.T) {
+	r := ResourceFeed()
+	stateData := getFeedTestResourceData(t, false)
+	stateData.SetId(testFeedID.String())
+	stateData.Set("scope", "project")
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "feed",
+	}), nil)
+	require.Nil(t, err)
+	require.True(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["project_id"].RequiresNew)
+	require.True(t, diff.Attributes["scope"].NewComputed)
+}
+
+// verifies that a feed is not replaced if its project does not change
+func TestFeed_Diff_SameProjectDoesNotForceNew(t *testing.T) {
+	r := ResourceFeed()
+	stateData := getFeedTestResourceData(t, false)
+	stateData.SetId(testFeedID.String())
+	stateData.Set("scope", "project")
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       "renamed",
+		"project_id": testFeedProjectID.String(),
+	}), nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+}
+
+// verifies that the scope is read from the project of the feed
+func TestFeed_Flatten_SetsScope(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeed().Schema, map[string]interface{}{})
+
+	flattenFeed(resourceData, &testFeed)
+	require.Equal(t, "project", resourceData.Get("scope"))
+
+	organizationFeed := testFeed
+	organizationFeed.Project = nil
+	flattenFeed(resourceData, &organizationFeed)
+	require.Equal(t, "organization", resourceData.Get("scope"))
+	require.Equal(t, "", resourceData.Get("project_id"))
+}
+
+// verifies that a new feed that is not found yet is read again until it is available
+func TestFeed_Create_WaitsForNewFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		feedClient.
+			EXPECT().
+			CreateFeed(clients.Ctx, gomock.Any()).
+			Return(&testFeed, nil).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeed(clients.Ctx, gomock.Any()).
+			Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+			Times(1),
+		feedClient.
+			EXPECT().
+			GetFeed(clients.Ctx, gomock.Any()).
+			Return(&testFeed, nil).
+			Times(2),
+	)
+
+	resourceData := getFeedTestResourceData(t, false)
+	err := resourceFeedCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+}
+
+// verifies that errors other than not found end the wait for a new feed
+func TestFeed_Create_WaitDoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		CreateFeed(clients.Ctx, gomock.Any()).
+		Return(&testFeed, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetFeed() Failed")).
+		Times(1)
+
+	err := resourceFeedCreate(getFeedTestResourceData(t, false), clients)
+	require.Contains(t, err.Error(), "GetFeed() Failed")
+}
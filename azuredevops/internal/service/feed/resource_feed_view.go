@@ -0,0 +1,168 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceFeedView schema and implementation for feed view resource
+func ResourceFeedView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedViewCreate,
+		Read:   resourceFeedViewRead,
+		Update: resourceFeedViewUpdate,
+		Delete: resourceFeedViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedViewImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(feed.FeedVisibilityValues.Private),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(feed.FeedVisibilityValues.Private),
+					string(feed.FeedVisibilityValues.Collection),
+					string(feed.FeedVisibilityValues.Organization),
+					string(feed.FeedVisibilityValues.AadTenant),
+				}, false),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFeedViewCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	visibility := feed.FeedVisibility(d.Get("visibility").(string))
+	createdView, err := clients.FeedClient.CreateFeedView(clients.Ctx, feed.CreateFeedViewArgs{
+		View: &feed.FeedView{
+			Name:       converter.String(d.Get("name").(string)),
+			Type:       &feed.FeedViewTypeValues.Release,
+			Visibility: &visibility,
+		},
+		FeedId:  converter.String(feedID),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating view %s of feed %s: %+v", d.Get("name").(string), feedID, err)
+	}
+
+	d.SetId(createdView.Id.String())
+	return resourceFeedViewRead(d, m)
+}
+
+func resourceFeedViewRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	view, err := clients.FeedClient.GetFeedView(clients.Ctx, feed.GetFeedViewArgs{
+		FeedId:  converter.String(feedID),
+		ViewId:  converter.String(d.Id()),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading view %s of feed %s: %+v", d.Id(), feedID, err)
+	}
+
+	d.Set("name", view.Name)
+	d.Set("url", view.Url)
+	if view.Visibility != nil {
+		d.Set("visibility", string(*view.Visibility))
+	}
+	return nil
+}
+
+func resourceFeedViewUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	visibility := feed.FeedVisibility(d.Get("visibility").(string))
+	_, err := clients.FeedClient.UpdateFeedView(clients.Ctx, feed.UpdateFeedViewArgs{
+		View: &feed.FeedView{
+			Name:       converter.String(d.Get("name").(string)),
+			Visibility: &visibility,
+		},
+		FeedId:  converter.String(feedID),
+		ViewId:  converter.String(d.Id()),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" updating view %s of feed %s: %+v", d.Id(), feedID, err)
+	}
+
+	return resourceFeedViewRead(d, m)
+}
+
+func resourceFeedViewDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	feedID := d.Get("feed_id").(string)
+
+	err := clients.FeedClient.DeleteFeedView(clients.Ctx, feed.DeleteFeedViewArgs{
+		FeedId:  converter.String(feedID),
+		ViewId:  converter.String(d.Id()),
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" deleting view %s of feed %s: %+v", d.Id(), feedID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceFeedViewImport accepts <feed_id>/<view_id> for an organization scoped feed and
+// <project_id>/<feed_id>/<view_id> for a project scoped feed
+func resourceFeedViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf(" Unexpected format of import ID %s, expected <feed_id>/<view_id> or <project_id>/<feed_id>/<view_id>", d.Id())
+	}
+	for _, part := range parts {
+		if _, err := uuid.Parse(part); err != nil {
+			return nil, fmt.Errorf(" %s of import ID %s is not a UUID: %+v", part, d.Id(), err)
+		}
+	}
+
+	if len(parts) == 3 {
+		d.Set("project_id", parts[0])
+		parts = parts[1:]
+	}
+	d.Set("feed_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
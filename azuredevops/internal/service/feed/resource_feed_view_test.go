@@ -0,0 +1,150 @@
+//go:build (all || resource_feed_view) && !exclude_feed
+// +build all resource_feed_view
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedViewFeedID = uuid.New()
+var testFeedViewID = uuid.New()
+
+func getFeedViewTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceFeedView().Schema, map[string]interface{}{
+		"feed_id":    testFeedViewFeedID.String(),
+		"name":       "Prerelease",
+		"visibility": "collection",
+	})
+}
+
+// verifies that a view is created as a release view with the configured visibility
+func TestFeedView_Create_CreatesReleaseView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	visibility := feed.FeedVisibilityValues.Collection
+	view := feed.FeedView{
+		Id:         &testFeedViewID,
+		Name:       converter.String("Prerelease"),
+		Type:       &feed.FeedViewTypeValues.Release,
+		Visibility: &visibility,
+	}
+	feedClient.
+		EXPECT().
+		CreateFeedView(clients.Ctx, feed.CreateFeedViewArgs{
+			View: &feed.FeedView{
+				Name:       converter.String("Prerelease"),
+				Type:       &feed.FeedViewTypeValues.Release,
+				Visibility: &visibility,
+			},
+			FeedId:  converter.String(testFeedViewFeedID.String()),
+			Project: converter.String(""),
+		}).
+		Return(&view, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedView(clients.Ctx, feed.GetFeedViewArgs{
+			FeedId:  converter.String(testFeedViewFeedID.String()),
+			ViewId:  converter.String(testFeedViewID.String()),
+			Project: converter.String(""),
+		}).
+		Return(&view, nil).
+		Times(1)
+
+	resourceData := getFeedViewTestResourceData(t)
+	err := resourceFeedViewCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedViewID.String(), resourceData.Id())
+	require.Equal(t, "collection", resourceData.Get("visibility"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestFeedView_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		CreateFeedView(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateFeedView() Failed")).
+		Times(1)
+
+	err := resourceFeedViewCreate(getFeedViewTestResourceData(t), clients)
+	require.Contains(t, err.Error(), "CreateFeedView() Failed")
+}
+
+// verifies that a view that no longer exists is removed from the state
+func TestFeedView_Read_RemovesDeletedView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		GetFeedView(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := getFeedViewTestResourceData(t)
+	resourceData.SetId(testFeedViewID.String())
+	err := resourceFeedViewRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on update, the error is not swallowed
+func TestFeedView_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedClient.
+		EXPECT().
+		UpdateFeedView(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UpdateFeedView() Failed")).
+		Times(1)
+
+	resourceData := getFeedViewTestResourceData(t)
+	resourceData.SetId(testFeedViewID.String())
+	err := resourceFeedViewUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateFeedView() Failed")
+}
+
+// verifies that the import ID may be prefixed with the project ID
+func TestFeedView_Import_WithProject(t *testing.T) {
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceFeedView().Schema, nil)
+	resourceData.SetId(projectID + "/" + testFeedViewFeedID.String() + "/" + testFeedViewID.String())
+
+	_, err := resourceFeedViewImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testFeedViewID.String(), resourceData.Id())
+	require.Equal(t, testFeedViewFeedID.String(), resourceData.Get("feed_id"))
+	require.Equal(t, projectID, resourceData.Get("project_id"))
+}
@@ -0,0 +1,110 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataGitRepositoriesAll schema and implementation for the data source listing all git repositories of a project
+func DataGitRepositoriesAll() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoriesAllRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"include_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"repositories": DataGitRepositories().Schema["repositories"],
+		},
+	}
+}
+
+func dataSourceGitRepositoriesAllRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	repos, err := clients.GitReposClient.GetRepositories(clients.Ctx, git.GetRepositoriesArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading repositories of project %s: %v", projectID, err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	filtered := filterGitRepositories(repos, nameRegex, d.Get("name_prefix").(string), d.Get("include_disabled").(bool))
+
+	results, err := flattenGitRepositories(&filtered)
+	if err != nil {
+		return fmt.Errorf(" flattening repositories: %v", err)
+	}
+
+	repoNames, err := datahelper.GetAttributeValues(results, "name")
+	if err != nil {
+		return fmt.Errorf(" Failed to get list of repository names: %v", err)
+	}
+	id, err := createGitRepositoryDataSourceID(d, &repoNames)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	if err := d.Set("repositories", results); err != nil {
+		d.SetId("")
+		return fmt.Errorf(" setting `repositories`: %+v", err)
+	}
+	return nil
+}
+
+// filterGitRepositories selects the repositories matching the name filters, sorted by name
+func filterGitRepositories(repos *[]git.GitRepository, nameRegex *regexp.Regexp, namePrefix string, includeDisabled bool) []git.GitRepository {
+	result := []git.GitRepository{}
+	if repos == nil {
+		return result
+	}
+	for _, repo := range *repos {
+		name := converter.ToString(repo.Name, "")
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		if namePrefix != "" && !strings.HasPrefix(name, namePrefix) {
+			continue
+		}
+		if !includeDisabled && converter.ToBool(repo.IsDisabled, false) {
+			continue
+		}
+		result = append(result, repo)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return converter.ToString(result[i].Name, "") < converter.ToString(result[j].Name, "")
+	})
+	return result
+}
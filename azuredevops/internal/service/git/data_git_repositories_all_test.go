@@ -0,0 +1,86 @@
+//go:build (all || git || data_sources || data_git_repositories_all) && (!exclude_data_sources || !exclude_git || !exclude_data_git_repositories_all)
+// +build all git data_sources data_git_repositories_all
+// +build !exclude_data_sources !exclude_git !exclude_data_git_repositories_all
+
+package git
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var gitRepoAllList = []git.GitRepository{
+	{Id: testhelper.CreateUUID(), Name: converter.String("svc-orders"), Project: azProjectRef},
+	{Id: testhelper.CreateUUID(), Name: converter.String("svc-billing"), Project: azProjectRef, IsDisabled: converter.Bool(true)},
+	{Id: testhelper.CreateUUID(), Name: converter.String("web-portal"), Project: azProjectRef, IsDisabled: converter.Bool(false)},
+	{Id: testhelper.CreateUUID(), Name: converter.String("svc-accounts"), Project: azProjectRef},
+}
+
+func repositoryNames(repos []git.GitRepository) []string {
+	names := []string{}
+	for _, repo := range repos {
+		names = append(names, *repo.Name)
+	}
+	return names
+}
+
+func TestGitRepositoriesAll_Filter(t *testing.T) {
+	tests := []struct {
+		name            string
+		nameRegex       *regexp.Regexp
+		namePrefix      string
+		includeDisabled bool
+		want            []string
+	}{
+		{"no filter", nil, "", false, []string{"svc-accounts", "svc-orders", "web-portal"}},
+		{"include disabled", nil, "", true, []string{"svc-accounts", "svc-billing", "svc-orders", "web-portal"}},
+		{"name prefix", nil, "svc-", true, []string{"svc-accounts", "svc-billing", "svc-orders"}},
+		{"name regex", regexp.MustCompile(`(orders|portal)$`), "", false, []string{"svc-orders", "web-portal"}},
+		{"name regex and prefix", regexp.MustCompile(`s$`), "svc-", false, []string{"svc-accounts", "svc-orders"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterGitRepositories(&gitRepoAllList, tt.nameRegex, tt.namePrefix, tt.includeDisabled)
+			require.Equal(t, tt.want, repositoryNames(got))
+		})
+	}
+	require.Empty(t, filterGitRepositories(nil, nil, "", true))
+}
+
+func TestGitRepositoriesAll_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	projectID := azProjectRef.Id.String()
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, git.GetRepositoriesArgs{Project: converter.String(projectID)}).
+		Return(&gitRepoAllList, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepositoriesAll().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("name_prefix", "svc-")
+
+	err := dataSourceGitRepositoriesAllRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+	repos := resourceData.Get("repositories").([]interface{})
+	require.Len(t, repos, 2)
+	require.Equal(t, "svc-accounts", repos[0].(map[string]interface{})["name"])
+	require.Equal(t, projectID, repos[0].(map[string]interface{})["project_id"])
+	require.Equal(t, "svc-orders", repos[1].(map[string]interface{})["name"])
+}
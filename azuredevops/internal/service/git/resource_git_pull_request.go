@@ -0,0 +1,410 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// the identity which cancels the auto-complete of a pull request
+const cancelAutoCompleteIdentityID = "00000000-0000-0000-0000-000000000000"
+
+// ResourceGitPullRequest schema to manage the lifecycle of a pull request
+func ResourceGitPullRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitPullRequestCreate,
+		ReadContext:   resourceGitPullRequestRead,
+		UpdateContext: resourceGitPullRequestUpdate,
+		DeleteContext: resourceGitPullRequestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"source_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchRefPrefix,
+			},
+			"target_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchRefPrefix,
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"draft": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reviewer_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"work_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"auto_complete": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+								string(git.GitPullRequestMergeStrategyValues.Squash),
+								string(git.GitPullRequestMergeStrategyValues.Rebase),
+								string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+							}, false),
+						},
+						"merge_commit_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"delete_source_branch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"transition_work_items": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"pull_request_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merge_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitPullRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	pullRequest := &git.GitPullRequest{
+		SourceRefName: converter.String(withPrefix(REF_BRANCH_PREFIX, d.Get("source_branch").(string))),
+		TargetRefName: converter.String(withPrefix(REF_BRANCH_PREFIX, d.Get("target_branch").(string))),
+		Title:         converter.String(d.Get("title").(string)),
+		Description:   converter.String(d.Get("description").(string)),
+		IsDraft:       converter.Bool(d.Get("draft").(bool)),
+	}
+
+	reviewers := []git.IdentityRefWithVote{}
+	for _, reviewerId := range tfhelper.ExpandStringSet(d.Get("reviewer_ids").(*schema.Set)) {
+		reviewers = append(reviewers, git.IdentityRefWithVote{Id: converter.String(reviewerId)})
+	}
+	pullRequest.Reviewers = &reviewers
+
+	workItemRefs := []webapi.ResourceRef{}
+	for _, workItemId := range d.Get("work_item_ids").(*schema.Set).List() {
+		workItemRefs = append(workItemRefs, webapi.ResourceRef{Id: converter.String(strconv.Itoa(workItemId.(int)))})
+	}
+	pullRequest.WorkItemRefs = &workItemRefs
+
+	createdPullRequest, err := clients.GitReposClient.CreatePullRequest(clients.Ctx, git.CreatePullRequestArgs{
+		GitPullRequestToCreate: pullRequest,
+		RepositoryId:           converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating pull request %q: %w", *pullRequest.Title, err))
+	}
+	d.SetId(fmt.Sprintf("%s:%d", repoId, *createdPullRequest.PullRequestId))
+
+	// auto-complete can only be set by an update of the created pull request
+	if _, ok := d.GetOk("auto_complete"); ok {
+		_, err = clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: expandGitPullRequestAutoComplete(d, createdPullRequest.CreatedBy),
+			RepositoryId:           converter.String(repoId),
+			PullRequestId:          createdPullRequest.PullRequestId,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting auto-complete of pull request %d: %w", *createdPullRequest.PullRequestId, err))
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+func resourceGitPullRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, pullRequestId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:        converter.String(repoId),
+		PullRequestId:       converter.Int(pullRequestId),
+		IncludeWorkItemRefs: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error reading pull request %d: %w", pullRequestId, err))
+	}
+
+	d.Set("repository_id", repoId)
+	d.Set("pull_request_id", pullRequestId)
+	d.Set("source_branch", converter.ToString(pullRequest.SourceRefName, ""))
+	d.Set("target_branch", converter.ToString(pullRequest.TargetRefName, ""))
+	d.Set("title", converter.ToString(pullRequest.Title, ""))
+	d.Set("description", converter.ToString(pullRequest.Description, ""))
+	d.Set("draft", converter.ToBool(pullRequest.IsDraft, false))
+	d.Set("url", converter.ToString(pullRequest.Url, ""))
+	if pullRequest.Status != nil {
+		d.Set("status", string(*pullRequest.Status))
+	}
+	if pullRequest.MergeStatus != nil {
+		d.Set("merge_status", string(*pullRequest.MergeStatus))
+	}
+	if pullRequest.CreatedBy != nil {
+		d.Set("created_by_id", converter.ToString(pullRequest.CreatedBy.Id, ""))
+	}
+
+	if err := d.Set("reviewer_ids", flattenGitPullRequestReviewers(d, pullRequest.Reviewers)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting `reviewer_ids`: %w", err))
+	}
+	workItemIds, err := flattenGitPullRequestWorkItems(d, pullRequest.WorkItemRefs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("work_item_ids", workItemIds); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting `work_item_ids`: %w", err))
+	}
+	if err := d.Set("auto_complete", flattenGitPullRequestAutoComplete(pullRequest)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting `auto_complete`: %w", err))
+	}
+	return nil
+}
+
+func resourceGitPullRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, pullRequestId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("title", "description", "draft", "auto_complete") {
+		pullRequest := &git.GitPullRequest{}
+		if d.HasChange("auto_complete") {
+			pullRequest = expandGitPullRequestAutoComplete(d, &webapi.IdentityRef{Id: converter.String(d.Get("created_by_id").(string))})
+		}
+		pullRequest.Title = converter.String(d.Get("title").(string))
+		pullRequest.Description = converter.String(d.Get("description").(string))
+		pullRequest.IsDraft = converter.Bool(d.Get("draft").(bool))
+		_, err = clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: pullRequest,
+			RepositoryId:           converter.String(repoId),
+			PullRequestId:          converter.Int(pullRequestId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error updating pull request %d: %w", pullRequestId, err))
+		}
+	}
+
+	if d.HasChange("reviewer_ids") {
+		oldReviewers, newReviewers := d.GetChange("reviewer_ids")
+		for _, reviewerId := range tfhelper.ExpandStringSet(newReviewers.(*schema.Set).Difference(oldReviewers.(*schema.Set))) {
+			_, err = clients.GitReposClient.CreatePullRequestReviewer(clients.Ctx, git.CreatePullRequestReviewerArgs{
+				Reviewer:      &git.IdentityRefWithVote{Id: converter.String(reviewerId), Vote: converter.Int(0)},
+				RepositoryId:  converter.String(repoId),
+				PullRequestId: converter.Int(pullRequestId),
+				ReviewerId:    converter.String(reviewerId),
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("Error adding reviewer %s to pull request %d: %w", reviewerId, pullRequestId, err))
+			}
+		}
+		for _, reviewerId := range tfhelper.ExpandStringSet(oldReviewers.(*schema.Set).Difference(newReviewers.(*schema.Set))) {
+			err = clients.GitReposClient.DeletePullRequestReviewer(clients.Ctx, git.DeletePullRequestReviewerArgs{
+				RepositoryId:  converter.String(repoId),
+				PullRequestId: converter.Int(pullRequestId),
+				ReviewerId:    converter.String(reviewerId),
+			})
+			if err != nil && !utils.ResponseWasNotFound(err) {
+				return diag.FromErr(fmt.Errorf("Error removing reviewer %s from pull request %d: %w", reviewerId, pullRequestId, err))
+			}
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+// resourceGitPullRequestDelete abandons an active pull request. Completed and abandoned pull requests are only removed
+// from the state.
+func resourceGitPullRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, pullRequestId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("status").(string) != string(git.PullRequestStatusValues.Active) {
+		return nil
+	}
+
+	_, err = clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+		GitPullRequestToUpdate: &git.GitPullRequest{
+			Status: &git.PullRequestStatusValues.Abandoned,
+		},
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(pullRequestId),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.FromErr(fmt.Errorf("Error abandoning pull request %d: %w", pullRequestId, err))
+	}
+	return nil
+}
+
+// expandGitPullRequestAutoComplete returns a pull request update which sets the auto-complete on behalf of the given
+// identity, or cancels it if no auto_complete block is configured
+func expandGitPullRequestAutoComplete(d *schema.ResourceData, setBy *webapi.IdentityRef) *git.GitPullRequest {
+	autoComplete := d.Get("auto_complete").([]interface{})
+	if len(autoComplete) == 0 || autoComplete[0] == nil {
+		return &git.GitPullRequest{
+			AutoCompleteSetBy: &webapi.IdentityRef{Id: converter.String(cancelAutoCompleteIdentityID)},
+		}
+	}
+
+	options := autoComplete[0].(map[string]interface{})
+	mergeStrategy := git.GitPullRequestMergeStrategy(options["merge_strategy"].(string))
+	completionOptions := &git.GitPullRequestCompletionOptions{
+		MergeStrategy:       &mergeStrategy,
+		DeleteSourceBranch:  converter.Bool(options["delete_source_branch"].(bool)),
+		TransitionWorkItems: converter.Bool(options["transition_work_items"].(bool)),
+	}
+	if message := options["merge_commit_message"].(string); message != "" {
+		completionOptions.MergeCommitMessage = converter.String(message)
+	}
+	return &git.GitPullRequest{
+		AutoCompleteSetBy: setBy,
+		CompletionOptions: completionOptions,
+	}
+}
+
+func flattenGitPullRequestAutoComplete(pullRequest *git.GitPullRequest) []interface{} {
+	if pullRequest.AutoCompleteSetBy == nil || pullRequest.CompletionOptions == nil {
+		return []interface{}{}
+	}
+
+	options := pullRequest.CompletionOptions
+	mergeStrategy := string(git.GitPullRequestMergeStrategyValues.NoFastForward)
+	if options.MergeStrategy != nil {
+		mergeStrategy = string(*options.MergeStrategy)
+	} else if converter.ToBool(options.SquashMerge, false) {
+		mergeStrategy = string(git.GitPullRequestMergeStrategyValues.Squash)
+	}
+	return []interface{}{map[string]interface{}{
+		"merge_strategy":        mergeStrategy,
+		"merge_commit_message":  converter.ToString(options.MergeCommitMessage, ""),
+		"delete_source_branch":  converter.ToBool(options.DeleteSourceBranch, false),
+		"transition_work_items": converter.ToBool(options.TransitionWorkItems, false),
+	}}
+}
+
+// flattenGitPullRequestReviewers returns the configured reviewers of the pull request. Reviewers added by branch
+// policies or users are ignored.
+func flattenGitPullRequestReviewers(d *schema.ResourceData, reviewers *[]git.IdentityRefWithVote) []interface{} {
+	configured := d.Get("reviewer_ids").(*schema.Set)
+	result := []interface{}{}
+	if reviewers == nil {
+		return result
+	}
+	for _, reviewer := range *reviewers {
+		if reviewer.Id != nil && configured.Contains(*reviewer.Id) {
+			result = append(result, *reviewer.Id)
+		}
+	}
+	return result
+}
+
+// flattenGitPullRequestWorkItems returns the configured work items of the pull request. Work items linked by commit
+// mentions, policies or users are ignored, since a change of the work items recreates the pull request.
+func flattenGitPullRequestWorkItems(d *schema.ResourceData, workItemRefs *[]webapi.ResourceRef) ([]interface{}, error) {
+	configured := d.Get("work_item_ids").(*schema.Set)
+	result := []interface{}{}
+	if workItemRefs == nil {
+		return result, nil
+	}
+	for _, workItemRef := range *workItemRefs {
+		workItemId, err := strconv.Atoi(converter.ToString(workItemRef.Id, ""))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing work item ID %q: %w", converter.ToString(workItemRef.Id, ""), err)
+		}
+		if configured.Contains(workItemId) {
+			result = append(result, workItemId)
+		}
+	}
+	return result, nil
+}
+
+func suppressBranchRefPrefix(_, old, new string, _ *schema.ResourceData) bool {
+	return withPrefix(REF_BRANCH_PREFIX, old) == withPrefix(REF_BRANCH_PREFIX, new)
+}
@@ -0,0 +1,155 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPullRequestRepoId = "00000000-0000-0000-0000-000000000002"
+var testPullRequestReviewerId = "00000000-0000-0000-0000-000000000003"
+
+func TestGitPullRequest_Create_SetsAutoComplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": testPullRequestRepoId,
+		"source_branch": "feature",
+		"target_branch": "refs/heads/main",
+		"title":         "Update files",
+		"reviewer_ids":  []interface{}{testPullRequestReviewerId},
+		"work_item_ids": []interface{}{42},
+		"auto_complete": []interface{}{map[string]interface{}{
+			"merge_strategy":       "squash",
+			"delete_source_branch": true,
+		}},
+	})
+
+	creator := &webapi.IdentityRef{Id: converter.String("a-creator")}
+	g.EXPECT().
+		CreatePullRequest(clients.Ctx, git.CreatePullRequestArgs{
+			GitPullRequestToCreate: &git.GitPullRequest{
+				SourceRefName: converter.String("refs/heads/feature"),
+				TargetRefName: converter.String("refs/heads/main"),
+				Title:         converter.String("Update files"),
+				Description:   converter.String(""),
+				IsDraft:       converter.Bool(false),
+				Reviewers:     &[]git.IdentityRefWithVote{{Id: converter.String(testPullRequestReviewerId)}},
+				WorkItemRefs:  &[]webapi.ResourceRef{{Id: converter.String("42")}},
+			},
+			RepositoryId: converter.String(testPullRequestRepoId),
+		}).
+		Return(&git.GitPullRequest{PullRequestId: converter.Int(7), CreatedBy: creator}, nil)
+	g.EXPECT().
+		UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				AutoCompleteSetBy: creator,
+				CompletionOptions: &git.GitPullRequestCompletionOptions{
+					MergeStrategy:       &git.GitPullRequestMergeStrategyValues.Squash,
+					DeleteSourceBranch:  converter.Bool(true),
+					TransitionWorkItems: converter.Bool(false),
+				},
+			},
+			RepositoryId:  converter.String(testPullRequestRepoId),
+			PullRequestId: converter.Int(7),
+		}).
+		Return(nil, fmt.Errorf("an-error"))
+
+	diags := resourceGitPullRequestCreate(clients.Ctx, d, clients)
+	require.True(t, diags.HasError())
+	require.Equal(t, "Error setting auto-complete of pull request 7: an-error", diags[0].Summary)
+	require.Equal(t, testPullRequestRepoId+":7", d.Id())
+}
+
+func TestGitPullRequest_Read_IgnoresUnmanagedReviewersAndWorkItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.SetId(testPullRequestRepoId + ":7")
+	d.Set("reviewer_ids", []interface{}{testPullRequestReviewerId})
+	d.Set("work_item_ids", []interface{}{42})
+
+	g.EXPECT().
+		GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+			RepositoryId:        converter.String(testPullRequestRepoId),
+			PullRequestId:       converter.Int(7),
+			IncludeWorkItemRefs: converter.Bool(true),
+		}).
+		Return(&git.GitPullRequest{
+			PullRequestId: converter.Int(7),
+			SourceRefName: converter.String("refs/heads/feature"),
+			TargetRefName: converter.String("refs/heads/main"),
+			Title:         converter.String("Update files"),
+			Status:        &git.PullRequestStatusValues.Active,
+			CreatedBy:     &webapi.IdentityRef{Id: converter.String("a-creator")},
+			Reviewers: &[]git.IdentityRefWithVote{
+				{Id: converter.String(testPullRequestReviewerId)},
+				{Id: converter.String("a-policy-reviewer"), IsRequired: converter.Bool(true)},
+			},
+			WorkItemRefs:      &[]webapi.ResourceRef{{Id: converter.String("42")}, {Id: converter.String("43")}},
+			AutoCompleteSetBy: &webapi.IdentityRef{Id: converter.String("a-creator")},
+			CompletionOptions: &git.GitPullRequestCompletionOptions{SquashMerge: converter.Bool(true)},
+		}, nil)
+
+	diags := resourceGitPullRequestRead(clients.Ctx, d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, 7, d.Get("pull_request_id"))
+	require.Equal(t, "active", d.Get("status"))
+	require.Equal(t, "a-creator", d.Get("created_by_id"))
+	require.Equal(t, []interface{}{testPullRequestReviewerId}, d.Get("reviewer_ids").(*schema.Set).List())
+	require.Equal(t, []interface{}{42}, d.Get("work_item_ids").(*schema.Set).List())
+	require.Equal(t, "squash", d.Get("auto_complete.0.merge_strategy"))
+}
+
+func TestGitPullRequest_Delete_AbandonsActivePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.SetId(testPullRequestRepoId + ":7")
+	d.Set("status", "active")
+
+	g.EXPECT().
+		UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{Status: &git.PullRequestStatusValues.Abandoned},
+			RepositoryId:           converter.String(testPullRequestRepoId),
+			PullRequestId:          converter.Int(7),
+		}).
+		Return(&git.GitPullRequest{}, nil)
+
+	diags := resourceGitPullRequestDelete(clients.Ctx, d, clients)
+	require.False(t, diags.HasError())
+
+	// completed pull requests are left as they are
+	d.Set("status", "completed")
+	diags = resourceGitPullRequestDelete(clients.Ctx, d, clients)
+	require.False(t, diags.HasError())
+}
+
+func TestGitPullRequest_ExpandAutoComplete_CancelsWithoutBlock(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+
+	pullRequest := expandGitPullRequestAutoComplete(d, &webapi.IdentityRef{Id: converter.String("a-creator")})
+	require.Equal(t, cancelAutoCompleteIdentityID, *pullRequest.AutoCompleteSetBy.Id)
+	require.Nil(t, pullRequest.CompletionOptions)
+}
@@ -45,6 +45,9 @@ func ResourceGitRepository() *schema.Resource {
 		Update:   resourceGitRepositoryUpdate,
 		Delete:   resourceGitRepositoryDelete,
 		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:             schema.TypeString,
@@ -72,10 +75,23 @@ func ResourceGitRepository() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"create_default_branch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_fork": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"parent_repository_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"remote_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -98,7 +114,8 @@ func ResourceGitRepository() *schema.Resource {
 			},
 			"disabled": {
 				Type:     schema.TypeBool,
-				Computed: true,
+				Optional: true,
+				Default:  false,
 			},
 			"initialization": {
 				Type:     schema.TypeList,
@@ -209,10 +226,15 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 				importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
 			}
 
-			_, importErr := createImportRequest(clients, importRequest, projectID.String(), *createdRepo.Name)
+			createdImportRequest, importErr := createImportRequest(clients, importRequest, projectID.String(), *createdRepo.Name)
 			if importErr != nil {
 				return fmt.Errorf("Error import repository in Azure DevOps: %+v ", importErr)
 			}
+
+			importErr = waitForImportRequest(clients, projectID.String(), createdRepo.Id.String(), createdImportRequest, d.Timeout(schema.TimeoutCreate))
+			if importErr != nil {
+				return importErr
+			}
 		}
 
 		if strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Clean)) ||
@@ -240,6 +262,13 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if d.Get("disabled").(bool) {
+		err = updateGitRepositoryDisabled(clients, createdRepo.Id, projectID, true)
+		if err != nil {
+			return fmt.Errorf(" disabling repository: %+v", err)
+		}
+	}
+
 	return resourceGitRepositoryRead(d, m)
 }
 
@@ -272,14 +301,89 @@ func resourceGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
 	}
 
-	_, err = updateGitRepository(clients, repo, projectID)
-	if err != nil {
-		return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
+	// a disabled repository cannot be modified, so it is enabled before and disabled after the other changes
+	oldDisabled, newDisabled := d.GetChange("disabled")
+	wasDisabled, disabled := oldDisabled.(bool), newDisabled.(bool)
+
+	// the properties of a disabled repository are only updated if they have been changed
+	updateProperties := !disabled || d.HasChanges("name", "default_branch")
+
+	if wasDisabled && updateProperties {
+		err = updateGitRepositoryDisabled(clients, repo.Id, projectID, false)
+		if err != nil {
+			return fmt.Errorf(" enabling repository: %+v", err)
+		}
+	}
+
+	if updateProperties {
+		if d.HasChange("default_branch") && d.Get("create_default_branch").(bool) {
+			oldBranch, newBranch := d.GetChange("default_branch")
+			err = createGitRepositoryDefaultBranch(clients, d.Id(), newBranch.(string), oldBranch.(string))
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = updateGitRepository(clients, repo, projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
+		}
+	}
+
+	if disabled && (!wasDisabled || updateProperties) {
+		err = updateGitRepositoryDisabled(clients, repo.Id, projectID, true)
+		if err != nil {
+			return fmt.Errorf(" disabling repository: %+v", err)
+		}
 	}
 
 	return resourceGitRepositoryRead(d, m)
 }
 
+// createGitRepositoryDefaultBranch creates the new default branch from the previous default branch, unless the branch
+// already exists
+func createGitRepositoryDefaultBranch(clients *client.AggregatedClient, repoID string, newBranch string, oldBranch string) error {
+	newBranchName := withoutPrefix(REF_BRANCH_PREFIX, newBranch)
+	_, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+		RepositoryId: converter.String(repoID),
+		Name:         converter.String(newBranchName),
+	})
+	if err == nil {
+		return nil
+	}
+	if !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" reading branch %s: %+v", newBranchName, err)
+	}
+
+	oldBranchName := withoutPrefix(REF_BRANCH_PREFIX, oldBranch)
+	if oldBranchName == "" {
+		return fmt.Errorf(" Branch %s cannot be created, the repository does not have a default branch", newBranchName)
+	}
+	gotBranch, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+		RepositoryId: converter.String(repoID),
+		Name:         converter.String(oldBranchName),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading branch %s: %+v", oldBranchName, err)
+	}
+	if gotBranch.Commit == nil || gotBranch.Commit.CommitId == nil {
+		return fmt.Errorf(" Branch %s does not have a commit to create branch %s from", oldBranchName, newBranchName)
+	}
+
+	_, err = updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(withPrefix(REF_BRANCH_PREFIX, newBranchName)),
+			NewObjectId: gotBranch.Commit.CommitId,
+			OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoID),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating branch %s: %+v", newBranchName, err)
+	}
+	return nil
+}
+
 func resourceGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	clients := m.(*client.AggregatedClient)
@@ -320,6 +424,56 @@ func waitForBranch(clients *client.AggregatedClient, repoName *string, projectID
 	return nil
 }
 
+func waitForImportRequest(clients *client.AggregatedClient, projectID string, repositoryID string, importRequest *git.GitImportRequest, timeout time.Duration) error {
+	if importRequest == nil || importRequest.ImportRequestId == nil {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target:                    []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh:                   importRequestRefreshFunc(clients, projectID, repositoryID, *importRequest.ImportRequestId),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		Delay:                     2 * time.Second,
+		ContinuousTargetOccurence: 1,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for the import of repository %s: %+v", repositoryID, err)
+	}
+	return nil
+}
+
+// importRequestRefreshFunc returns the status of an import request and fails if the import failed or was abandoned
+func importRequestRefreshFunc(clients *client.AggregatedClient, projectID string, repositoryID string, importRequestID int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		importRequest, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+			Project:         converter.String(projectID),
+			RepositoryId:    converter.String(repositoryID),
+			ImportRequestId: converter.Int(importRequestID),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf(" reading import request %d: %+v", importRequestID, err)
+		}
+		if importRequest == nil || importRequest.Status == nil {
+			return nil, string(git.GitAsyncOperationStatusValues.Queued), nil
+		}
+
+		status := *importRequest.Status
+		if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+			errorMessage := ""
+			if importRequest.DetailedStatus != nil {
+				errorMessage = converter.ToString(importRequest.DetailedStatus.ErrorMessage, "")
+			}
+			return nil, "", fmt.Errorf(" Import request %d of repository %s is %s. %s", importRequestID, repositoryID, status, errorMessage)
+		}
+		return importRequest, string(status), nil
+	}
+}
+
 func createImportRequest(clients *client.AggregatedClient, gitImportRequest git.GitImportRequest, project string, repositoryID string) (*git.GitImportRequest, error) {
 	args := git.CreateImportRequestArgs{
 		ImportRequest: &gitImportRequest,
@@ -402,6 +556,16 @@ func updateGitRepository(clients *client.AggregatedClient, repository *git.GitRe
 		})
 }
 
+// updateGitRepositoryDisabled disables or enables a repository. The state of a repository has to be changed without
+// modifying any other property.
+func updateGitRepositoryDisabled(clients *client.AggregatedClient, repoID *uuid.UUID, project fmt.Stringer, disabled bool) error {
+	_, err := updateGitRepository(clients, &git.GitRepository{
+		Id:         repoID,
+		IsDisabled: converter.Bool(disabled),
+	}, project)
+	return err
+}
+
 func deleteGitRepository(clients *client.AggregatedClient, repoID string) error {
 	uuid, err := uuid.Parse(repoID)
 	if err != nil {
@@ -457,6 +621,7 @@ func flattenGitRepository(d *schema.ResourceData, repository *git.GitRepository)
 	d.Set("project_id", repository.Project.Id.String())
 	d.Set("default_branch", repository.DefaultBranch)
 	d.Set("is_fork", repository.IsFork)
+	flattenGitRepositoryParent(d, repository.ParentRepository)
 	d.Set("remote_url", repository.RemoteUrl)
 	d.Set("size", repository.Size)
 	d.Set("ssh_url", repository.SshUrl)
@@ -467,6 +632,20 @@ func flattenGitRepository(d *schema.ResourceData, repository *git.GitRepository)
 	return nil
 }
 
+// flattenGitRepositoryParent sets the repository a fork has been created from. The parent of a fork can be located in
+// another project.
+func flattenGitRepositoryParent(d *schema.ResourceData, parent *git.GitRepositoryRef) {
+	parentName, parentProjectID := "", ""
+	if parent != nil {
+		parentName = converter.ToString(parent.Name, "")
+		if parent.Project != nil && parent.Project.Id != nil {
+			parentProjectID = parent.Project.Id.String()
+		}
+	}
+	d.Set("parent_repository_name", parentName)
+	d.Set("parent_project_id", parentProjectID)
+}
+
 // Convert internal Terraform data structure to an AzDO data structure. Note: only the params that are
 // not generated by the service are expanded here
 func expandGitRepository(d *schema.ResourceData) (*git.GitRepository, *repoInitializationMeta, *uuid.UUID, error) {
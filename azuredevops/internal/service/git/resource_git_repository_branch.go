@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,6 +30,9 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 		CreateContext: resourceGitRepositoryBranchCreate,
 		ReadContext:   resourceGitRepositoryBranchRead,
 		DeleteContext: resourceGitRepositoryBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitRepositoryBranchImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -43,30 +47,37 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 			"ref_branch": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_tag", "ref_commit_id"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressImportedBranchRef,
+				ConflictsWith:    []string{"ref_tag", "ref_commit_id"},
 			},
 			"ref_tag": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_branch", "ref_commit_id"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressImportedBranchRef,
+				ConflictsWith:    []string{"ref_branch", "ref_commit_id"},
 			},
 			"ref_commit_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ValidateFunc:  validation.StringIsNotEmpty,
-				ConflictsWith: []string{"ref_branch", "ref_tag"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressImportedBranchRef,
+				ConflictsWith:    []string{"ref_branch", "ref_tag"},
 			},
 			"last_commit_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imported": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -205,6 +216,9 @@ func resourceGitRepositoryBranchDelete(ctx context.Context, d *schema.ResourceDa
 		Name:         converter.String(shortBranchName),
 	})
 	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("Error getting latest commit of %q: %w", name, err))
 	}
 
@@ -223,6 +237,34 @@ func resourceGitRepositoryBranchDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// The source of a branch is only used during create and unknown for imported branches, setting it afterwards must
+// not replace an imported branch
+func suppressImportedBranchRef(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Get("imported").(bool)
+}
+
+// resourceGitRepositoryBranchImport accepts <repository_id>/<name> and the <repository_id>:<name> format of the ID
+func resourceGitRepositoryBranchImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoId, name, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of import ID %q, expected <repository_id>/<name>", d.Id())
+		}
+		repoId, name = parts[0], parts[1]
+	}
+	if _, err := uuid.Parse(repoId); err != nil {
+		return nil, fmt.Errorf("Repository ID %q of import ID %q is not a UUID: %w", repoId, d.Id(), err)
+	}
+
+	shortBranchName := withoutPrefix(REF_BRANCH_PREFIX, name)
+	d.SetId(fmt.Sprintf("%s:%s", repoId, shortBranchName))
+	d.Set("repository_id", repoId)
+	d.Set("name", shortBranchName)
+	d.Set("imported", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func updateRefs(clients *client.AggregatedClient, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
 	updateRefResults, err := clients.GitReposClient.UpdateRefs(clients.Ctx, args)
 	if err != nil {
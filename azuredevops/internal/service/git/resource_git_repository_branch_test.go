@@ -303,3 +303,58 @@ func TestGitRepositoryBranch_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestGitRepositoryBranch_Import(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantID     string
+		wantBranch string
+		wantErr    string
+	}{
+		{"Import based on repositoryId/branchName", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b/feature/a-branch", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b:feature/a-branch", "feature/a-branch", ""},
+		{"Import based on repositoryId:branchName", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b:a-branch", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b:a-branch", "a-branch", ""},
+		{"Import strips refs/heads/ prefix", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b/refs/heads/a-branch", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b:a-branch", "a-branch", ""},
+		{"Import requires a branch name", "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b", "", "", "expected <repository_id>/<name>"},
+		{"Import requires a repository UUID", "a-repo/a-branch", "", "", "is not a UUID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+			d.SetId(tt.id)
+
+			got, err := resourceGitRepositoryBranchImport(context.Background(), d, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("resourceGitRepositoryBranchImport() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resourceGitRepositoryBranchImport() unexpected error = %v", err)
+			}
+			if len(got) != 1 || got[0].Id() != tt.wantID || got[0].Get("name") != tt.wantBranch {
+				t.Errorf("resourceGitRepositoryBranchImport() = %s (%v), want %s (%s)", got[0].Id(), got[0].Get("name"), tt.wantID, tt.wantBranch)
+			}
+			if !got[0].Get("imported").(bool) {
+				t.Errorf("resourceGitRepositoryBranchImport() did not mark the branch as imported")
+			}
+		})
+	}
+}
+
+func TestGitRepositoryBranch_SuppressImportedBranchRef(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+	d.SetId("a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b:a-branch")
+	if suppressImportedBranchRef("ref_branch", "", "main", d) {
+		t.Errorf("suppressImportedBranchRef() suppressed setting the source of a created branch")
+	}
+
+	d.Set("imported", true)
+	if !suppressImportedBranchRef("ref_branch", "", "main", d) {
+		t.Errorf("suppressImportedBranchRef() did not suppress setting the source of an imported branch")
+	}
+	if suppressImportedBranchRef("ref_branch", "main", "develop", d) {
+		t.Errorf("suppressImportedBranchRef() suppressed changing a known source")
+	}
+}
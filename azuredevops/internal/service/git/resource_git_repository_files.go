@@ -0,0 +1,365 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitRepositoryFiles schema and implementation for a resource managing several files of a repository, all
+// changes of an apply are pushed as a single commit
+func ResourceGitRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryFilesCreate,
+		Read:   resourceGitRepositoryFilesRead,
+		Update: resourceGitRepositoryFilesUpdate,
+		Delete: resourceGitRepositoryFilesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "refs/heads/master",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"file": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"commit_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"author_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"author_email"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"author_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"author_name"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"overwrite_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryFilesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		return fmt.Errorf("Repository branch not found, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+	}
+
+	files, err := expandUniqueGitRepositoryFiles(d.Get("file").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	changes, err := expandGitRepositoryFileAddChanges(clients, repoId, branch, files, d.Get("overwrite_on_create").(bool))
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Add %d files", len(files))
+	if err := pushGitRepositoryFileChanges(d, clients, changes, message, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Create repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, branch))
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	_, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: &repoId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" Repository not found, repositoryID: %s. Error:  %+v", repoId, err)
+	}
+
+	// files deleted outside of Terraform are removed from the state so that they are added again on the next apply
+	files := []interface{}{}
+	for _, path := range sortedGitRepositoryFilePaths(expandGitRepositoryFiles(d.Get("file").(*schema.Set))) {
+		item, err := clients.GitReposClient.GetItem(clients.Ctx, git.GetItemArgs{
+			RepositoryId:   &repoId,
+			Path:           converter.String(path),
+			IncludeContent: converter.Bool(true),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String(shortBranchName(branch)),
+				VersionType: &git.GitVersionTypeValues.Branch,
+			},
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, path, err)
+		}
+		files = append(files, map[string]interface{}{
+			"path":    path,
+			"content": converter.ToString(item.Content, ""),
+		})
+	}
+
+	lastCommitId, err := getLastCommitId(clients, repoId, branch)
+	if err != nil {
+		return fmt.Errorf("Get last commit of branch %s failed, repositoryID: %s. Error:  %+v", branch, repoId, err)
+	}
+
+	d.Set("file", files)
+	d.Set("last_commit_id", lastCommitId)
+	return nil
+}
+
+func resourceGitRepositoryFilesUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	oldFiles, newFiles := d.GetChange("file")
+	files, err := expandUniqueGitRepositoryFiles(newFiles.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	added, edited, deleted := diffGitRepositoryFiles(expandGitRepositoryFiles(oldFiles.(*schema.Set)), files)
+
+	addedFiles := map[string]string{}
+	for _, path := range added {
+		addedFiles[path] = files[path]
+	}
+	changes, err := expandGitRepositoryFileAddChanges(clients, repoId, branch, addedFiles, d.Get("overwrite_on_create").(bool))
+	if err != nil {
+		return err
+	}
+	for _, path := range edited {
+		changes = append(changes, newGitRepositoryFileChange(git.VersionControlChangeTypeValues.Edit, path, files[path]))
+	}
+	for _, path := range deleted {
+		changes = append(changes, git.GitChange{
+			ChangeType: &git.VersionControlChangeTypeValues.Delete,
+			Item:       git.GitItem{Path: converter.String(path)},
+		})
+	}
+
+	if len(changes) > 0 {
+		message := fmt.Sprintf("Update %d files", len(changes))
+		if err := pushGitRepositoryFileChanges(d, clients, changes, message, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Update repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+		}
+	}
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	changes := []interface{}{}
+	for _, path := range sortedGitRepositoryFilePaths(expandGitRepositoryFiles(d.Get("file").(*schema.Set))) {
+		if err := checkRepositoryFileExists(clients, repoId, path, branch); err != nil {
+			if utils.ResponseWasNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, path, err)
+		}
+		changes = append(changes, git.GitChange{
+			ChangeType: &git.VersionControlChangeTypeValues.Delete,
+			Item:       git.GitItem{Path: converter.String(path)},
+		})
+	}
+
+	if len(changes) > 0 {
+		message := fmt.Sprintf("Delete %d files", len(changes))
+		if err := pushGitRepositoryFileChanges(d, clients, changes, message, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("Failed to destroy the repository files, repository ID: %s, branch: %s. Error %+v ", repoId, branch, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandGitRepositoryFileAddChanges returns the changes adding the files. Existing files are only overwritten if
+// overwrite is enabled.
+func expandGitRepositoryFileAddChanges(clients *client.AggregatedClient, repoId, branch string, files map[string]string, overwrite bool) ([]interface{}, error) {
+	changes := []interface{}{}
+	for _, path := range sortedGitRepositoryFilePaths(files) {
+		changeType := git.VersionControlChangeTypeValues.Add
+		err := checkRepositoryFileExists(clients, repoId, path, branch)
+		if err == nil {
+			if !overwrite {
+				return nil, fmt.Errorf("Refusing to overwrite existing file %s. Configure `overwrite_on_create` to `true` to override.", path)
+			}
+			changeType = git.VersionControlChangeTypeValues.Edit
+		} else if !utils.ResponseWasNotFound(err) {
+			return nil, fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, path, err)
+		}
+		changes = append(changes, newGitRepositoryFileChange(changeType, path, files[path]))
+	}
+	return changes, nil
+}
+
+// pushGitRepositoryFileChanges pushes all changes as a single commit, retrying if the branch was updated concurrently
+func pushGitRepositoryFileChanges(d *schema.ResourceData, clients *client.AggregatedClient, changes []interface{}, defaultMessage string, timeout time.Duration) error {
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	message := defaultMessage
+	if v, ok := d.GetOk("commit_message"); ok {
+		message = v.(string)
+	}
+	commit := git.GitCommitRef{
+		Comment: &message,
+		Changes: &changes,
+	}
+	if v, ok := d.GetOk("author_name"); ok {
+		commit.Author = &git.GitUserDate{
+			Name:  converter.String(v.(string)),
+			Email: converter.String(d.Get("author_email").(string)),
+		}
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError { //nolint:staticcheck
+		objectID, err := getLastCommitId(clients, repoId, branch)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		_, err = clients.GitReposClient.CreatePush(context.Background(), git.CreatePushArgs{
+			RepositoryId: &repoId,
+			Push: &git.GitPush{
+				RefUpdates: &[]git.GitRefUpdate{
+					{
+						Name:        &branch,
+						OldObjectId: &objectID,
+					},
+				},
+				Commits: &[]git.GitCommitRef{commit},
+			},
+		})
+		if err != nil {
+			if utils.ResponseContainsStatusMessage(err, "has already been updated by another client") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func newGitRepositoryFileChange(changeType git.VersionControlChangeType, path string, content string) git.GitChange {
+	return git.GitChange{
+		ChangeType: &changeType,
+		Item: git.GitItem{
+			Path: converter.String(path),
+		},
+		NewContent: &git.ItemContent{
+			Content:     converter.String(content),
+			ContentType: &git.ItemContentTypeValues.RawText,
+		},
+	}
+}
+
+// expandGitRepositoryFiles returns the content of the configured files by path
+func expandGitRepositoryFiles(files *schema.Set) map[string]string {
+	result := map[string]string{}
+	for _, file := range files.List() {
+		file := file.(map[string]interface{})
+		result[file["path"].(string)] = file["content"].(string)
+	}
+	return result
+}
+
+// expandUniqueGitRepositoryFiles is expandGitRepositoryFiles failing for paths configured more than once
+func expandUniqueGitRepositoryFiles(files *schema.Set) (map[string]string, error) {
+	result := expandGitRepositoryFiles(files)
+	if len(result) != files.Len() {
+		return nil, fmt.Errorf("Every file path can only be configured once, got %d files for %d paths", files.Len(), len(result))
+	}
+	return result, nil
+}
+
+// diffGitRepositoryFiles returns the sorted paths of the added, edited and deleted files
+func diffGitRepositoryFiles(oldFiles, newFiles map[string]string) (added, edited, deleted []string) {
+	for _, path := range sortedGitRepositoryFilePaths(newFiles) {
+		oldContent, ok := oldFiles[path]
+		if !ok {
+			added = append(added, path)
+		} else if oldContent != newFiles[path] {
+			edited = append(edited, path)
+		}
+	}
+	for _, path := range sortedGitRepositoryFilePaths(oldFiles) {
+		if _, ok := newFiles[path]; !ok {
+			deleted = append(deleted, path)
+		}
+	}
+	return added, edited, deleted
+}
+
+func sortedGitRepositoryFilePaths(files map[string]string) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
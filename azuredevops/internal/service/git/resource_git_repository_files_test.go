@@ -0,0 +1,95 @@
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGitRepositoryFiles(t *testing.T) {
+	oldFiles := map[string]string{
+		"azure-pipelines.yml": "trigger: none",
+		"CODEOWNERS":          "* @team",
+		"README.md":           "# Readme",
+	}
+	newFiles := map[string]string{
+		"azure-pipelines.yml": "trigger: main",
+		"README.md":           "# Readme",
+		"docs/index.md":       "# Docs",
+	}
+
+	added, edited, deleted := diffGitRepositoryFiles(oldFiles, newFiles)
+	require.Equal(t, []string{"docs/index.md"}, added)
+	require.Equal(t, []string{"azure-pipelines.yml"}, edited)
+	require.Equal(t, []string{"CODEOWNERS"}, deleted)
+}
+
+func TestExpandUniqueGitRepositoryFiles_DuplicatePaths(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, nil)
+	d.Set("file", []interface{}{
+		map[string]interface{}{"path": "README.md", "content": "a"},
+		map[string]interface{}{"path": "README.md", "content": "b"},
+	})
+
+	_, err := expandUniqueGitRepositoryFiles(d.Get("file").(*schema.Set))
+	require.Error(t, err)
+}
+
+// verifies that all files are pushed with a single commit carrying the configured message and author
+func TestGitRepositoryFiles_Create_PushesSingleCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: gitClient, Ctx: context.Background()}
+
+	repoId := "a6a4d6e3-bd0a-4b2c-8d6e-2a8c2f3e1a1b"
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, nil)
+	d.Set("repository_id", repoId)
+	d.Set("branch", "refs/heads/main")
+	d.Set("commit_message", "Bootstrap repository")
+	d.Set("author_name", "Automation")
+	d.Set("author_email", "automation@contoso.com")
+	d.Set("file", []interface{}{
+		map[string]interface{}{"path": "azure-pipelines.yml", "content": "trigger: main"},
+		map[string]interface{}{"path": "CODEOWNERS", "content": "* @team"},
+	})
+
+	gitClient.EXPECT().GetBranch(gomock.Any(), gomock.Any()).Return(&git.GitBranchStats{}, nil).Times(1)
+	gitClient.EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(2)
+	gitClient.EXPECT().
+		GetCommits(gomock.Any(), gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String("a-commit")}}, nil).
+		Times(1)
+	gitClient.EXPECT().
+		CreatePush(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+			commits := *args.Push.Commits
+			require.Len(t, commits, 1)
+			require.Equal(t, "Bootstrap repository", *commits[0].Comment)
+			require.Equal(t, "Automation", *commits[0].Author.Name)
+			require.Equal(t, "automation@contoso.com", *commits[0].Author.Email)
+			require.Len(t, *commits[0].Changes, 2)
+			require.Equal(t, "a-commit", *(*args.Push.RefUpdates)[0].OldObjectId)
+			return &git.GitPush{}, nil
+		}).
+		Times(1)
+	gitClient.EXPECT().
+		GetRepository(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	err := resourceGitRepositoryFilesCreate(d, clients)
+	require.Nil(t, err)
+}
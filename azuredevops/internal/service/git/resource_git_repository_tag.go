@@ -0,0 +1,261 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryTag schema to manage the lifecycle of a lightweight or annotated git repository tag
+func ResourceGitRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryTagCreate,
+		ReadContext:   resourceGitRepositoryTagRead,
+		DeleteContext: resourceGitRepositoryTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitRepositoryTagImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ref_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ExactlyOneOf:     []string{"ref_branch", "ref_commit_id"},
+				DiffSuppressFunc: suppressImportedBranchRef,
+			},
+			"ref_commit_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ref_branch", "ref_commit_id"},
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	name := d.Get("name").(string)
+	shortTagName := withoutPrefix(REF_TAG_PREFIX, name)
+	if name != shortTagName {
+		return diag.Errorf("Tag name must be in short format without refs/tags/ prefix, got: %q", name)
+	}
+
+	commitId := d.Get("ref_commit_id").(string)
+	if v, ok := d.GetOk("ref_branch"); ok {
+		branchName := withoutPrefix(REF_BRANCH_PREFIX, v.(string))
+		gotBranch, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(repoId),
+			Name:         converter.String(branchName),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error getting latest commit of %q: %w", branchName, err))
+		}
+		if gotBranch.Commit == nil || gotBranch.Commit.CommitId == nil {
+			return diag.Errorf("Branch %q does not have a commit to tag.", branchName)
+		}
+		commitId = *gotBranch.Commit.CommitId
+	}
+
+	if message, ok := d.GetOk("message"); ok {
+		repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error reading repository %q: %w", repoId, err))
+		}
+		_, err = clients.GitReposClient.CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			TagObject: &git.GitAnnotatedTag{
+				Name:    converter.String(shortTagName),
+				Message: converter.String(message.(string)),
+				TaggedObject: &git.GitObject{
+					ObjectId: converter.String(commitId),
+				},
+			},
+			Project:      converter.String(repo.Project.Id.String()),
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error creating tag %q: %w", shortTagName, err))
+		}
+	} else {
+		_, err := updateRefs(clients, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String(withPrefix(REF_TAG_PREFIX, shortTagName)),
+				NewObjectId: converter.String(commitId),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error creating tag %q: %w", shortTagName, err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, shortTagName))
+	return resourceGitRepositoryTagRead(ctx, d, m)
+}
+
+func resourceGitRepositoryTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, name, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error reading repository %q: %w", repoId, err))
+	}
+
+	tag, err := getGitRepositoryTagRef(clients, repoId, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading tag %q: %w", name, err))
+	}
+	if tag == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// annotated tags point to a tag object, which is peeled to the tagged commit
+	commitId := converter.ToString(tag.ObjectId, "")
+	message := ""
+	if tag.PeeledObjectId != nil {
+		commitId = *tag.PeeledObjectId
+		annotatedTag, err := clients.GitReposClient.GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+			Project:      converter.String(repo.Project.Id.String()),
+			RepositoryId: converter.String(repoId),
+			ObjectId:     tag.ObjectId,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error reading annotated tag %q: %w", name, err))
+		}
+		message = strings.TrimSuffix(converter.ToString(annotatedTag.Message, ""), "\n")
+	}
+
+	d.Set("name", name)
+	d.Set("repository_id", repoId)
+	d.Set("ref_commit_id", commitId)
+	d.Set("object_id", converter.ToString(tag.ObjectId, ""))
+	d.Set("message", message)
+	return nil
+}
+
+func resourceGitRepositoryTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, name, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tag, err := getGitRepositoryTagRef(clients, repoId, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading tag %q: %w", name, err))
+	}
+	if tag == nil {
+		return nil
+	}
+
+	_, err = updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(withPrefix(REF_TAG_PREFIX, name)),
+			OldObjectId: tag.ObjectId,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error deleting tag %q: %w", name, err))
+	}
+	return nil
+}
+
+// resourceGitRepositoryTagImport accepts <repository_id>/<name> and the <repository_id>:<name> format of the ID
+func resourceGitRepositoryTagImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoId, name, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of import ID %q, expected <repository_id>/<name>", d.Id())
+		}
+		repoId, name = parts[0], parts[1]
+	}
+	if _, err := uuid.Parse(repoId); err != nil {
+		return nil, fmt.Errorf("Repository ID %q of import ID %q is not a UUID: %w", repoId, d.Id(), err)
+	}
+
+	shortTagName := withoutPrefix(REF_TAG_PREFIX, name)
+	d.SetId(fmt.Sprintf("%s:%s", repoId, shortTagName))
+	d.Set("repository_id", repoId)
+	d.Set("name", shortTagName)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getGitRepositoryTagRef returns the ref of the tag or nil if the tag does not exist
+func getGitRepositoryTagRef(clients *client.AggregatedClient, repoId, name string) (*git.GitRef, error) {
+	longTagName := withPrefix(REF_TAG_PREFIX, name)
+	gotRefs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String(strings.TrimPrefix(longTagName, "refs/")),
+		PeelTags:     converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// the filter matches by prefix, e.g. v1 also matches v1.1
+	for _, ref := range gotRefs.Value {
+		if ref.Name != nil && *ref.Name == longTagName {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,184 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testTagRepoId = "00000000-0000-0000-0000-000000000001"
+
+func TestGitRepositoryTag_Create_LightweightFromBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.Set("name", "v1.0.0")
+	d.Set("repository_id", testTagRepoId)
+	d.Set("ref_branch", "refs/heads/main")
+
+	g.EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(testTagRepoId),
+			Name:         converter.String("main"),
+		}).
+		Return(&git.GitBranchStats{Commit: &git.GitCommitRef{CommitId: converter.String("a-commit")}}, nil)
+	g.EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/tags/v1.0.0"),
+				NewObjectId: converter.String("a-commit"),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(testTagRepoId),
+		}).
+		Return(nil, fmt.Errorf("an-error"))
+
+	diags := resourceGitRepositoryTagCreate(clients.Ctx, d, clients)
+	require.True(t, diags.HasError())
+	require.Equal(t, "Error creating tag \"v1.0.0\": an-error", diags[0].Summary)
+}
+
+func TestGitRepositoryTag_Create_AnnotatedFromCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.Set("name", "v1.0.0")
+	d.Set("repository_id", testTagRepoId)
+	d.Set("ref_commit_id", "a-commit")
+	d.Set("message", "Release 1.0.0")
+
+	projectId := uuid.New()
+	g.EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&git.GitRepository{Project: &core.TeamProjectReference{Id: &projectId}}, nil)
+	g.EXPECT().
+		CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			TagObject: &git.GitAnnotatedTag{
+				Name:         converter.String("v1.0.0"),
+				Message:      converter.String("Release 1.0.0"),
+				TaggedObject: &git.GitObject{ObjectId: converter.String("a-commit")},
+			},
+			Project:      converter.String(projectId.String()),
+			RepositoryId: converter.String(testTagRepoId),
+		}).
+		Return(nil, fmt.Errorf("an-error"))
+
+	diags := resourceGitRepositoryTagCreate(clients.Ctx, d, clients)
+	require.True(t, diags.HasError())
+	require.Equal(t, "Error creating tag \"v1.0.0\": an-error", diags[0].Summary)
+}
+
+func TestGitRepositoryTag_Read_AnnotatedTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId(testTagRepoId + ":v1")
+
+	projectId := uuid.New()
+	g.EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&git.GitRepository{Project: &core.TeamProjectReference{Id: &projectId}}, nil)
+	g.EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testTagRepoId),
+			Filter:       converter.String("tags/v1"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v1.1"), ObjectId: converter.String("other-tag")},
+				{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("a-tag"), PeeledObjectId: converter.String("a-commit")},
+			},
+		}, nil)
+	g.EXPECT().
+		GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+			Project:      converter.String(projectId.String()),
+			RepositoryId: converter.String(testTagRepoId),
+			ObjectId:     converter.String("a-tag"),
+		}).
+		Return(&git.GitAnnotatedTag{Message: converter.String("Release 1\n")}, nil)
+
+	diags := resourceGitRepositoryTagRead(clients.Ctx, d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "v1", d.Get("name"))
+	require.Equal(t, "a-commit", d.Get("ref_commit_id"))
+	require.Equal(t, "a-tag", d.Get("object_id"))
+	require.Equal(t, "Release 1", d.Get("message"))
+}
+
+func TestGitRepositoryTag_Read_RemovesMissingTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: g, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId(testTagRepoId + ":v1")
+
+	projectId := uuid.New()
+	g.EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&git.GitRepository{Project: &core.TeamProjectReference{Id: &projectId}}, nil)
+	g.EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/tags/v1.1"), ObjectId: converter.String("other-tag")}},
+		}, nil)
+
+	diags := resourceGitRepositoryTagRead(clients.Ctx, d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", d.Id())
+}
+
+func TestGitRepositoryTag_Import(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantId  string
+		wantErr bool
+	}{
+		{"slash separated", testTagRepoId + "/release/v1", testTagRepoId + ":release/v1", false},
+		{"colon separated", testTagRepoId + ":v1", testTagRepoId + ":v1", false},
+		{"prefixed tag name", testTagRepoId + "/refs/tags/v1", testTagRepoId + ":v1", false},
+		{"repository ID is not a UUID", "a-repo/v1", "", true},
+		{"missing tag name", testTagRepoId + "/", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+			d.SetId(tt.id)
+
+			got, err := resourceGitRepositoryTagImport(context.Background(), d, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantId, got[0].Id())
+			require.Equal(t, testTagRepoId, got[0].Get("repository_id"))
+		})
+	}
+}
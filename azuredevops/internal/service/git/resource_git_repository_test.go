@@ -8,11 +8,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
@@ -132,6 +135,31 @@ func TestGitRepo_FlattenExpand_RoundTrip(t *testing.T) {
 	require.Equal(t, repoInitialization.sourceURL, "")
 }
 
+// verifies that the parent of a fork in another project is exposed
+func TestGitRepo_Flatten_ForkParent(t *testing.T) {
+	parentProjectID := uuid.New()
+	parentRepoID := uuid.New()
+	gitRepo := testGitRepository
+	gitRepo.IsFork = converter.Bool(true)
+	gitRepo.ParentRepository = &git.GitRepositoryRef{
+		Id:      &parentRepoID,
+		Name:    converter.String("ParentRepo"),
+		Project: &core.TeamProjectReference{Id: &parentProjectID},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	err := flattenGitRepository(resourceData, &gitRepo)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("is_fork").(bool))
+	require.Equal(t, "ParentRepo", resourceData.Get("parent_repository_name"))
+	require.Equal(t, parentProjectID.String(), resourceData.Get("parent_project_id"))
+
+	err = flattenGitRepository(resourceData, &testGitRepository)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Get("parent_repository_name"))
+	require.Equal(t, "", resourceData.Get("parent_project_id"))
+}
+
 // verifies that the read operation is considered failed if the initial API
 // call fails.
 func TestGitRepo_Read_DoesNotSwallowErrorFromFailedReadCall(t *testing.T) {
@@ -304,3 +332,194 @@ func TestGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 
 	resourceGitRepositoryRead(resourceData, clients)
 }
+
+// verifies that the status of an import request is reported while the import is running
+func TestGitRepo_ImportRequestRefresh_ReturnsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+			Project:         converter.String(testRepoProjectID.String()),
+			RepositoryId:    converter.String(testRepoID.String()),
+			ImportRequestId: converter.Int(7),
+		}).
+		Return(&git.GitImportRequest{Status: &git.GitAsyncOperationStatusValues.InProgress}, nil).
+		Times(1)
+
+	_, state, err := importRequestRefreshFunc(clients, testRepoProjectID.String(), testRepoID.String(), 7)()
+	require.Nil(t, err)
+	require.Equal(t, string(git.GitAsyncOperationStatusValues.InProgress), state)
+}
+
+// verifies that a failed import request results in an error containing the reason
+func TestGitRepo_ImportRequestRefresh_FailedImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, gomock.Any()).
+		Return(&git.GitImportRequest{
+			Status:         &git.GitAsyncOperationStatusValues.Failed,
+			DetailedStatus: &git.GitImportStatusDetail{ErrorMessage: converter.String("Authentication failed")},
+		}, nil).
+		Times(1)
+
+	_, _, err := importRequestRefreshFunc(clients, testRepoProjectID.String(), testRepoID.String(), 7)()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Authentication failed")
+}
+
+// verifies that an existing default branch is not created again
+func TestGitRepo_CreateDefaultBranch_BranchExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Name:         converter.String("develop"),
+		}).
+		Return(&git.GitBranchStats{Name: converter.String("develop")}, nil).
+		Times(1)
+
+	err := createGitRepositoryDefaultBranch(clients, testRepoID.String(), "refs/heads/develop", "refs/heads/main")
+	require.Nil(t, err)
+}
+
+// verifies that a missing default branch is created from the previous default branch
+func TestGitRepo_CreateDefaultBranch_CreatesFromPreviousDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Name:         converter.String("develop"),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Name:         converter.String("main"),
+		}).
+		Return(&git.GitBranchStats{Commit: &git.GitCommitRef{CommitId: converter.String("a-commit")}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/heads/develop"),
+				NewObjectId: converter.String("a-commit"),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(testRepoID.String()),
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+
+	err := createGitRepositoryDefaultBranch(clients, testRepoID.String(), "refs/heads/develop", "refs/heads/main")
+	require.Nil(t, err)
+}
+
+// verifies that a repository is disabled without sending any other property
+func TestGitRepo_UpdateDisabled_OnlySendsDisabledFlag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		UpdateRepository(clients.Ctx, git.UpdateRepositoryArgs{
+			NewRepositoryInfo: &git.GitRepository{
+				Id:         &testRepoID,
+				IsDisabled: converter.Bool(true),
+			},
+			RepositoryId: &testRepoID,
+			Project:      converter.String(testRepoProjectID.String()),
+		}).
+		Return(&git.GitRepository{Id: &testRepoID, IsDisabled: converter.Bool(true)}, nil).
+		Times(1)
+
+	err := updateGitRepositoryDisabled(clients, &testRepoID, testRepoProjectID, true)
+	require.Nil(t, err)
+}
+
+// verifies that a repository which stays disabled is enabled for the update of its properties and disabled again
+func TestGitRepo_Update_RenamesDisabledRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoResource := ResourceGitRepository()
+	disabledRepository := testGitRepository
+	disabledRepository.IsDisabled = converter.Bool(true)
+	stateData := schema.TestResourceDataRaw(t, repoResource.Schema, nil)
+	stateData.SetId(testRepoID.String())
+	flattenGitRepository(stateData, &disabledRepository)
+	configureCleanInitialization(stateData)
+	state := stateData.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":     testRepoProjectID.String(),
+		"name":           "NewRepoName",
+		"disabled":       true,
+		"initialization": []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+	diff, err := repoResource.Diff(context.Background(), state, config, nil)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(repoResource.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	updateDisabled := func(disabled bool) *gomock.Call {
+		return reposClient.
+			EXPECT().
+			UpdateRepository(clients.Ctx, git.UpdateRepositoryArgs{
+				NewRepositoryInfo: &git.GitRepository{Id: &testRepoID, IsDisabled: converter.Bool(disabled)},
+				RepositoryId:      &testRepoID,
+				Project:           converter.String(testRepoProjectID.String()),
+			}).
+			Return(&git.GitRepository{Id: &testRepoID}, nil)
+	}
+	gomock.InOrder(
+		updateDisabled(false),
+		reposClient.
+			EXPECT().
+			UpdateRepository(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.UpdateRepositoryArgs) (*git.GitRepository, error) {
+				require.Equal(t, "NewRepoName", *args.NewRepositoryInfo.Name)
+				require.Nil(t, args.NewRepositoryInfo.IsDisabled)
+				return args.NewRepositoryInfo, nil
+			}),
+		updateDisabled(true),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(nil, errors.New("GetRepository() Failed")),
+	)
+
+	err = resourceGitRepositoryUpdate(resourceData, clients)
+	require.Regexp(t, ".*GetRepository\\(\\) Failed", err.Error())
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataDescriptor schema and implementation for the descriptor data source, which converts between the storage key
+// and the subject descriptor of a graph subject
+func DataDescriptor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDescriptorRead,
+		Schema: map[string]*schema.Schema{
+			"storage_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"storage_key", "descriptor"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"descriptor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"storage_key", "descriptor"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func dataSourceDescriptorRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if v, ok := d.GetOk("storage_key"); ok {
+		storageKey, err := uuid.Parse(v.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing storage key %s: %v", v, err)
+		}
+		descriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{
+			StorageKey: &storageKey,
+		})
+		if err != nil {
+			return fmt.Errorf(" getting descriptor for storage key %s: %v", storageKey, err)
+		}
+		if descriptor == nil || descriptor.Value == nil {
+			return fmt.Errorf(" No descriptor found for storage key %s", storageKey)
+		}
+
+		d.SetId(storageKey.String())
+		d.Set("descriptor", *descriptor.Value)
+		return nil
+	}
+
+	descriptor := d.Get("descriptor").(string)
+	storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+		SubjectDescriptor: converter.String(descriptor),
+	})
+	if err != nil {
+		return fmt.Errorf(" getting storage key for descriptor %s: %v", descriptor, err)
+	}
+	if storageKey == nil || storageKey.Value == nil {
+		return fmt.Errorf(" No storage key found for descriptor %s", descriptor)
+	}
+
+	d.SetId(storageKey.Value.String())
+	d.Set("storage_key", storageKey.Value.String())
+	return nil
+}
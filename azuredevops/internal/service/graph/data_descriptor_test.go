@@ -0,0 +1,90 @@
+//go:build (all || core || data_sources || data_descriptor) && (!exclude_data_sources || !exclude_data_descriptor)
+// +build all core data_sources data_descriptor
+// +build !exclude_data_sources !exclude_data_descriptor
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a storage key is converted into a descriptor
+func TestDescriptorDataSource_StorageKeyToDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	storageKey := uuid.New()
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &storageKey}).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("aad.descriptor")}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDescriptor().Schema, nil)
+	resourceData.Set("storage_key", storageKey.String())
+
+	err := dataSourceDescriptorRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, storageKey.String(), resourceData.Id())
+	require.Equal(t, "aad.descriptor", resourceData.Get("descriptor"))
+}
+
+// verifies that a descriptor is converted into a storage key
+func TestDescriptorDataSource_DescriptorToStorageKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	storageKey := uuid.New()
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: converter.String("aad.descriptor")}).
+		Return(&graph.GraphStorageKeyResult{Value: &storageKey}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDescriptor().Schema, nil)
+	resourceData.Set("descriptor", "aad.descriptor")
+
+	err := dataSourceDescriptorRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, storageKey.String(), resourceData.Id())
+	require.Equal(t, storageKey.String(), resourceData.Get("storage_key"))
+}
+
+// verifies that errors of the conversion are returned
+func TestDescriptorDataSource_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetStorageKey() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDescriptor().Schema, nil)
+	resourceData.Set("descriptor", "aad.descriptor")
+
+	err := dataSourceDescriptorRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetStorageKey() Failed")
+}
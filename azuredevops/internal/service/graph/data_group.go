@@ -169,7 +169,7 @@ func getGroupsWithContinuationToken(clients *client.AggregatedClient, projectDes
 
 func selectGroup(groups *[]graph.GraphGroup, groupName string) *graph.GraphGroup {
 	for _, group := range *groups {
-		if strings.EqualFold(*group.DisplayName, groupName) {
+		if group.DisplayName != nil && strings.EqualFold(*group.DisplayName, groupName) {
 			return &group
 		}
 	}
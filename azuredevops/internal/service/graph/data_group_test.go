@@ -166,6 +166,18 @@ func TestGroupDataSource_HandlesCollectionGroups_And_ReturnsCorrectGroup(t *test
 	require.Empty(t, resourceData.Get("project_id"))
 }
 
+// verifies that groups without a display name are skipped while selecting the target group
+func TestGroupDataSource_SelectGroup_SkipsGroupsWithoutName(t *testing.T) {
+	groups := []graph.GraphGroup{
+		{Descriptor: converter.String("descriptor1")},
+		{Descriptor: converter.String("descriptor2"), DisplayName: converter.String("Project Collection Administrators")},
+	}
+
+	group := selectGroup(&groups, "project collection administrators")
+	require.NotNil(t, group)
+	require.Equal(t, "descriptor2", *group.Descriptor)
+}
+
 func testGroupDataSource_HandlesCollectionGroups(t *testing.T, resourceData *schema.ResourceData) error {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServicePrincipal schema and implementation for the service principal data source
+func DataServicePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServicePrincipalRead,
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "client_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "client_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServicePrincipalRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	displayName := d.Get("display_name").(string)
+	clientID := d.Get("client_id").(string)
+
+	servicePrincipals, err := getServicePrincipals(clients)
+	if err != nil {
+		return fmt.Errorf(" listing service principals: %v", err)
+	}
+
+	var matches []graph.GraphServicePrincipal
+	for _, servicePrincipal := range servicePrincipals {
+		if clientID != "" {
+			if strings.EqualFold(converter.ToString(servicePrincipal.ApplicationId, ""), clientID) {
+				matches = append(matches, servicePrincipal)
+			}
+		} else if strings.EqualFold(converter.ToString(servicePrincipal.DisplayName, ""), displayName) {
+			matches = append(matches, servicePrincipal)
+		}
+	}
+
+	if len(matches) == 0 {
+		if clientID != "" {
+			return fmt.Errorf(" Could not find service principal with client ID %s", clientID)
+		}
+		return fmt.Errorf(" Could not find service principal with display name %s", displayName)
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf(" Found %d service principals with display name %s, use the client ID instead", len(matches), displayName)
+	}
+
+	servicePrincipal := matches[0]
+	if servicePrincipal.Descriptor == nil {
+		return fmt.Errorf(" Service principal %s does not contain a descriptor", converter.ToString(servicePrincipal.DisplayName, ""))
+	}
+
+	d.SetId(*servicePrincipal.Descriptor)
+	d.Set("display_name", servicePrincipal.DisplayName)
+	d.Set("client_id", servicePrincipal.ApplicationId)
+	d.Set("descriptor", servicePrincipal.Descriptor)
+	d.Set("origin", servicePrincipal.Origin)
+	d.Set("origin_id", servicePrincipal.OriginId)
+	return nil
+}
+
+func getServicePrincipals(clients *client.AggregatedClient) ([]graph.GraphServicePrincipal, error) {
+	servicePrincipals := []graph.GraphServicePrincipal{}
+	args := graph.ListServicePrincipalsArgs{}
+	for {
+		response, err := clients.GraphClient.ListServicePrincipals(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			break
+		}
+		if response.GraphServicePrincipals != nil {
+			servicePrincipals = append(servicePrincipals, *response.GraphServicePrincipals...)
+		}
+		if response.ContinuationToken == nil || len(*response.ContinuationToken) == 0 || (*response.ContinuationToken)[0] == "" {
+			break
+		}
+		args.ContinuationToken = converter.String((*response.ContinuationToken)[0])
+	}
+	return servicePrincipals, nil
+}
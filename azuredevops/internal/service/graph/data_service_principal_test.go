@@ -0,0 +1,138 @@
+//go:build (all || core || data_sources || data_service_principal) && (!exclude_data_sources || !exclude_data_service_principal)
+// +build all core data_sources data_service_principal
+// +build !exclude_data_sources !exclude_data_service_principal
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testServicePrincipals = []graph.GraphServicePrincipal{
+	{
+		Descriptor:    converter.String("aadsp.descriptor1"),
+		DisplayName:   converter.String("automation"),
+		ApplicationId: converter.String("11111111-1111-1111-1111-111111111111"),
+		Origin:        converter.String("aad"),
+		OriginId:      converter.String("origin1"),
+	},
+	{
+		Descriptor:    converter.String("aadsp.descriptor2"),
+		DisplayName:   converter.String("deployment"),
+		ApplicationId: converter.String("22222222-2222-2222-2222-222222222222"),
+		Origin:        converter.String("aad"),
+		OriginId:      converter.String("origin2"),
+	},
+}
+
+// verifies that a service principal is found by its client ID across pages
+func TestServicePrincipalDataSource_FindByClientID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	firstPage := testServicePrincipals[:1]
+	secondPage := testServicePrincipals[1:]
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{}).
+		Return(&graph.PagedGraphServicePrincipals{
+			GraphServicePrincipals: &firstPage,
+			ContinuationToken:      &[]string{"token"},
+		}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{ContinuationToken: converter.String("token")}).
+		Return(&graph.PagedGraphServicePrincipals{GraphServicePrincipals: &secondPage}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipal().Schema, nil)
+	resourceData.Set("client_id", "22222222-2222-2222-2222-222222222222")
+
+	err := dataSourceServicePrincipalRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "aadsp.descriptor2", resourceData.Id())
+	require.Equal(t, "deployment", resourceData.Get("display_name"))
+	require.Equal(t, "origin2", resourceData.Get("origin_id"))
+}
+
+// verifies that the display name is matched case insensitive
+func TestServicePrincipalDataSource_FindByDisplayName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, gomock.Any()).
+		Return(&graph.PagedGraphServicePrincipals{GraphServicePrincipals: &testServicePrincipals}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipal().Schema, nil)
+	resourceData.Set("display_name", "Automation")
+
+	err := dataSourceServicePrincipalRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "aadsp.descriptor1", resourceData.Get("descriptor"))
+	require.Equal(t, "11111111-1111-1111-1111-111111111111", resourceData.Get("client_id"))
+}
+
+// verifies that an ambiguous display name results in an error
+func TestServicePrincipalDataSource_AmbiguousDisplayName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	servicePrincipals := []graph.GraphServicePrincipal{testServicePrincipals[0], testServicePrincipals[0]}
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, gomock.Any()).
+		Return(&graph.PagedGraphServicePrincipals{GraphServicePrincipals: &servicePrincipals}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipal().Schema, nil)
+	resourceData.Set("display_name", "automation")
+
+	err := dataSourceServicePrincipalRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Found 2 service principals")
+}
+
+// verifies that errors of the API are returned
+func TestServicePrincipalDataSource_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ListServicePrincipals() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipal().Schema, nil)
+	resourceData.Set("display_name", "automation")
+
+	err := dataSourceServicePrincipalRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ListServicePrincipals() Failed")
+}
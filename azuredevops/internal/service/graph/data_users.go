@@ -240,17 +240,28 @@ func getUsersWithContinuationToken(clients *client.AggregatedClient, subjectType
 		return nil, "", fmt.Errorf("Error listing users: %q", err)
 	}
 
+	if response == nil {
+		return []graph.GraphUser{}, "", nil
+	}
+
+	// the continuation token is the only reliable way to know whether there are more pages, a page might be empty
+	// even if there are more users
 	continuationToken = ""
-	if response.ContinuationToken != nil && (*response.ContinuationToken)[0] != "" {
+	if response.ContinuationToken != nil && len(*response.ContinuationToken) > 0 && (*response.ContinuationToken)[0] != "" {
 		continuationToken = (*response.ContinuationToken)[0]
 	}
 
-	return *response.GraphUsers, continuationToken, nil
+	users := []graph.GraphUser{}
+	if response.GraphUsers != nil {
+		users = *response.GraphUsers
+	}
+	return users, continuationToken, nil
 }
 
 func addStorageKeyAsId(clients *client.AggregatedClient, users []interface{}, numWorkers int) error {
 	userQueue := make(chan map[string]interface{}, len(users))
-	errChan := make(chan error)
+	// every worker reports at most one error, a buffer per worker keeps failing workers from blocking
+	errChan := make(chan error, numWorkers)
 
 	var wg sync.WaitGroup
 
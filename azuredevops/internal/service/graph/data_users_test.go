@@ -155,6 +155,56 @@ func TestDataSourceUser_Read_HandlesContinuationToken(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// verifies that empty pages do not stop the paging as long as a continuation token is returned
+func TestDataSourceUser_Read_HandlesEmptyPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{
+		GraphClient: graphClient,
+		Ctx:         context.Background(),
+	}
+
+	var calls []*gomock.Call
+	calls = append(calls, graphClient.
+		EXPECT().
+		ListUsers(clients.Ctx, graph.ListUsersArgs{
+			SubjectTypes: &[]string{},
+		}).
+		Return(&graph.PagedGraphUsers{
+			ContinuationToken: &[]string{"2"},
+		}, nil).
+		Times(1))
+
+	calls = append(calls, graphClient.
+		EXPECT().
+		ListUsers(clients.Ctx, graph.ListUsersArgs{
+			SubjectTypes:      &[]string{},
+			ContinuationToken: converter.String("2"),
+		}).
+		Return(&graph.PagedGraphUsers{
+			GraphUsers:        &usrList2,
+			ContinuationToken: &[]string{},
+		}, nil).
+		Times(1))
+
+	calls = append(calls, graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, gomock.Any()).
+		Return(&graph.GraphStorageKeyResult{
+			Links: "",
+			Value: &id,
+		}, nil).Times(3))
+
+	gomock.InOrder(calls...)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUsers().Schema, nil)
+	err := dataUsersRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 3, resourceData.Get("users").(*schema.Set).Len())
+}
+
 // verifies that a single user can be read successfully
 func TestDataSourceUser_Read_TestReadEmptyUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
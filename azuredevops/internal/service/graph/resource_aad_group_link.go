@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const (
+	aadGroupLinkPending = "Pending"
+	aadGroupLinkLinked  = "Linked"
+)
+
+// ResourceAadGroupLink schema and implementation for a resource materializing an Azure AD group in the organization
+func ResourceAadGroupLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAadGroupLinkCreate,
+		Read:   resourceAadGroupLinkRead,
+		Delete: resourceAadGroupLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"origin_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAadGroupLinkCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	originID := d.Get("origin_id").(string)
+
+	var scopeDescriptor *string
+	if v, ok := d.GetOk("scope"); ok {
+		scope, _ := uuid.Parse(v.(string))
+		descriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{
+			StorageKey: &scope,
+		})
+		if err != nil {
+			return fmt.Errorf(" getting descriptor of scope %s: %v", scope, err)
+		}
+		if descriptor == nil || descriptor.Value == nil {
+			return fmt.Errorf(" scope %s has no descriptor", scope)
+		}
+		scopeDescriptor = descriptor.Value
+	}
+
+	group, err := clients.GraphClient.CreateGroupOriginId(clients.Ctx, graph.CreateGroupOriginIdArgs{
+		CreationContext: &graph.GraphGroupOriginIdCreationContext{
+			OriginId: converter.String(originID),
+		},
+		ScopeDescriptor: scopeDescriptor,
+	})
+	if err != nil {
+		return fmt.Errorf(" linking Azure AD group %s: %v", originID, err)
+	}
+	if group == nil || group.Descriptor == nil {
+		return fmt.Errorf(" linking Azure AD group %s: no descriptor was returned", originID)
+	}
+	d.SetId(*group.Descriptor)
+
+	// the group is only usable by memberships and entitlements once it can be read and resolved by its descriptor
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{aadGroupLinkPending},
+		Target:                    []string{aadGroupLinkLinked},
+		Refresh:                   aadGroupLinkRefreshFunc(clients, *group.Descriptor),
+		Timeout:                   d.Timeout(schema.TimeoutCreate),
+		MinTimeout:                5 * time.Second,
+		Delay:                     2 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for Azure AD group %s to be linked: %v", originID, err)
+	}
+	return resourceAadGroupLinkRead(d, m)
+}
+
+func resourceAadGroupLinkRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	group, err := clients.GraphClient.GetGroup(clients.Ctx, graph.GetGroupArgs{
+		GroupDescriptor: converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading linked Azure AD group %s: %v", d.Id(), err)
+	}
+	if group == nil {
+		d.SetId("")
+		return nil
+	}
+
+	storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+		SubjectDescriptor: converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf(" getting storage key of linked Azure AD group %s: %v", d.Id(), err)
+	}
+
+	d.Set("descriptor", d.Id())
+	if storageKey != nil && storageKey.Value != nil {
+		d.Set("storage_key", storageKey.Value.String())
+	}
+	d.Set("origin_id", converter.ToString(group.OriginId, ""))
+	d.Set("display_name", converter.ToString(group.DisplayName, ""))
+	d.Set("principal_name", converter.ToString(group.PrincipalName, ""))
+	d.Set("mail", converter.ToString(group.MailAddress, ""))
+	d.Set("domain", converter.ToString(group.Domain, ""))
+	return nil
+}
+
+func resourceAadGroupLinkDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	err := clients.GraphClient.DeleteGroup(clients.Ctx, graph.DeleteGroupArgs{
+		GroupDescriptor: converter.String(d.Id()),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing linked Azure AD group %s: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func aadGroupLinkRefreshFunc(clients *client.AggregatedClient, descriptor string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, err := clients.GraphClient.GetGroup(clients.Ctx, graph.GetGroupArgs{
+			GroupDescriptor: &descriptor,
+		})
+		if err == nil {
+			_, err = clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+				SubjectDescriptor: &descriptor,
+			})
+		}
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return aadGroupLinkPending, aadGroupLinkPending, nil
+			}
+			return nil, "", fmt.Errorf(" reading linked Azure AD group %s: %v", descriptor, err)
+		}
+		return aadGroupLinkLinked, aadGroupLinkLinked, nil
+	}
+}
@@ -0,0 +1,177 @@
+//go:build (all || core || resource_aad_group_link) && !exclude_resource_aad_group_link
+// +build all core resource_aad_group_link
+// +build !exclude_resource_aad_group_link
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a group which can not be read yet keeps the wait pending
+func TestAadGroupLink_Refresh_PendingWhileNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, graph.GetGroupArgs{GroupDescriptor: converter.String("aadgp.descriptor")}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	_, state, err := aadGroupLinkRefreshFunc(clients, "aadgp.descriptor")()
+	require.Nil(t, err)
+	require.Equal(t, aadGroupLinkPending, state)
+}
+
+// verifies that the wait completes once the group and its storage key can be read
+func TestAadGroupLink_Refresh_LinkedWhenResolvable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	storageKey := uuid.New()
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, graph.GetGroupArgs{GroupDescriptor: converter.String("aadgp.descriptor")}).
+		Return(&graph.GraphGroup{Descriptor: converter.String("aadgp.descriptor")}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: converter.String("aadgp.descriptor")}).
+		Return(&graph.GraphStorageKeyResult{Value: &storageKey}, nil).
+		Times(1)
+
+	_, state, err := aadGroupLinkRefreshFunc(clients, "aadgp.descriptor")()
+	require.Nil(t, err)
+	require.Equal(t, aadGroupLinkLinked, state)
+}
+
+// verifies that an error linking the group is not swallowed
+func TestAadGroupLink_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	originID := uuid.NewString()
+	graphClient.
+		EXPECT().
+		CreateGroupOriginId(clients.Ctx, graph.CreateGroupOriginIdArgs{
+			CreationContext: &graph.GraphGroupOriginIdCreationContext{OriginId: &originID},
+		}).
+		Return(nil, errors.New("CreateGroupOriginId() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAadGroupLink().Schema, nil)
+	resourceData.Set("origin_id", originID)
+
+	err := resourceAadGroupLinkCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateGroupOriginId() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the resource is removed from the state once the group is gone
+func TestAadGroupLink_Read_RemovedGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAadGroupLink().Schema, nil)
+	resourceData.SetId("aadgp.descriptor")
+
+	err := resourceAadGroupLinkRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a linked group stays tracked when the wait for it fails
+func TestAadGroupLink_Create_KeepsIDWhenWaitFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	originID := uuid.NewString()
+	graphClient.
+		EXPECT().
+		CreateGroupOriginId(clients.Ctx, gomock.Any()).
+		Return(&graph.GraphGroup{Descriptor: converter.String("aadgp.descriptor")}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetGroup() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAadGroupLink().Schema, nil)
+	resourceData.Set("origin_id", originID)
+
+	err := resourceAadGroupLinkCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "GetGroup() Failed")
+	require.Equal(t, "aadgp.descriptor", resourceData.Id())
+}
+
+// verifies that a response without descriptor is reported instead of dereferenced
+func TestAadGroupLink_Create_MissingDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	scope := uuid.New()
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &scope}).
+		Return(&graph.GraphDescriptorResult{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAadGroupLink().Schema, nil)
+	resourceData.Set("origin_id", uuid.NewString())
+	resourceData.Set("scope", scope.String())
+
+	err := resourceAadGroupLinkCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "has no descriptor")
+
+	graphClient.
+		EXPECT().
+		CreateGroupOriginId(clients.Ctx, gomock.Any()).
+		Return(&graph.GraphGroup{}, nil).
+		Times(1)
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceAadGroupLink().Schema, nil)
+	resourceData.Set("origin_id", uuid.NewString())
+
+	err = resourceAadGroupLinkCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "no descriptor was returned")
+	require.Equal(t, "", resourceData.Id())
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,6 +17,8 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
+const groupOriginVsts = "vsts"
+
 // ResourceGroup schema and implementation for group resource
 func ResourceGroup() *schema.Resource {
 	return &schema.Resource{
@@ -26,6 +29,7 @@ func ResourceGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeGroupDiff,
 		Schema: map[string]*schema.Schema{
 			"scope": {
 				Type:         schema.TypeString,
@@ -106,6 +110,11 @@ func ResourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -198,6 +207,16 @@ func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
+
+	storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+		SubjectDescriptor: group.Descriptor,
+	})
+	if err != nil {
+		return fmt.Errorf(" getting storage key of group %s: %v", *group.Descriptor, err)
+	}
+	if storageKey != nil && storageKey.Value != nil {
+		d.Set("group_id", storageKey.Value.String())
+	}
 	return flattenGroup(d, group, members)
 }
 
@@ -260,6 +279,24 @@ func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceGroupRead(d, m)
 }
 
+// Display name and description can only be changed for Azure DevOps groups, groups backed by an external provider
+// take them from the provider
+func customizeGroupDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	origin, _ := d.GetChange("origin")
+	if origin.(string) == "" || strings.EqualFold(origin.(string), groupOriginVsts) {
+		return nil
+	}
+	for _, key := range []string{"display_name", "description"} {
+		if d.HasChange(key) {
+			return fmt.Errorf(" %s of group %s cannot be updated, the group is backed by origin %s", key, d.Id(), origin)
+		}
+	}
+	return nil
+}
+
 func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 
@@ -319,8 +356,9 @@ func flattenGroup(d *schema.ResourceData, group *graph.GraphGroup, members *[]gr
 	if group.PrincipalName != nil {
 		d.Set("principal_name", *group.PrincipalName)
 	}
-	if group.Description != nil {
-		d.Set("description", *group.Description)
+	// the description of groups backed by an external provider is managed by the provider and not by Azure DevOps
+	if strings.EqualFold(converter.ToString(group.Origin, ""), groupOriginVsts) {
+		d.Set("description", converter.ToString(group.Description, ""))
 	}
 	if members != nil {
 		dMembers := make([]string, len(*members))
@@ -68,7 +68,9 @@ func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error
 		if err != nil {
 			return fmt.Errorf("Error converting membership list to set: %+v", err)
 		}
-		membersToRemove = membersToAdd.Difference(actualMembershipsSet)
+		// existing members that are not part of the configuration need to be removed. The configured
+		// members are rehashed since set operations compare hash codes.
+		membersToRemove = actualMembershipsSet.Difference(schema.NewSet(schema.HashString, membersToAdd.List()))
 	} else {
 		membersToRemove, _ = getGroupMembershipSet(nil)
 	}
@@ -39,6 +39,41 @@ func TestGroupMembership_Create_DoesNotSwallowErrors(t *testing.T) {
 	require.Contains(t, err.Error(), "AddMembership() Failed")
 }
 
+// verifies that overwrite mode removes existing members that are not part of the configuration
+func TestGroupMembership_Create_OverwriteRemovesUnmanagedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.Ctx, graph.ListMembershipsArgs{
+			SubjectDescriptor: converter.String("TEST_GROUP"),
+			Direction:         &graph.GraphTraversalDirectionValues.Down,
+			Depth:             converter.Int(1),
+		}).
+		Return(&[]graph.GraphMembership{
+			*buildMembership("TEST_GROUP", "TEST_MEMBER_1"),
+			*buildMembership("TEST_GROUP", "TEST_MEMBER_2"),
+		}, nil)
+
+	expectedArgs := graph.RemoveMembershipArgs{
+		ContainerDescriptor: converter.String("TEST_GROUP"),
+		SubjectDescriptor:   converter.String("TEST_MEMBER_2"),
+	}
+	graphClient.
+		EXPECT().
+		RemoveMembership(clients.Ctx, expectedArgs).
+		Return(errors.New("RemoveMembership() Failed"))
+
+	resourceData := getGroupMembershipResourceData(t, "TEST_GROUP", "TEST_MEMBER_1")
+	resourceData.Set("mode", "overwrite")
+	err := resourceGroupMembershipCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "RemoveMembership() Failed")
+}
+
 func TestGroupMembership_Destroy_DoesNotSwallowErrors(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
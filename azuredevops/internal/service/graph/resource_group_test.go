@@ -13,9 +13,13 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -343,3 +347,140 @@ func TestGroupResource_Create_TestHandleErrorVstsContext(t *testing.T) {
 		require.Contains(t, err.Error(), "CreateGroup() Failed")
 	*/
 }
+
+// verifies that the storage key of the group is exported as group_id and the description of a vsts group is read
+func TestGroupResource_Read_SetsGroupIDAndDescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	groupDescriptor := "vssgp.descriptor"
+	storageKey := uuid.New()
+	expectGroupRead(graphClient, clients, &graph.GraphGroup{
+		Descriptor:  converter.String(groupDescriptor),
+		DisplayName: converter.String(displayName),
+		Origin:      converter.String("vsts"),
+		Domain:      converter.String("vstfs:///Framework/IdentityDomain/00000000-0000-0000-0000-000000000000"),
+	}, &storageKey)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroup().Schema, nil)
+	resourceData.SetId(groupDescriptor)
+	resourceData.Set("description", "outdated description")
+
+	err := resourceGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, storageKey.String(), resourceData.Get("group_id"))
+	require.Equal(t, groupDescriptor, resourceData.Get("descriptor"))
+	require.Equal(t, "", resourceData.Get("description"))
+}
+
+// verifies that the description of a group backed by an external provider is not read into the state
+func TestGroupResource_Read_IgnoresDescriptionOfAadGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	groupDescriptor := "aadgp.descriptor"
+	storageKey := uuid.New()
+	expectGroupRead(graphClient, clients, &graph.GraphGroup{
+		Descriptor:  converter.String(groupDescriptor),
+		DisplayName: converter.String(displayName),
+		Description: converter.String("managed in Azure AD"),
+		Origin:      converter.String("aad"),
+		OriginId:    converter.String(originID),
+		Domain:      converter.String("00000000-0000-0000-0000-000000000000"),
+	}, &storageKey)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroup().Schema, nil)
+	resourceData.SetId(groupDescriptor)
+
+	err := resourceGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, storageKey.String(), resourceData.Get("group_id"))
+	require.Equal(t, originID, resourceData.Get("origin_id"))
+	require.Equal(t, "", resourceData.Get("description"))
+}
+
+// verifies that the description of a group backed by an external provider is only rejected when it changes
+func TestGroupResource_CustomizeDiff_RejectsDescriptionChangeOfAadGroup(t *testing.T) {
+	r := ResourceGroup()
+	stateData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"origin_id":   originID,
+		"description": "managed in Azure AD",
+	})
+	stateData.SetId("aadgp.descriptor")
+	stateData.Set("origin", "aad")
+	state := stateData.State()
+
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"origin_id":   originID,
+		"description": "managed in Azure AD",
+	}), nil)
+	require.Nil(t, err)
+
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"origin_id":   originID,
+		"description": "changed",
+	}), nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "description of group aadgp.descriptor cannot be updated")
+}
+
+// verifies that errors reading the storage key are returned
+func TestGroupResource_Read_DoesNotSwallowStorageKeyError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	groupDescriptor := "vssgp.descriptor"
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, graph.GetGroupArgs{GroupDescriptor: converter.String(groupDescriptor)}).
+		Return(&graph.GraphGroup{Descriptor: converter.String(groupDescriptor)}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.Ctx, gomock.Any()).
+		Return(&[]graph.GraphMembership{}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetStorageKey() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroup().Schema, nil)
+	resourceData.SetId(groupDescriptor)
+
+	err := resourceGroupRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetStorageKey() Failed")
+}
+
+func expectGroupRead(graphClient *azdosdkmocks.MockGraphClient, clients *client.AggregatedClient, group *graph.GraphGroup, storageKey *uuid.UUID) {
+	graphClient.
+		EXPECT().
+		GetGroup(clients.Ctx, graph.GetGroupArgs{GroupDescriptor: group.Descriptor}).
+		Return(group, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.Ctx, graph.ListMembershipsArgs{
+			SubjectDescriptor: group.Descriptor,
+			Direction:         &graph.GraphTraversalDirectionValues.Down,
+			Depth:             converter.Int(1),
+		}).
+		Return(&[]graph.GraphMembership{}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: group.Descriptor}).
+		Return(&graph.GraphStorageKeyResult{Value: storageKey}, nil).
+		Times(1)
+}
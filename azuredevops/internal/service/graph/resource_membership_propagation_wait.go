@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+)
+
+const (
+	membershipPropagationPending    = "Pending"
+	membershipPropagationPropagated = "Propagated"
+)
+
+// ResourceMembershipPropagationWait schema and implementation for a resource waiting until a membership is visible
+func ResourceMembershipPropagationWait() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembershipPropagationWaitCreate,
+		Read:   resourceMembershipPropagationWaitRead,
+		Delete: resourceMembershipPropagationWaitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"subject_descriptor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"container_descriptor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceMembershipPropagationWaitCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subject := d.Get("subject_descriptor").(string)
+	container := d.Get("container_descriptor").(string)
+
+	// the membership has to be seen several times in a row, since reads may hit replicas that are not yet updated
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{membershipPropagationPending},
+		Target:                    []string{membershipPropagationPropagated},
+		Refresh:                   membershipPropagationRefreshFunc(clients, subject, container),
+		Timeout:                   d.Timeout(schema.TimeoutCreate),
+		MinTimeout:                5 * time.Second,
+		Delay:                     2 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil { //nolint:staticcheck
+		return fmt.Errorf("Error waiting for membership of %s in %s to propagate: %+v", subject, container, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", container, subject))
+	return nil
+}
+
+func resourceMembershipPropagationWaitRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subject := d.Get("subject_descriptor").(string)
+	container := d.Get("container_descriptor").(string)
+
+	err := clients.GraphClient.CheckMembershipExistence(clients.Ctx, graph.CheckMembershipExistenceArgs{
+		SubjectDescriptor:   &subject,
+		ContainerDescriptor: &container,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			// the membership is gone, so the resource is recreated and waits again
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error checking membership of %s in %s: %+v", subject, container, err)
+	}
+	return nil
+}
+
+func resourceMembershipPropagationWaitDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func membershipPropagationRefreshFunc(clients *client.AggregatedClient, subject string, container string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		err := clients.GraphClient.CheckMembershipExistence(clients.Ctx, graph.CheckMembershipExistenceArgs{
+			SubjectDescriptor:   &subject,
+			ContainerDescriptor: &container,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return membershipPropagationPending, membershipPropagationPending, nil
+			}
+			return nil, "", fmt.Errorf("Error checking membership of %s in %s: %+v", subject, container, err)
+		}
+		return membershipPropagationPropagated, membershipPropagationPropagated, nil
+	}
+}
@@ -0,0 +1,114 @@
+//go:build (all || core || resource_membership_propagation_wait) && !exclude_resource_membership_propagation_wait
+// +build all core resource_membership_propagation_wait
+// +build !exclude_resource_membership_propagation_wait
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var membershipPropagationWaitArgs = graph.CheckMembershipExistenceArgs{
+	SubjectDescriptor:   converter.String("TEST_MEMBER"),
+	ContainerDescriptor: converter.String("TEST_GROUP"),
+}
+
+func getMembershipPropagationWaitResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceMembershipPropagationWait().Schema, map[string]interface{}{
+		"subject_descriptor":   "TEST_MEMBER",
+		"container_descriptor": "TEST_GROUP",
+	})
+	resourceData.SetId("TEST_GROUP/TEST_MEMBER")
+	return resourceData
+}
+
+// verifies that a membership which is not visible yet keeps the wait pending
+func TestMembershipPropagationWait_Refresh_PendingWhileNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		CheckMembershipExistence(clients.Ctx, membershipPropagationWaitArgs).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	_, state, err := membershipPropagationRefreshFunc(clients, "TEST_MEMBER", "TEST_GROUP")()
+	require.Nil(t, err)
+	require.Equal(t, membershipPropagationPending, state)
+}
+
+// verifies that a visible membership completes the wait
+func TestMembershipPropagationWait_Refresh_PropagatedWhenFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		CheckMembershipExistence(clients.Ctx, membershipPropagationWaitArgs).
+		Return(nil).
+		Times(1)
+
+	_, state, err := membershipPropagationRefreshFunc(clients, "TEST_MEMBER", "TEST_GROUP")()
+	require.Nil(t, err)
+	require.Equal(t, membershipPropagationPropagated, state)
+}
+
+// verifies that if an error is produced while waiting, the error is not swallowed
+func TestMembershipPropagationWait_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		CheckMembershipExistence(clients.Ctx, membershipPropagationWaitArgs).
+		Return(errors.New("CheckMembershipExistence() Failed")).
+		Times(1)
+
+	resourceData := getMembershipPropagationWaitResourceData(t)
+	resourceData.SetId("")
+	err := resourceMembershipPropagationWaitCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CheckMembershipExistence() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the resource is removed from the state once the membership is gone
+func TestMembershipPropagationWait_Read_RemovedMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		CheckMembershipExistence(clients.Ctx, membershipPropagationWaitArgs).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := getMembershipPropagationWaitResourceData(t)
+	err := resourceMembershipPropagationWaitRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,151 @@
+package identity
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// the identity properties that contain the principal name of an identity
+var identityPrincipalNameProperties = []string{"Account", "Mail"}
+
+// DataIdentities schema and implementation for the identities data source, which resolves several principal names
+// with a single request
+func DataIdentities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentitiesRead,
+		Schema: map[string]*schema.Schema{
+			"principal_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"search_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "General",
+				ValidateFunc: validation.StringInSlice([]string{"AccountName", "MailAddress", "General"}, false),
+			},
+			"identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIdentitiesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	principalNames := tfhelper.ExpandStringList(d.Get("principal_names").([]interface{}))
+	searchFilter := d.Get("search_filter").(string)
+
+	filterValue := strings.Join(principalNames, ",")
+	response, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SearchFilter: &searchFilter,
+		FilterValue:  &filterValue,
+	})
+	if err != nil {
+		return fmt.Errorf(" reading identities with filter %s: %v", searchFilter, err)
+	}
+
+	identitiesByName := map[string]identity.Identity{}
+	if response != nil {
+		for _, item := range *response {
+			if item.Id == nil {
+				continue
+			}
+			for _, name := range identityPrincipalNames(&item) {
+				identitiesByName[strings.ToLower(name)] = item
+			}
+		}
+	}
+
+	identities := []interface{}{}
+	descriptors := []string{}
+	unresolved := []string{}
+	for _, principalName := range principalNames {
+		item, ok := identitiesByName[strings.ToLower(principalName)]
+		if !ok {
+			unresolved = append(unresolved, principalName)
+			continue
+		}
+		identities = append(identities, map[string]interface{}{
+			"principal_name":     principalName,
+			"id":                 item.Id.String(),
+			"descriptor":         converter.ToString(item.Descriptor, ""),
+			"subject_descriptor": converter.ToString(item.SubjectDescriptor, ""),
+			"display_name":       converter.ToString(item.ProviderDisplayName, ""),
+		})
+		descriptors = append(descriptors, converter.ToString(item.Descriptor, ""))
+	}
+	if len(unresolved) > 0 {
+		return fmt.Errorf(" Could not find identities for %s with filter %s", strings.Join(unresolved, ", "), searchFilter)
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(descriptors, "-"))); err != nil {
+		return fmt.Errorf("Unable to compute hash for identity descriptors: %v", err)
+	}
+	d.SetId("identities#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("identities", identities); err != nil {
+		return fmt.Errorf("Error setting `identities`: %+v", err)
+	}
+	return nil
+}
+
+// Read the principal names of an identity from its properties, which are returned as
+// {"Account": {"$type": "System.String", "$value": "user@contoso.com"}, ...}
+func identityPrincipalNames(item *identity.Identity) []string {
+	properties, ok := item.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := []string{}
+	for _, key := range identityPrincipalNameProperties {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, ok := property["$value"].(string); ok && value != "" {
+			names = append(names, value)
+		}
+	}
+	return names
+}
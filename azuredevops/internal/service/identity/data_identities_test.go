@@ -0,0 +1,117 @@
+//go:build (all || core || data_sources || data_identities) && (!exclude_data_sources || !exclude_data_identities)
+// +build all core data_sources data_identities
+// +build !exclude_data_sources !exclude_data_identities
+
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that all principal names are resolved with a single request and returned in the configured order
+func TestIdentitiesDataSource_ResolvesPrincipalNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	firstID := uuid.New()
+	secondID := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SearchFilter: converter.String("General"),
+			FilterValue:  converter.String("first@contoso.com,Second@contoso.com"),
+		}).
+		Return(&[]identity.Identity{
+			testIdentityWithAccount(secondID, "Microsoft.IdentityModel.Claims.ClaimsIdentity;second", "second@contoso.com"),
+			testIdentityWithAccount(firstID, "Microsoft.IdentityModel.Claims.ClaimsIdentity;first", "first@contoso.com"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentities().Schema, nil)
+	resourceData.Set("principal_names", []string{"first@contoso.com", "Second@contoso.com"})
+
+	err := dataSourceIdentitiesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+
+	identities := resourceData.Get("identities").([]interface{})
+	require.Len(t, identities, 2)
+	require.Equal(t, firstID.String(), identities[0].(map[string]interface{})["id"])
+	require.Equal(t, "Microsoft.IdentityModel.Claims.ClaimsIdentity;first", identities[0].(map[string]interface{})["descriptor"])
+	require.Equal(t, "Second@contoso.com", identities[1].(map[string]interface{})["principal_name"])
+	require.Equal(t, secondID.String(), identities[1].(map[string]interface{})["id"])
+}
+
+// verifies that principal names without identity are reported
+func TestIdentitiesDataSource_ReportsUnresolvedPrincipalNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{
+			testIdentityWithAccount(uuid.New(), "Microsoft.IdentityModel.Claims.ClaimsIdentity;first", "first@contoso.com"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentities().Schema, nil)
+	resourceData.Set("principal_names", []string{"first@contoso.com", "missing@contoso.com"})
+
+	err := dataSourceIdentitiesRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "missing@contoso.com")
+}
+
+// verifies that errors of the API are returned
+func TestIdentitiesDataSource_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ReadIdentities() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentities().Schema, nil)
+	resourceData.Set("principal_names", []string{"first@contoso.com"})
+
+	err := dataSourceIdentitiesRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ReadIdentities() Failed")
+}
+
+func testIdentityWithAccount(id uuid.UUID, descriptor string, account string) identity.Identity {
+	return identity.Identity{
+		Id:                  &id,
+		Descriptor:          converter.String(descriptor),
+		ProviderDisplayName: converter.String(account),
+		Properties: map[string]interface{}{
+			"Account": map[string]interface{}{
+				"$type":  "System.String",
+				"$value": account,
+			},
+		},
+	}
+}
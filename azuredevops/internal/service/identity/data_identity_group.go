@@ -2,12 +2,14 @@ package identity
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
 // DataIdentityGroup returns the schema and implementation for the group data source
@@ -29,6 +31,47 @@ func DataIdentityGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subject_descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"legacy_descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"include_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -50,17 +93,67 @@ func dataSourceIdentityGroupRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(" can not find group with name %s in project with ID %s", groupName, projectID)
 	}
 
-	// Set ID and descriptor for group data resource based on targetGroup output.
-	targetGroupID := targetGroup.Id.String()
-	d.SetId(targetGroupID)
-	d.Set("descriptor", targetGroup.Descriptor)
+	// The identity descriptor (e.g. Microsoft.TeamFoundation.Identity;S-1-9-...) is what the identity based APIs
+	// expect, the graph APIs call it the legacy descriptor and use the subject descriptor instead
+	if targetGroup.Id == nil {
+		return fmt.Errorf(" Group with name %s in project with ID %s does not contain an ID", groupName, projectID)
+	}
+	d.SetId(targetGroup.Id.String())
+	d.Set("descriptor", converter.ToString(targetGroup.Descriptor, ""))
+	d.Set("legacy_descriptor", converter.ToString(targetGroup.Descriptor, ""))
+	d.Set("subject_descriptor", converter.ToString(targetGroup.SubjectDescriptor, ""))
+
+	members := []interface{}{}
+	if d.Get("include_members").(bool) {
+		members, err = readIdentityGroupMemberDetails(clients, targetGroup.Id.String())
+		if err != nil {
+			return fmt.Errorf(" reading members of group %s: %v", groupName, err)
+		}
+	}
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf("Error setting `members`: %+v", err)
+	}
 	return nil
 }
 
+// Read the direct members of a group, sorted by descriptor to keep the list stable
+func readIdentityGroupMemberDetails(clients *client.AggregatedClient, groupID string) ([]interface{}, error) {
+	members := []interface{}{}
+	memberDescriptors, err := readIdentityGroupMembers(clients, groupID)
+	if err != nil || len(memberDescriptors) == 0 {
+		return members, err
+	}
+
+	descriptors := []string{}
+	for _, descriptor := range memberDescriptors {
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Strings(descriptors)
+	identities, err := readIdentitiesByDescriptors(clients, descriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range identities {
+		principalName := ""
+		if names := identityPrincipalNames(&item); len(names) > 0 {
+			principalName = names[0]
+		}
+		members = append(members, map[string]interface{}{
+			"id":                 item.Id.String(),
+			"descriptor":         converter.ToString(item.Descriptor, ""),
+			"subject_descriptor": converter.ToString(item.SubjectDescriptor, ""),
+			"principal_name":     principalName,
+			"display_name":       converter.ToString(item.ProviderDisplayName, ""),
+		})
+	}
+	return members, nil
+}
+
 // Select Group that match name to Provider Display Name
 func selectIdentityGroup(groups *[]identity.Identity, groupName string) *identity.Identity {
 	for _, group := range *groups {
-		if strings.EqualFold(*group.ProviderDisplayName, groupName) {
+		if strings.EqualFold(converter.ToString(group.ProviderDisplayName, ""), groupName) {
 			return &group
 		}
 	}
@@ -58,6 +58,95 @@ func TestIdentityGroupDataSource_ProjectDescriptorLookupErrorNotFound(t *testing
 	require.Contains(t, err.Error(), "Error getting groups")
 }
 
+func TestIdentityGroupDataSource_SetsDescriptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.NewString()
+	resourceData := createIdentityGroupDataSource(t, projectID, "[project]\\contributors")
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	groupID := uuid.New()
+	identityClient.
+		EXPECT().
+		ListGroups(clients.Ctx, identity.ListGroupsArgs{ScopeIds: &projectID}).
+		Return(&[]identity.Identity{
+			{
+				Id:                  &groupID,
+				Descriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1"),
+				SubjectDescriptor:   converter.String("vssgp.Uy0xLTktMTU1MTM3NDI0NS0x"),
+				ProviderDisplayName: converter.String("[project]\\Contributors"),
+			},
+		}, nil)
+
+	err := dataSourceIdentityGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, groupID.String(), resourceData.Id())
+	require.Equal(t, "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1", resourceData.Get("descriptor"))
+	require.Equal(t, "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1", resourceData.Get("legacy_descriptor"))
+	require.Equal(t, "vssgp.Uy0xLTktMTU1MTM3NDI0NS0x", resourceData.Get("subject_descriptor"))
+}
+
+func TestIdentityGroupDataSource_IncludesMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.NewString()
+	resourceData := createIdentityGroupDataSource(t, projectID, "[project]\\contributors")
+	resourceData.Set("include_members", true)
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	groupID := uuid.New()
+	memberID := uuid.New()
+	memberDescriptor := "Microsoft.IdentityModel.Claims.ClaimsIdentity;contoso.com\\user@contoso.com"
+	identityClient.
+		EXPECT().
+		ListGroups(clients.Ctx, identity.ListGroupsArgs{ScopeIds: &projectID}).
+		Return(&[]identity.Identity{
+			{
+				Id:                  &groupID,
+				Descriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1"),
+				ProviderDisplayName: converter.String("[project]\\Contributors"),
+			},
+		}, nil)
+	identityClient.
+		EXPECT().
+		ReadMembers(clients.Ctx, identity.ReadMembersArgs{
+			ContainerId:     converter.String(groupID.String()),
+			QueryMembership: &identity.QueryMembershipValues.Direct,
+		}).
+		Return(&[]string{memberDescriptor}, nil)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{Descriptors: converter.String(memberDescriptor)}).
+		Return(&[]identity.Identity{
+			{
+				Id:                  &memberID,
+				Descriptor:          converter.String(memberDescriptor),
+				SubjectDescriptor:   converter.String("aad.dXNlcg"),
+				ProviderDisplayName: converter.String("User"),
+				Properties: map[string]interface{}{
+					"Account": map[string]interface{}{"$type": "System.String", "$value": "user@contoso.com"},
+				},
+			},
+		}, nil)
+
+	err := dataSourceIdentityGroupRead(resourceData, clients)
+	require.Nil(t, err)
+
+	members := resourceData.Get("members").([]interface{})
+	require.Len(t, members, 1)
+	member := members[0].(map[string]interface{})
+	require.Equal(t, memberID.String(), member["id"])
+	require.Equal(t, memberDescriptor, member["descriptor"])
+	require.Equal(t, "aad.dXNlcg", member["subject_descriptor"])
+	require.Equal(t, "user@contoso.com", member["principal_name"])
+}
+
 func createIdentityGroupDataSource(t *testing.T, projectID string, groupName string) *schema.ResourceData {
 	resourceData := schema.TestResourceDataRaw(t, DataIdentityGroup().Schema, nil)
 	resourceData.Set("name", groupName)
@@ -2,6 +2,7 @@ package identity
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,11 @@ func DataIdentityGroups() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"groups": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -35,6 +41,10 @@ func DataIdentityGroups() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -52,6 +62,10 @@ func dataSourceIdentityGroupsRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(" failed to get groups for project with ID %s. Error: %v", projectID, err)
 	}
 
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		groups = filterIdentityGroupsByName(groups, regexp.MustCompile(nameRegex.(string)))
+	}
+
 	// With project groups flatten results
 	flattenedGroups, err := flattenIdentityGroups(&groups)
 	if err != nil {
@@ -64,17 +78,33 @@ func dataSourceIdentityGroupsRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-// Get Groups with Scope of Project ID
+// Get Groups with Scope of Project ID, or all groups of the organization if no project ID is given
 func getIdentityGroupsWithProjectID(clients *client.AggregatedClient, projectID string) ([]identity.Identity, error) {
-	response, err := clients.IdentityClient.ListGroups(clients.Ctx, identity.ListGroupsArgs{
-		ScopeIds: &projectID,
-	})
+	args := identity.ListGroupsArgs{}
+	if projectID != "" {
+		args.ScopeIds = &projectID
+	}
+	response, err := clients.IdentityClient.ListGroups(clients.Ctx, args)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting groups: %v", err)
 	}
+	if response == nil {
+		return []identity.Identity{}, nil
+	}
 	return *response, nil
 }
 
+// Select Groups whose Provider Display Name matches the regular expression
+func filterIdentityGroupsByName(groups []identity.Identity, nameRegex *regexp.Regexp) []identity.Identity {
+	result := []identity.Identity{}
+	for _, group := range groups {
+		if group.ProviderDisplayName != nil && nameRegex.MatchString(*group.ProviderDisplayName) {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
 // flatten function
 func flattenIdentityGroups(groups *[]identity.Identity) ([]interface{}, error) {
 	if groups == nil {
@@ -93,6 +123,9 @@ func flattenIdentityGroups(groups *[]identity.Identity) ([]interface{}, error) {
 		if group.ProviderDisplayName != nil {
 			groupMap["name"] = *group.ProviderDisplayName
 		}
+		if group.Descriptor != nil {
+			groupMap["descriptor"] = *group.Descriptor
+		}
 		results[i] = groupMap
 	}
 	return results, nil
@@ -58,6 +58,66 @@ func TestIdentityGroupsDataSource_DoesNotSwallowProjectDescriptorLookupError_Not
 	require.Contains(t, err.Error(), "Error getting group")
 }
 
+func TestIdentityGroupsDataSource_ListsOrganizationGroupsWithoutProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createIdentityGroupsDataSource(t, "")
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	groupID := uuid.New()
+	identityClient.
+		EXPECT().
+		ListGroups(clients.Ctx, identity.ListGroupsArgs{}).
+		Return(&[]identity.Identity{
+			{
+				Id:                  &groupID,
+				ProviderDisplayName: converter.String("[contoso]\\Project Collection Administrators"),
+				Descriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1"),
+			},
+		}, nil)
+
+	err := dataSourceIdentityGroupsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	groups := resourceData.Get("groups").(*schema.Set).List()
+	require.Len(t, groups, 1)
+	group := groups[0].(map[string]interface{})
+	require.Equal(t, groupID.String(), group["id"])
+	require.Equal(t, "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1", group["descriptor"])
+}
+
+func TestIdentityGroupsDataSource_FiltersGroupsByNameRegex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.NewString()
+	resourceData := createIdentityGroupsDataSource(t, projectID)
+	resourceData.Set("name_regex", "(?i)admin")
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	adminsID := uuid.New()
+	readersID := uuid.New()
+	identityClient.
+		EXPECT().
+		ListGroups(clients.Ctx, identity.ListGroupsArgs{ScopeIds: &projectID}).
+		Return(&[]identity.Identity{
+			{Id: &adminsID, ProviderDisplayName: converter.String("[project]\\Project Administrators")},
+			{Id: &readersID, ProviderDisplayName: converter.String("[project]\\Readers")},
+		}, nil)
+
+	err := dataSourceIdentityGroupsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	groups := resourceData.Get("groups").(*schema.Set).List()
+	require.Len(t, groups, 1)
+	require.Equal(t, adminsID.String(), groups[0].(map[string]interface{})["id"])
+}
+
 func createIdentityGroupsDataSource(t *testing.T, projectID string) *schema.ResourceData {
 	resourceData := schema.TestResourceDataRaw(t, DataIdentityGroups().Schema, nil)
 	if projectID != "" {
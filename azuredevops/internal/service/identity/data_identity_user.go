@@ -1,15 +1,20 @@
 package identity
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
+var errIdentityUserNotFound = errors.New("No user found")
+
 // DataIdentityUserResource returns the user data source resource
 func DataIdentityUser() *schema.Resource {
 	return &schema.Resource{
@@ -17,12 +22,22 @@ func DataIdentityUser() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				ExactlyOneOf: []string{"name", "descriptor", "origin_id"},
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"descriptor": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "descriptor", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"origin_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"name", "descriptor", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"search_filter": {
 				Type:         schema.TypeString,
@@ -36,24 +51,39 @@ func DataIdentityUser() *schema.Resource {
 
 func dataIdentitySourceUserRead(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
-	userName := d.Get("name").(string)
-	searchFilter := d.Get("search_filter").(string)
 
-	// Query ADO for list of identity user with filter
-	filterUser, err := getIdentityUsersWithFilterValue(clients, searchFilter, userName)
-	if err != nil {
-		return fmt.Errorf(" finding user with filter %s. Error: %v", searchFilter, err)
-	}
+	var targetUser *identity.Identity
+	var err error
+	if descriptor, ok := d.GetOk("descriptor"); ok {
+		targetUser, err = getIdentityUserByDescriptor(clients, descriptor.(string))
+		if err != nil {
+			return fmt.Errorf(" finding user with descriptor %s. Error: %v", descriptor, err)
+		}
+	} else if originID, ok := d.GetOk("origin_id"); ok {
+		targetUser, err = getIdentityUserByOriginID(clients, originID.(string))
+		if err != nil {
+			return fmt.Errorf(" finding user with origin ID %s. Error: %v", originID, err)
+		}
+	} else {
+		userName := d.Get("name").(string)
+		searchFilter := d.Get("search_filter").(string)
 
-	flattenUser, err := flattenIdentityUsers(filterUser)
-	if err != nil {
-		return fmt.Errorf("Error flatten user. Error: %v", err)
-	}
+		// Query ADO for list of identity user with filter
+		filterUser, err := getIdentityUsersWithFilterValue(clients, searchFilter, userName)
+		if err != nil {
+			return fmt.Errorf(" finding user with filter %s. Error: %v", searchFilter, err)
+		}
 
-	// Filter for the desired user in the FilterUsers results
-	targetUser := validateIdentityUser(flattenUser, userName, searchFilter)
-	if targetUser == nil {
-		return fmt.Errorf(" Could not find user with name %s with filter %s", userName, searchFilter)
+		flattenUser, err := flattenIdentityUsers(filterUser)
+		if err != nil {
+			return fmt.Errorf("Error flatten user. Error: %v", err)
+		}
+
+		// Filter for the desired user in the FilterUsers results
+		targetUser, err = validateIdentityUser(flattenUser, userName, searchFilter)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Set id and user list for users data resource
@@ -99,11 +129,107 @@ func flattenIdentityUsers(users *[]identity.Identity) (*[]identity.Identity, err
 }
 
 // Filter results to validate user is correct. Occurs post-flatten due to missing properties based on search-filter.
-func validateIdentityUser(users *[]identity.Identity, userName string, searchFilter string) *identity.Identity {
+// MailAddress and AccountName searches only return exact matches, General and DisplayName searches are matched by
+// display name. An exact display name match wins over partial matches, more than one candidate is reported as an error.
+func validateIdentityUser(users *[]identity.Identity, userName string, searchFilter string) (*identity.Identity, error) {
+	candidates := []identity.Identity{}
+	exactMatches := []identity.Identity{}
 	for _, user := range *users {
+		if searchFilter == "MailAddress" || searchFilter == "AccountName" {
+			candidates = append(candidates, user)
+			continue
+		}
+		if user.ProviderDisplayName == nil {
+			continue
+		}
+		if strings.EqualFold(*user.ProviderDisplayName, userName) {
+			exactMatches = append(exactMatches, user)
+		}
 		if strings.Contains(strings.ToLower(*user.ProviderDisplayName), strings.ToLower(userName)) {
-			return &user
+			candidates = append(candidates, user)
 		}
 	}
-	return nil
+	if len(exactMatches) > 0 {
+		candidates = exactMatches
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf(" Could not find user with name %s with filter %s", userName, searchFilter)
+	}
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf(" Found %d users with name %s with filter %s, use a more specific name, the descriptor or the origin ID: %s",
+			len(candidates), userName, searchFilter, describeIdentityUsers(candidates))
+	}
+	return &candidates[0], nil
+}
+
+func describeIdentityUsers(users []identity.Identity) string {
+	descriptions := []string{}
+	for _, user := range users {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", converter.ToString(user.ProviderDisplayName, ""), converter.ToString(user.Descriptor, "")))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// Read a single user by its identity descriptor (e.g. Microsoft.IdentityModel.Claims.ClaimsIdentity;...) or by its
+// subject descriptor (e.g. aad.xxx), which unlike identity descriptors does not contain a ";"
+func getIdentityUserByDescriptor(clients *client.AggregatedClient, descriptor string) (*identity.Identity, error) {
+	args := identity.ReadIdentitiesArgs{}
+	if strings.Contains(descriptor, ";") {
+		args.Descriptors = &descriptor
+	} else {
+		args.SubjectDescriptors = &descriptor
+	}
+	response, err := clients.IdentityClient.ReadIdentities(clients.Ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// unknown descriptors are returned as empty entries
+	users := []identity.Identity{}
+	if response != nil {
+		for _, user := range *response {
+			if user.Id != nil {
+				users = append(users, user)
+			}
+		}
+	}
+	if len(users) == 0 {
+		return nil, errIdentityUserNotFound
+	}
+	if len(users) > 1 {
+		return nil, fmt.Errorf("Found %d users: %s", len(users), describeIdentityUsers(users))
+	}
+	return &users[0], nil
+}
+
+// Find a user by the object ID of its AAD account. Neither the identity nor the graph API can search by origin ID, so
+// the AAD users of the organization are listed until the user is found.
+func getIdentityUserByOriginID(clients *client.AggregatedClient, originID string) (*identity.Identity, error) {
+	args := graph.ListUsersArgs{
+		SubjectTypes: &[]string{"aad"},
+	}
+	for {
+		response, err := clients.GraphClient.ListUsers(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			break
+		}
+
+		if response.GraphUsers != nil {
+			for _, user := range *response.GraphUsers {
+				if user.OriginId != nil && strings.EqualFold(*user.OriginId, originID) && user.Descriptor != nil {
+					return getIdentityUserByDescriptor(clients, *user.Descriptor)
+				}
+			}
+		}
+
+		if response.ContinuationToken == nil || len(*response.ContinuationToken) == 0 || (*response.ContinuationToken)[0] == "" {
+			break
+		}
+		args.ContinuationToken = &(*response.ContinuationToken)[0]
+	}
+	return nil, errIdentityUserNotFound
 }
@@ -13,10 +13,13 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,6 +76,136 @@ func TestErrorNotSwallowed(t *testing.T) {
 	require.Contains(t, err.Error(), "with filter "+searchFilter)
 }
 
+func TestIdentityUser_AmbiguousNameReturnsCandidates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userName := "Jane"
+	searchFilter := "General"
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	setUpMockReadIdentities(identityClient, clients.Ctx, userName, searchFilter, &[]identity.Identity{
+		{Id: converter.UUID(uuid.NewString()), ProviderDisplayName: converter.String("Jane Doe"), Descriptor: converter.String("descriptor-1")},
+		{Id: converter.UUID(uuid.NewString()), ProviderDisplayName: converter.String("Jane Roe"), Descriptor: converter.String("descriptor-2")},
+	}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentityUser().Schema, nil)
+	resourceData.Set("name", userName)
+
+	err := dataIdentitySourceUserRead(resourceData, clients)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Found 2 users with name Jane")
+	require.Contains(t, err.Error(), "Jane Doe (descriptor-1)")
+	require.Contains(t, err.Error(), "Jane Roe (descriptor-2)")
+}
+
+func TestIdentityUser_ExactNameMatchWins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userName := "jane doe"
+	searchFilter := "DisplayName"
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	id := uuid.New()
+	setUpMockReadIdentities(identityClient, clients.Ctx, userName, searchFilter, &[]identity.Identity{
+		{Id: converter.UUID(uuid.NewString()), ProviderDisplayName: converter.String("Jane Doe Jr."), Descriptor: converter.String("descriptor-1")},
+		{Id: &id, ProviderDisplayName: converter.String("Jane Doe"), Descriptor: converter.String("descriptor-2")},
+	}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentityUser().Schema, nil)
+	resourceData.Set("name", userName)
+	resourceData.Set("search_filter", searchFilter)
+
+	err := dataIdentitySourceUserRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "descriptor-2", resourceData.Get("descriptor"))
+}
+
+func TestIdentityUser_LookupBySubjectDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	id := uuid.New()
+	subjectDescriptor := "aad.NzQ5YjQ1NjgtNzc1Ny03NDBjLWE2OWMtZjE0YWE2ZTk0ODhi"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: &subjectDescriptor}).
+		Return(&[]identity.Identity{
+			{Id: &id, Descriptor: converter.String("Microsoft.IdentityModel.Claims.ClaimsIdentity;contoso\\jane@contoso.com")},
+		}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentityUser().Schema, nil)
+	resourceData.Set("descriptor", subjectDescriptor)
+
+	err := dataIdentitySourceUserRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "Microsoft.IdentityModel.Claims.ClaimsIdentity;contoso\\jane@contoso.com", resourceData.Get("descriptor"))
+}
+
+func TestIdentityUser_LookupByOriginID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient: identityClient,
+		GraphClient:    graphClient,
+		Ctx:            context.Background(),
+	}
+
+	subjectTypes := []string{"aad"}
+	continuationToken := "token"
+	gomock.InOrder(
+		graphClient.
+			EXPECT().
+			ListUsers(clients.Ctx, graph.ListUsersArgs{SubjectTypes: &subjectTypes}).
+			Return(&graph.PagedGraphUsers{
+				ContinuationToken: &[]string{continuationToken},
+				GraphUsers:        &[]graph.GraphUser{{OriginId: converter.String("other"), Descriptor: converter.String("aad.other")}},
+			}, nil),
+		graphClient.
+			EXPECT().
+			ListUsers(clients.Ctx, graph.ListUsersArgs{SubjectTypes: &subjectTypes, ContinuationToken: &continuationToken}).
+			Return(&graph.PagedGraphUsers{
+				GraphUsers: &[]graph.GraphUser{{OriginId: converter.String("object-id"), Descriptor: converter.String("aad.jane")}},
+			}, nil),
+	)
+
+	id := uuid.New()
+	subjectDescriptor := "aad.jane"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: &subjectDescriptor}).
+		Return(&[]identity.Identity{{Id: &id, Descriptor: converter.String("jane")}}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataIdentityUser().Schema, nil)
+	resourceData.Set("origin_id", "object-id")
+
+	err := dataIdentitySourceUserRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+}
+
 func setUpMockReadIdentities(identityClient *azdosdkmocks.MockIdentityClient, ctx context.Context, userName, searchFilter string, identities *[]identity.Identity, err error) {
 	expectedArgs := identity.ReadIdentitiesArgs{
 		FilterValue:  &userName,
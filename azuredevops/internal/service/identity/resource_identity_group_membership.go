@@ -0,0 +1,262 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// subjectDescriptorRegexp matches graph subject descriptors, which start with the subject type, e.g. aad.<id> or
+// vssgp.<id>, so that principal names like john.doe are not mistaken for descriptors
+var subjectDescriptorRegexp = regexp.MustCompile(`^(aad|aadgp|aadsp|msa|vss|vssgp|svc|s2s|imp|bnd|win|unauth|agg)\.[A-Za-z0-9_=-]+$`)
+
+// ResourceIdentityGroupMembership schema and implementation for the identity group membership resource. Unlike
+// azuredevops_group_membership it uses the identity API, which is also available on Azure DevOps Server.
+func ResourceIdentityGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityGroupMembershipCreateUpdate,
+		Read:   resourceIdentityGroupMembershipRead,
+		Update: resourceIdentityGroupMembershipCreateUpdate,
+		Delete: resourceIdentityGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIdentityGroupMembershipImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityGroupMembershipCreateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	groupID := d.Get("group_id").(string)
+
+	configuredMembers, err := resolveIdentityMembers(clients, tfhelper.ExpandStringSet(d.Get("members").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+	actualDescriptors, err := readIdentityGroupMembers(clients, groupID)
+	if err != nil {
+		return fmt.Errorf(" reading members of group %s: %v", groupID, err)
+	}
+
+	// members that are not part of the configuration are removed, the membership is authoritative
+	configuredDescriptors := map[string]bool{}
+	for _, member := range configuredMembers {
+		configuredDescriptors[strings.ToLower(*member.Descriptor)] = true
+	}
+	unmanagedDescriptors := []string{}
+	for key, descriptor := range actualDescriptors {
+		if !configuredDescriptors[key] {
+			unmanagedDescriptors = append(unmanagedDescriptors, descriptor)
+		}
+	}
+	if len(unmanagedDescriptors) > 0 {
+		sort.Strings(unmanagedDescriptors)
+		unmanagedMembers, err := readIdentitiesByDescriptors(clients, unmanagedDescriptors)
+		if err != nil {
+			return fmt.Errorf(" reading members of group %s: %v", groupID, err)
+		}
+		if err := removeIdentityGroupMembers(clients, groupID, unmanagedMembers); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range configuredMembers {
+		if _, ok := actualDescriptors[strings.ToLower(*member.Descriptor)]; ok {
+			continue
+		}
+		memberID := member.Id.String()
+		if _, err := clients.IdentityClient.AddMember(clients.Ctx, identity.AddMemberArgs{
+			ContainerId: &groupID,
+			MemberId:    &memberID,
+		}); err != nil {
+			return fmt.Errorf(" adding member %s to group %s: %v", *member.Descriptor, groupID, err)
+		}
+	}
+
+	d.SetId(groupID)
+	return resourceIdentityGroupMembershipRead(d, m)
+}
+
+func resourceIdentityGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	groupID := d.Id()
+
+	actualDescriptors, err := readIdentityGroupMembers(clients, groupID)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading members of group %s: %v", groupID, err)
+	}
+
+	// configured members keep their configured form (descriptor or principal name) as long as they are members,
+	// any other member of the group is added by its descriptor so that it is removed on the next apply
+	members := []string{}
+	for _, member := range tfhelper.ExpandStringSet(d.Get("members").(*schema.Set)) {
+		resolved, err := resolveIdentityMember(clients, member)
+		if err != nil {
+			// members that no longer exist, e.g. deleted users, are dropped so that they show up as drift
+			if errors.Is(err, errIdentityUserNotFound) {
+				continue
+			}
+			return err
+		}
+		key := strings.ToLower(*resolved.Descriptor)
+		if _, ok := actualDescriptors[key]; ok {
+			members = append(members, member)
+			delete(actualDescriptors, key)
+		}
+	}
+	for _, descriptor := range actualDescriptors {
+		members = append(members, descriptor)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("members", members)
+	return nil
+}
+
+func resourceIdentityGroupMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	groupID := d.Id()
+
+	members, err := resolveIdentityMembers(clients, tfhelper.ExpandStringSet(d.Get("members").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+	if err := removeIdentityGroupMembers(clients, groupID, members); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIdentityGroupMembershipImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := uuid.Parse(d.Id()); err != nil {
+		return nil, fmt.Errorf(" Identity group membership ID must be the ID of the group. Error: %v", err)
+	}
+	d.Set("group_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// Read the descriptors of the direct members of the group, keyed by the lower-cased descriptor since descriptors are
+// case insensitive
+func readIdentityGroupMembers(clients *client.AggregatedClient, groupID string) (map[string]string, error) {
+	response, err := clients.IdentityClient.ReadMembers(clients.Ctx, identity.ReadMembersArgs{
+		ContainerId:     &groupID,
+		QueryMembership: &identity.QueryMembershipValues.Direct,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	descriptors := map[string]string{}
+	if response != nil {
+		for _, descriptor := range *response {
+			descriptors[strings.ToLower(descriptor)] = descriptor
+		}
+	}
+	return descriptors, nil
+}
+
+func removeIdentityGroupMembers(clients *client.AggregatedClient, groupID string, members []identity.Identity) error {
+	for _, member := range members {
+		memberID := member.Id.String()
+		_, err := clients.IdentityClient.RemoveMember(clients.Ctx, identity.RemoveMemberArgs{
+			ContainerId: &groupID,
+			MemberId:    &memberID,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" removing member %s from group %s: %v", memberID, groupID, err)
+		}
+	}
+	return nil
+}
+
+func readIdentitiesByDescriptors(clients *client.AggregatedClient, descriptors []string) ([]identity.Identity, error) {
+	joined := strings.Join(descriptors, ",")
+	response, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		Descriptors: &joined,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	identities := []identity.Identity{}
+	if response != nil {
+		for _, item := range *response {
+			if item.Id != nil {
+				identities = append(identities, item)
+			}
+		}
+	}
+	return identities, nil
+}
+
+func resolveIdentityMembers(clients *client.AggregatedClient, members []string) ([]identity.Identity, error) {
+	identities := []identity.Identity{}
+	for _, member := range members {
+		resolved, err := resolveIdentityMember(clients, member)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, *resolved)
+	}
+	return identities, nil
+}
+
+// Resolve a member given by identity descriptor, subject descriptor or principal name to its identity
+func resolveIdentityMember(clients *client.AggregatedClient, member string) (*identity.Identity, error) {
+	if strings.Contains(member, ";") || subjectDescriptorRegexp.MatchString(member) {
+		resolved, err := getIdentityUserByDescriptor(clients, member)
+		if err != nil {
+			return nil, fmt.Errorf(" finding member with descriptor %s. Error: %w", member, err)
+		}
+		if resolved.Descriptor == nil {
+			return nil, fmt.Errorf(" Identity of member %s does not contain a descriptor", member)
+		}
+		return resolved, nil
+	}
+
+	response, err := getIdentityUsersWithFilterValue(clients, "General", member)
+	if err != nil {
+		return nil, fmt.Errorf(" finding member %s. Error: %v", member, err)
+	}
+	if response == nil || len(*response) == 0 {
+		return nil, fmt.Errorf(" Could not find member %s: %w", member, errIdentityUserNotFound)
+	}
+	if len(*response) > 1 {
+		return nil, fmt.Errorf(" Found %d identities for member %s, use the descriptor instead: %s", len(*response), member, describeIdentityUsers(*response))
+	}
+	if (*response)[0].Id == nil || (*response)[0].Descriptor == nil {
+		return nil, fmt.Errorf(" Identity of member %s does not contain an ID or descriptor", member)
+	}
+	return &(*response)[0], nil
+}
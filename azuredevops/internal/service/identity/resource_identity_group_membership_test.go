@@ -0,0 +1,181 @@
+//go:build (all || resource_identity_group_membership) && !exclude_resource_identity_group_membership
+// +build all resource_identity_group_membership
+// +build !exclude_resource_identity_group_membership
+
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGroupID            = uuid.New()
+	testKeptMemberID       = uuid.New()
+	testAddedMemberID      = uuid.New()
+	testRemovedMemberID    = uuid.New()
+	testKeptDescriptor     = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1"
+	testAddedDescriptor    = "Microsoft.IdentityModel.Claims.ClaimsIdentity;contoso\\jane@contoso.com"
+	testRemovedDescriptor  = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-2"
+	testAddedPrincipalName = "jane@contoso.com"
+)
+
+func setUpIdentityMemberResolution(identityClient *azdosdkmocks.MockIdentityClient, ctx context.Context) {
+	keptDescriptor := testKeptDescriptor
+	identityClient.
+		EXPECT().
+		ReadIdentities(ctx, identity.ReadIdentitiesArgs{Descriptors: &keptDescriptor}).
+		Return(&[]identity.Identity{{Id: &testKeptMemberID, Descriptor: &keptDescriptor}}, nil).
+		AnyTimes()
+	identityClient.
+		EXPECT().
+		ReadIdentities(ctx, identity.ReadIdentitiesArgs{SearchFilter: converter.String("General"), FilterValue: &testAddedPrincipalName}).
+		Return(&[]identity.Identity{{Id: &testAddedMemberID, Descriptor: &testAddedDescriptor}}, nil).
+		AnyTimes()
+}
+
+// verifies that configured members are added and members that are not configured are removed
+func TestIdentityGroupMembership_Create_ConvergesMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+	setUpIdentityMemberResolution(identityClient, clients.Ctx)
+
+	groupID := testGroupID.String()
+	gomock.InOrder(
+		identityClient.
+			EXPECT().
+			ReadMembers(clients.Ctx, identity.ReadMembersArgs{ContainerId: &groupID, QueryMembership: &identity.QueryMembershipValues.Direct}).
+			Return(&[]string{testKeptDescriptor, testRemovedDescriptor}, nil).
+			Times(1),
+		identityClient.
+			EXPECT().
+			ReadMembers(clients.Ctx, gomock.Any()).
+			Return(&[]string{testKeptDescriptor, testAddedDescriptor}, nil).
+			Times(1),
+	)
+
+	removedDescriptor := testRemovedDescriptor
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{Descriptors: &removedDescriptor}).
+		Return(&[]identity.Identity{{Id: &testRemovedMemberID, Descriptor: &removedDescriptor}}, nil).
+		Times(1)
+	removedMemberID := testRemovedMemberID.String()
+	identityClient.
+		EXPECT().
+		RemoveMember(clients.Ctx, identity.RemoveMemberArgs{ContainerId: &groupID, MemberId: &removedMemberID}).
+		Return(converter.Bool(true), nil).
+		Times(1)
+	addedMemberID := testAddedMemberID.String()
+	identityClient.
+		EXPECT().
+		AddMember(clients.Ctx, identity.AddMemberArgs{ContainerId: &groupID, MemberId: &addedMemberID}).
+		Return(converter.Bool(true), nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceIdentityGroupMembership().Schema, nil)
+	resourceData.Set("group_id", groupID)
+	resourceData.Set("members", []string{testKeptDescriptor, testAddedPrincipalName})
+
+	err := resourceIdentityGroupMembershipCreateUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, groupID, resourceData.Id())
+
+	members := resourceData.Get("members").(*schema.Set)
+	require.Equal(t, 2, members.Len())
+	require.True(t, members.Contains(testKeptDescriptor))
+	require.True(t, members.Contains(testAddedPrincipalName))
+}
+
+// verifies that members added outside of Terraform are read by their descriptor and missing members are dropped
+func TestIdentityGroupMembership_Read_DetectsDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+	setUpIdentityMemberResolution(identityClient, clients.Ctx)
+
+	identityClient.
+		EXPECT().
+		ReadMembers(clients.Ctx, gomock.Any()).
+		Return(&[]string{testKeptDescriptor, testRemovedDescriptor}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceIdentityGroupMembership().Schema, nil)
+	resourceData.SetId(testGroupID.String())
+	resourceData.Set("members", []string{testKeptDescriptor, testAddedPrincipalName})
+
+	err := resourceIdentityGroupMembershipRead(resourceData, clients)
+	require.Nil(t, err)
+
+	members := resourceData.Get("members").(*schema.Set)
+	require.Equal(t, 2, members.Len())
+	require.True(t, members.Contains(testKeptDescriptor))
+	require.True(t, members.Contains(testRemovedDescriptor))
+}
+
+// verifies that only the ID of a group can be imported
+func TestIdentityGroupMembership_Import_RequiresGroupID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceIdentityGroupMembership().Schema, nil)
+	resourceData.SetId("[contoso]\\Developers")
+
+	_, err := resourceIdentityGroupMembershipImport(resourceData, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be the ID of the group")
+}
+
+// verifies that a configured member which no longer exists is dropped instead of failing the refresh
+func TestIdentityGroupMembership_Read_SkipsDeletedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+	setUpIdentityMemberResolution(identityClient, clients.Ctx)
+
+	deletedPrincipalName := "deleted@contoso.com"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SearchFilter: converter.String("General"), FilterValue: &deletedPrincipalName}).
+		Return(&[]identity.Identity{}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadMembers(clients.Ctx, gomock.Any()).
+		Return(&[]string{testKeptDescriptor}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceIdentityGroupMembership().Schema, nil)
+	resourceData.SetId(testGroupID.String())
+	resourceData.Set("members", []string{testKeptDescriptor, deletedPrincipalName})
+
+	err := resourceIdentityGroupMembershipRead(resourceData, clients)
+	require.Nil(t, err)
+
+	members := resourceData.Get("members").(*schema.Set)
+	require.Equal(t, 1, members.Len())
+	require.True(t, members.Contains(testKeptDescriptor))
+}
+
+// verifies that only values starting with a known subject type are treated as subject descriptors
+func TestIdentityGroupMembership_SubjectDescriptorRegexp(t *testing.T) {
+	for _, descriptor := range []string{"aad.ZjQ1YzI4ZmYtYmU5Yi03ZjM1", "vssgp.Uy0xLTktMTU1MTM3NDI0NS0x", "aadgp.Uy0xLTktMTU1MTM3NDI0NS0x", "msa.ZjQ1YzI4ZmY"} {
+		require.True(t, subjectDescriptorRegexp.MatchString(descriptor), descriptor)
+	}
+	for _, name := range []string{"john.doe", "jane.doe@contoso.com", "contoso.developers", "[contoso]\\Developers"} {
+		require.False(t, subjectDescriptorRegexp.MatchString(name), name)
+	}
+}
@@ -0,0 +1,122 @@
+package memberentitlementmanagement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGroupEntitlement schema and implementation for group entitlement data source
+func DataGroupEntitlement() *schema.Resource {
+	return &schema.Resource{
+		Read: dataGroupEntitlementRead,
+		Schema: map[string]*schema.Schema{
+			"principal_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"principal_name", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"origin_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"principal_name", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"origin": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"principal_name"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_license_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"licensing_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataGroupEntitlementRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	var groupEntitlement *memberentitlementmanagement.GroupEntitlement
+	if principalName, ok := d.GetOk("principal_name"); ok {
+		id, err := readEntitlementIDByPrincipalName(clients, principalName.(string))
+		if err != nil {
+			return fmt.Errorf(" finding group entitlement for principal name %s: %v", principalName, err)
+		}
+		groupEntitlement, err = clients.MemberEntitleManagementClient.GetGroupEntitlement(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementArgs{
+			GroupId: id,
+		})
+		if err != nil {
+			return fmt.Errorf(" reading group entitlement for principal name %s: %v", principalName, err)
+		}
+	} else {
+		originID := d.Get("origin_id").(string)
+		groupEntitlements, err := clients.MemberEntitleManagementClient.GetGroupEntitlements(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementsArgs{})
+		if err != nil {
+			return fmt.Errorf(" reading group entitlements: %v", err)
+		}
+		groupEntitlement = findGroupEntitlementByOriginID(groupEntitlements, originID, d.Get("origin").(string))
+		if groupEntitlement == nil {
+			return fmt.Errorf(" No group entitlement found for origin ID %s", originID)
+		}
+	}
+
+	if groupEntitlement == nil || groupEntitlement.Id == nil || groupEntitlement.Group == nil {
+		return fmt.Errorf(" Group entitlement not found")
+	}
+
+	d.SetId(groupEntitlement.Id.String())
+	d.Set("principal_name", converter.ToString(groupEntitlement.Group.PrincipalName, ""))
+	d.Set("origin_id", converter.ToString(groupEntitlement.Group.OriginId, ""))
+	d.Set("origin", converter.ToString(groupEntitlement.Group.Origin, ""))
+	d.Set("display_name", converter.ToString(groupEntitlement.Group.DisplayName, ""))
+	d.Set("descriptor", converter.ToString(groupEntitlement.Group.Descriptor, ""))
+	if licenseRule := groupEntitlement.LicenseRule; licenseRule != nil {
+		if licenseRule.AccountLicenseType != nil {
+			d.Set("account_license_type", string(*licenseRule.AccountLicenseType))
+		}
+		if licenseRule.LicensingSource != nil {
+			d.Set("licensing_source", string(*licenseRule.LicensingSource))
+		}
+	}
+	return nil
+}
+
+func findGroupEntitlementByOriginID(groupEntitlements *[]memberentitlementmanagement.GroupEntitlement, originID string, origin string) *memberentitlementmanagement.GroupEntitlement {
+	if groupEntitlements == nil {
+		return nil
+	}
+	for _, groupEntitlement := range *groupEntitlements {
+		if groupEntitlement.Group == nil || !strings.EqualFold(converter.ToString(groupEntitlement.Group.OriginId, ""), originID) {
+			continue
+		}
+		if origin != "" && !strings.EqualFold(converter.ToString(groupEntitlement.Group.Origin, ""), origin) {
+			continue
+		}
+		return &groupEntitlement
+	}
+	return nil
+}
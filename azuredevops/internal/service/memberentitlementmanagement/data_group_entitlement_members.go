@@ -0,0 +1,142 @@
+package memberentitlementmanagement
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+)
+
+// DataGroupEntitlementMembers schema and implementation for the group entitlement members data source
+func DataGroupEntitlementMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataGroupEntitlementMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group_entitlement_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"licensing_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assignment_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataGroupEntitlementMembersRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	groupEntitlementID := d.Get("group_entitlement_id").(string)
+	id, err := uuid.Parse(groupEntitlementID)
+	if err != nil {
+		return fmt.Errorf("Error parsing GroupEntitlementID: %s. %v", groupEntitlementID, err)
+	}
+
+	args := memberentitlementmanagementextras.GetGroupMembersArgs{
+		GroupId: &id,
+	}
+	members := []interface{}{}
+	for {
+		page, err := clients.MemberEntitleManagementClientExtras.GetGroupMembers(clients.Ctx, args)
+		if err != nil {
+			return fmt.Errorf(" reading members of group entitlement %s: %v", groupEntitlementID, err)
+		}
+		if page == nil {
+			break
+		}
+		if page.Members != nil {
+			members = append(members, flattenGroupEntitlementMembers(page.Members)...)
+		}
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			break
+		}
+		args.PagingToken = page.ContinuationToken
+	}
+
+	d.SetId(id.String())
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf(" setting members of group entitlement %s: %v", groupEntitlementID, err)
+	}
+	return nil
+}
+
+func flattenGroupEntitlementMembers(userEntitlements *[]memberentitlementmanagement.UserEntitlement) []interface{} {
+	members := make([]interface{}, 0, len(*userEntitlements))
+	for _, userEntitlement := range *userEntitlements {
+		member := map[string]interface{}{}
+		if userEntitlement.Id != nil {
+			member["id"] = userEntitlement.Id.String()
+		}
+		if user := userEntitlement.User; user != nil {
+			member["descriptor"] = converter.ToString(user.Descriptor, "")
+			member["principal_name"] = converter.ToString(user.PrincipalName, "")
+			member["display_name"] = converter.ToString(user.DisplayName, "")
+			member["origin"] = converter.ToString(user.Origin, "")
+			member["origin_id"] = converter.ToString(user.OriginId, "")
+		}
+		if accessLevel := userEntitlement.AccessLevel; accessLevel != nil {
+			if accessLevel.AccountLicenseType != nil {
+				member["account_license_type"] = string(*accessLevel.AccountLicenseType)
+			}
+			member["license_display_name"] = converter.ToString(accessLevel.LicenseDisplayName, "")
+			if accessLevel.LicensingSource != nil {
+				member["licensing_source"] = string(*accessLevel.LicensingSource)
+			}
+			if accessLevel.AssignmentSource != nil {
+				member["assignment_source"] = string(*accessLevel.AssignmentSource)
+			}
+		}
+		members = append(members, member)
+	}
+	return members
+}
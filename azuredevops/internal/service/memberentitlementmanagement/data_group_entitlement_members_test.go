@@ -0,0 +1,153 @@
+//go:build (all || data_sources || data_group_entitlement_members) && (!exclude_data_sources || !exclude_data_group_entitlement_members)
+// +build all data_sources data_group_entitlement_members
+// +build !exclude_data_sources !exclude_data_group_entitlement_members
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the members of a group entitlement are returned with their effective license
+func TestDataGroupEntitlementMembers_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: memberEntitlementClient,
+		Ctx:                                 context.Background(),
+	}
+
+	groupEntitlementID := uuid.New()
+	userID := uuid.New()
+	accountLicenseType := licensing.AccountLicenseTypeValues.Express
+	licensingSource := licensing.LicensingSourceValues.Account
+	assignmentSource := licensing.AssignmentSourceValues.GroupRule
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupMembers(clients.Ctx, memberentitlementmanagementextras.GetGroupMembersArgs{GroupId: &groupEntitlementID}).
+		Return(&memberentitlementmanagementextras.PagedGraphMemberList{
+			Members: &[]memberentitlementmanagement.UserEntitlement{
+				{
+					Id: &userID,
+					User: &graph.GraphUser{
+						Descriptor:    converter.String("aad.descriptor"),
+						PrincipalName: converter.String("user@contoso.com"),
+						DisplayName:   converter.String("User"),
+						Origin:        converter.String("aad"),
+						OriginId:      converter.String("origin-id"),
+					},
+					AccessLevel: &licensing.AccessLevel{
+						AccountLicenseType: &accountLicenseType,
+						LicenseDisplayName: converter.String("Basic"),
+						LicensingSource:    &licensingSource,
+						AssignmentSource:   &assignmentSource,
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlementMembers().Schema, nil)
+	resourceData.Set("group_entitlement_id", groupEntitlementID.String())
+
+	err := dataGroupEntitlementMembersRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, groupEntitlementID.String(), resourceData.Id())
+
+	members := resourceData.Get("members").([]interface{})
+	require.Len(t, members, 1)
+	member := members[0].(map[string]interface{})
+	require.Equal(t, userID.String(), member["id"])
+	require.Equal(t, "user@contoso.com", member["principal_name"])
+	require.Equal(t, "express", member["account_license_type"])
+	require.Equal(t, "Basic", member["license_display_name"])
+	require.Equal(t, "account", member["licensing_source"])
+	require.Equal(t, "groupRule", member["assignment_source"])
+}
+
+// verifies that all pages of the members are read
+func TestDataGroupEntitlementMembers_Read_FollowsPagingToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: memberEntitlementClient,
+		Ctx:                                 context.Background(),
+	}
+
+	groupEntitlementID := uuid.New()
+	firstUserID := uuid.New()
+	secondUserID := uuid.New()
+	gomock.InOrder(
+		memberEntitlementClient.
+			EXPECT().
+			GetGroupMembers(clients.Ctx, memberentitlementmanagementextras.GetGroupMembersArgs{GroupId: &groupEntitlementID}).
+			Return(&memberentitlementmanagementextras.PagedGraphMemberList{
+				ContinuationToken: converter.String("next-page"),
+				Members:           &[]memberentitlementmanagement.UserEntitlement{{Id: &firstUserID}},
+			}, nil),
+		memberEntitlementClient.
+			EXPECT().
+			GetGroupMembers(clients.Ctx, memberentitlementmanagementextras.GetGroupMembersArgs{
+				GroupId:     &groupEntitlementID,
+				PagingToken: converter.String("next-page"),
+			}).
+			Return(&memberentitlementmanagementextras.PagedGraphMemberList{
+				Members: &[]memberentitlementmanagement.UserEntitlement{{Id: &secondUserID}},
+			}, nil),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlementMembers().Schema, nil)
+	resourceData.Set("group_entitlement_id", groupEntitlementID.String())
+
+	err := dataGroupEntitlementMembersRead(resourceData, clients)
+	require.Nil(t, err)
+
+	members := resourceData.Get("members").([]interface{})
+	require.Len(t, members, 2)
+	require.Equal(t, firstUserID.String(), members[0].(map[string]interface{})["id"])
+	require.Equal(t, secondUserID.String(), members[1].(map[string]interface{})["id"])
+}
+
+// verifies that an error reading the members is not swallowed
+func TestDataGroupEntitlementMembers_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: memberEntitlementClient,
+		Ctx:                                 context.Background(),
+	}
+
+	groupEntitlementID := uuid.New()
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupMembers(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetGroupMembers() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlementMembers().Schema, nil)
+	resourceData.Set("group_entitlement_id", groupEntitlementID.String())
+
+	err := dataGroupEntitlementMembersRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetGroupMembers() Failed")
+}
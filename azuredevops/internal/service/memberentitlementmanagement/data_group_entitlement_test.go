@@ -0,0 +1,133 @@
+//go:build (all || data_sources || data_group_entitlement) && (!exclude_data_sources || !exclude_data_group_entitlement)
+// +build all data_sources data_group_entitlement
+// +build !exclude_data_sources !exclude_data_group_entitlement
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a group entitlement is resolved through the identity search of its principal name
+func TestDataGroupEntitlement_Read_ByPrincipalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient:                identityClient,
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	principalName := "[contoso]\\Developers"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{{Id: &id}}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlement(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementArgs{GroupId: &id}).
+		Return(testDataGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", "origin-id", principalName, "Developers", "aadgp.descriptor"), nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlement().Schema, nil)
+	resourceData.Set("principal_name", principalName)
+
+	err := dataGroupEntitlementRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "express", resourceData.Get("account_license_type"))
+	require.Equal(t, "aadgp.descriptor", resourceData.Get("descriptor"))
+	require.Equal(t, "Developers", resourceData.Get("display_name"))
+}
+
+// verifies that a group entitlement is found by its origin ID
+func TestDataGroupEntitlement_Read_ByOriginID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	otherID := uuid.New()
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlements(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementsArgs{}).
+		Return(&[]memberentitlementmanagement.GroupEntitlement{
+			*testDataGroupEntitlement(&otherID, licensing.AccountLicenseTypeValues.Stakeholder, "aad", "other-origin-id", "[contoso]\\Readers", "Readers", "other"),
+			*testDataGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", "origin-id", "[contoso]\\Developers", "Developers", "aadgp.descriptor"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlement().Schema, nil)
+	resourceData.Set("origin_id", "origin-id")
+
+	err := dataGroupEntitlementRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "[contoso]\\Developers", resourceData.Get("principal_name"))
+}
+
+// verifies that an error reading the group entitlements is returned
+func TestDataGroupEntitlement_Read_ReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlements(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetGroupEntitlements() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGroupEntitlement().Schema, nil)
+	resourceData.Set("origin_id", "origin-id")
+
+	err := dataGroupEntitlementRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetGroupEntitlements() Failed")
+}
+
+func testDataGroupEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, displayName string, descriptor string) *memberentitlementmanagement.GroupEntitlement {
+	licensingSource := licensing.LicensingSourceValues.Account
+	return &memberentitlementmanagement.GroupEntitlement{
+		Id: id,
+		Group: &graph.GraphGroup{
+			Origin:        &origin,
+			OriginId:      &originID,
+			PrincipalName: &principalName,
+			DisplayName:   &displayName,
+			Descriptor:    &descriptor,
+		},
+		LicenseRule: &licensing.AccessLevel{
+			AccountLicenseType: &accountLicenseType,
+			LicensingSource:    &licensingSource,
+		},
+	}
+}
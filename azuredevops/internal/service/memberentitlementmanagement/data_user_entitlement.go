@@ -0,0 +1,137 @@
+package memberentitlementmanagement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+)
+
+// DataUserEntitlement schema and implementation for user entitlement data source
+func DataUserEntitlement() *schema.Resource {
+	return &schema.Resource{
+		Read: dataUserEntitlementRead,
+		Schema: map[string]*schema.Schema{
+			"principal_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"principal_name", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"origin_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"principal_name", "origin_id"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"origin": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"principal_name"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_license_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"licensing_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataUserEntitlementRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	var userEntitlement *memberentitlementmanagement.UserEntitlement
+	if principalName, ok := d.GetOk("principal_name"); ok {
+		id, err := readEntitlementIDByPrincipalName(clients, principalName.(string))
+		if err != nil {
+			return fmt.Errorf(" finding user entitlement for principal name %s: %v", principalName, err)
+		}
+		userEntitlement, err = readUserEntitlement(clients, id)
+		if err != nil {
+			return fmt.Errorf(" reading user entitlement for principal name %s: %v", principalName, err)
+		}
+	} else {
+		originID := d.Get("origin_id").(string)
+		var err error
+		userEntitlement, err = findUserEntitlementByOriginID(clients, originID, d.Get("origin").(string))
+		if err != nil {
+			return fmt.Errorf(" finding user entitlement for origin ID %s: %v", originID, err)
+		}
+		if userEntitlement == nil {
+			return fmt.Errorf(" No user entitlement found for origin ID %s", originID)
+		}
+	}
+
+	if userEntitlement == nil || userEntitlement.Id == nil || userEntitlement.User == nil {
+		return fmt.Errorf(" User entitlement not found")
+	}
+
+	d.SetId(userEntitlement.Id.String())
+	d.Set("principal_name", converter.ToString(userEntitlement.User.PrincipalName, ""))
+	d.Set("origin_id", converter.ToString(userEntitlement.User.OriginId, ""))
+	d.Set("origin", converter.ToString(userEntitlement.User.Origin, ""))
+	d.Set("display_name", converter.ToString(userEntitlement.User.DisplayName, ""))
+	d.Set("descriptor", converter.ToString(userEntitlement.User.Descriptor, ""))
+	if accessLevel := userEntitlement.AccessLevel; accessLevel != nil {
+		if accessLevel.AccountLicenseType != nil {
+			d.Set("account_license_type", string(*accessLevel.AccountLicenseType))
+		}
+		if accessLevel.LicensingSource != nil {
+			d.Set("licensing_source", string(*accessLevel.LicensingSource))
+		}
+	}
+	return nil
+}
+
+// findUserEntitlementByOriginID pages through the user entitlements, as neither the identity nor the user entitlement
+// search can filter by origin ID
+func findUserEntitlementByOriginID(clients *client.AggregatedClient, originID string, origin string) (*memberentitlementmanagement.UserEntitlement, error) {
+	args := memberentitlementmanagementextras.SearchUserEntitlementsArgs{}
+	for {
+		page, err := clients.MemberEntitleManagementClientExtras.SearchUserEntitlements(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			return nil, nil
+		}
+
+		if page.Members != nil {
+			for _, userEntitlement := range *page.Members {
+				if userEntitlement.User == nil || !strings.EqualFold(converter.ToString(userEntitlement.User.OriginId, ""), originID) {
+					continue
+				}
+				if origin != "" && !strings.EqualFold(converter.ToString(userEntitlement.User.Origin, ""), origin) {
+					continue
+				}
+				return &userEntitlement, nil
+			}
+		}
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			return nil, nil
+		}
+		args.ContinuationToken = page.ContinuationToken
+	}
+}
@@ -0,0 +1,155 @@
+//go:build (all || data_sources || data_user_entitlement) && (!exclude_data_sources || !exclude_data_user_entitlement)
+// +build all data_sources data_user_entitlement
+// +build !exclude_data_sources !exclude_data_user_entitlement
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a user entitlement is resolved through the identity search of its principal name
+func TestDataUserEntitlement_Read_ByPrincipalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient:                identityClient,
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	principalName := "foobar@microsoft.com"
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SearchFilter: converter.String("General"),
+			FilterValue:  &principalName,
+		}).
+		Return(&[]identity.Identity{{Id: &id}}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.Ctx, memberentitlementmanagement.GetUserEntitlementArgs{UserId: &id}).
+		Return(testDataUserEntitlement(&id, licensing.AccountLicenseTypeValues.Stakeholder, "aad", "origin-id", principalName, "aad.descriptor"), nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlement().Schema, nil)
+	resourceData.Set("principal_name", principalName)
+
+	err := dataUserEntitlementRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "stakeholder", resourceData.Get("account_license_type"))
+	require.Equal(t, "account", resourceData.Get("licensing_source"))
+	require.Equal(t, "aad.descriptor", resourceData.Get("descriptor"))
+	require.Equal(t, "origin-id", resourceData.Get("origin_id"))
+}
+
+// verifies that a user entitlement is found by origin ID on a later page of the user entitlement search
+func TestDataUserEntitlement_Read_ByOriginID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: extrasClient,
+		Ctx:                                 context.Background(),
+	}
+
+	id := uuid.New()
+	otherID := uuid.New()
+	gomock.InOrder(
+		extrasClient.
+			EXPECT().
+			SearchUserEntitlements(clients.Ctx, memberentitlementmanagementextras.SearchUserEntitlementsArgs{}).
+			Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+				ContinuationToken: converter.String("token"),
+				Members: &[]memberentitlementmanagement.UserEntitlement{
+					*testDataUserEntitlement(&otherID, licensing.AccountLicenseTypeValues.Express, "aad", "other-origin-id", "other@microsoft.com", "other"),
+				},
+			}, nil).
+			Times(1),
+		extrasClient.
+			EXPECT().
+			SearchUserEntitlements(clients.Ctx, memberentitlementmanagementextras.SearchUserEntitlementsArgs{ContinuationToken: converter.String("token")}).
+			Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+				Members: &[]memberentitlementmanagement.UserEntitlement{
+					*testDataUserEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", "origin-id", "foobar@microsoft.com", "aad.descriptor"),
+				},
+			}, nil).
+			Times(1),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlement().Schema, nil)
+	resourceData.Set("origin_id", "origin-id")
+	resourceData.Set("origin", "aad")
+
+	err := dataUserEntitlementRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "foobar@microsoft.com", resourceData.Get("principal_name"))
+}
+
+// verifies that an error is returned if no user entitlement has the origin ID
+func TestDataUserEntitlement_Read_ByOriginID_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: extrasClient,
+		Ctx:                                 context.Background(),
+	}
+
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+			Members: &[]memberentitlementmanagement.UserEntitlement{
+				{Id: converter.UUID(uuid.New().String()), User: &graph.GraphUser{OriginId: converter.String("other-origin-id")}},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlement().Schema, nil)
+	resourceData.Set("origin_id", "origin-id")
+
+	err := dataUserEntitlementRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "No user entitlement found for origin ID origin-id")
+}
+
+func testDataUserEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, descriptor string) *memberentitlementmanagement.UserEntitlement {
+	licensingSource := licensing.LicensingSourceValues.Account
+	return &memberentitlementmanagement.UserEntitlement{
+		Id: id,
+		User: &graph.GraphUser{
+			Origin:        &origin,
+			OriginId:      &originID,
+			PrincipalName: &principalName,
+			Descriptor:    &descriptor,
+		},
+		AccessLevel: &licensing.AccessLevel{
+			AccountLicenseType: &accountLicenseType,
+			LicensingSource:    &licensingSource,
+		},
+	}
+}
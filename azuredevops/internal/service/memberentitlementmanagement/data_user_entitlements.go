@@ -0,0 +1,274 @@
+package memberentitlementmanagement
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+)
+
+// the license IDs used by the $filter of the user entitlement search, by account license type
+var userEntitlementLicenseIDs = map[string]string{
+	string(licensing.AccountLicenseTypeValues.Advanced):     "Account-Advanced",
+	string(licensing.AccountLicenseTypeValues.EarlyAdopter): "Account-EarlyAdopter",
+	string(licensing.AccountLicenseTypeValues.Express):      "Account-Express",
+	string(licensing.AccountLicenseTypeValues.Professional): "Account-Professional",
+	string(licensing.AccountLicenseTypeValues.Stakeholder):  "Account-Stakeholder",
+}
+
+var userEntitlementOrderByRegexp = regexp.MustCompile(`^(dateCreated|lastAccessed|name)( (asc|desc))?$`)
+
+var userEntitlementFilterKeys = []string{"license_type", "license_status", "user_type", "name"}
+
+// DataUserEntitlements schema and implementation for the user entitlements data source
+func DataUserEntitlements() *schema.Resource {
+	return &schema.Resource{
+		Read: dataUserEntitlementsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"license_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"advanced", "earlyAdopter", "express", "professional", "stakeholder"}, false),
+				ConflictsWith: []string{"filter"},
+			},
+			"license_status": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"Disabled"}, false),
+				ConflictsWith: []string{"filter"},
+			},
+			"user_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"member", "guest"}, false),
+				ConflictsWith: []string{"filter"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"filter"},
+			},
+			"filter": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: userEntitlementFilterKeys,
+			},
+			"order_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(userEntitlementOrderByRegexp, "must be one of dateCreated, lastAccessed or name, optionally followed by asc or desc"),
+			},
+			"origin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"last_accessed_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"licensing_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_accessed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataUserEntitlementsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	args := memberentitlementmanagementextras.SearchUserEntitlementsArgs{}
+	if filter := expandUserEntitlementsFilter(d); filter != "" {
+		args.Filter = converter.String(filter)
+	}
+	if orderBy, ok := d.GetOk("order_by"); ok {
+		args.OrderBy = converter.String(orderBy.(string))
+	}
+
+	var lastAccessedBefore *time.Time
+	if v, ok := d.GetOk("last_accessed_before"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing last_accessed_before: %v", err)
+		}
+		lastAccessedBefore = &t
+	}
+	origin := d.Get("origin").(string)
+
+	users := []interface{}{}
+	ids := []string{}
+	for {
+		page, err := clients.MemberEntitleManagementClientExtras.SearchUserEntitlements(clients.Ctx, args)
+		if err != nil {
+			return fmt.Errorf(" searching user entitlements: %v", err)
+		}
+		if page == nil {
+			break
+		}
+
+		if page.Members != nil {
+			for _, userEntitlement := range *page.Members {
+				if !matchUserEntitlement(&userEntitlement, origin, lastAccessedBefore) {
+					continue
+				}
+				user := flattenUserEntitlementSummary(&userEntitlement)
+				users = append(users, user)
+				ids = append(ids, user["id"].(string))
+			}
+		}
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			break
+		}
+		args.ContinuationToken = page.ContinuationToken
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(ids, "-"))); err != nil {
+		return fmt.Errorf("Unable to compute hash for user entitlement IDs: %v", err)
+	}
+	d.SetId("userentitlements#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("users", users); err != nil {
+		return fmt.Errorf("Error setting `users`: %+v", err)
+	}
+	return nil
+}
+
+// expandUserEntitlementsFilter builds the $filter of the user entitlement search from the filter arguments
+func expandUserEntitlementsFilter(d *schema.ResourceData) string {
+	if filter, ok := d.GetOk("filter"); ok {
+		return filter.(string)
+	}
+
+	clauses := []string{}
+	if v, ok := d.GetOk("license_type"); ok {
+		clauses = append(clauses, fmt.Sprintf("licenseId eq '%s'", userEntitlementLicenseIDs[v.(string)]))
+	}
+	if v, ok := d.GetOk("license_status"); ok {
+		clauses = append(clauses, fmt.Sprintf("licenseStatus eq '%s'", v.(string)))
+	}
+	if v, ok := d.GetOk("user_type"); ok {
+		clauses = append(clauses, fmt.Sprintf("userType eq '%s'", v.(string)))
+	}
+	if v, ok := d.GetOk("name"); ok {
+		clauses = append(clauses, fmt.Sprintf("name eq '%s'", strings.ReplaceAll(v.(string), "'", "''")))
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// matchUserEntitlement applies the filters the user entitlement search does not support. Users that never accessed
+// the organization match any last_accessed_before.
+func matchUserEntitlement(userEntitlement *memberentitlementmanagement.UserEntitlement, origin string, lastAccessedBefore *time.Time) bool {
+	if origin != "" && (userEntitlement.User == nil || !strings.EqualFold(converter.ToString(userEntitlement.User.Origin, ""), origin)) {
+		return false
+	}
+	if lastAccessedBefore != nil && userEntitlement.LastAccessedDate != nil &&
+		!userEntitlement.LastAccessedDate.Time.Before(*lastAccessedBefore) {
+		return false
+	}
+	return true
+}
+
+func flattenUserEntitlementSummary(userEntitlement *memberentitlementmanagement.UserEntitlement) map[string]interface{} {
+	user := map[string]interface{}{
+		"id": "",
+	}
+	if userEntitlement.Id != nil {
+		user["id"] = userEntitlement.Id.String()
+	}
+	if graphUser := userEntitlement.User; graphUser != nil {
+		user["descriptor"] = converter.ToString(graphUser.Descriptor, "")
+		user["principal_name"] = converter.ToString(graphUser.PrincipalName, "")
+		user["display_name"] = converter.ToString(graphUser.DisplayName, "")
+		user["origin"] = converter.ToString(graphUser.Origin, "")
+		user["origin_id"] = converter.ToString(graphUser.OriginId, "")
+	}
+	if accessLevel := userEntitlement.AccessLevel; accessLevel != nil {
+		if accessLevel.AccountLicenseType != nil {
+			user["account_license_type"] = string(*accessLevel.AccountLicenseType)
+		}
+		user["license_display_name"] = converter.ToString(accessLevel.LicenseDisplayName, "")
+		if accessLevel.LicensingSource != nil {
+			user["licensing_source"] = string(*accessLevel.LicensingSource)
+		}
+		if accessLevel.Status != nil {
+			user["status"] = string(*accessLevel.Status)
+		}
+	}
+	if userEntitlement.DateCreated != nil {
+		user["date_created"] = userEntitlement.DateCreated.Time.Format(time.RFC3339)
+	}
+	if userEntitlement.LastAccessedDate != nil {
+		user["last_accessed_date"] = userEntitlement.LastAccessedDate.Time.Format(time.RFC3339)
+	}
+	return user
+}
@@ -0,0 +1,162 @@
+//go:build (all || data_sources || data_user_entitlements) && (!exclude_data_sources || !exclude_data_user_entitlements)
+// +build all data_sources data_user_entitlements
+// +build !exclude_data_sources !exclude_data_user_entitlements
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/memberentitlementmanagementextras"
+	"github.com/stretchr/testify/require"
+)
+
+func testUserEntitlement(principalName string, origin string, lastAccessed *time.Time) memberentitlementmanagement.UserEntitlement {
+	id := uuid.New()
+	userEntitlement := memberentitlementmanagement.UserEntitlement{
+		Id: &id,
+		User: &graph.GraphUser{
+			PrincipalName: converter.String(principalName),
+			Origin:        converter.String(origin),
+		},
+	}
+	if lastAccessed != nil {
+		userEntitlement.LastAccessedDate = &azuredevops.Time{Time: *lastAccessed}
+	}
+	return userEntitlement
+}
+
+// verifies that all pages of the user entitlement search are read, following the continuation token
+func TestDataUserEntitlements_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: extrasClient,
+		Ctx:                                 context.Background(),
+	}
+
+	filter := "licenseId eq 'Account-Express'"
+	gomock.InOrder(
+		extrasClient.
+			EXPECT().
+			SearchUserEntitlements(clients.Ctx, memberentitlementmanagementextras.SearchUserEntitlementsArgs{Filter: &filter}).
+			Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+				ContinuationToken: converter.String("token"),
+				Members:           &[]memberentitlementmanagement.UserEntitlement{testUserEntitlement("one@contoso.com", "aad", nil)},
+			}, nil).
+			Times(1),
+		extrasClient.
+			EXPECT().
+			SearchUserEntitlements(clients.Ctx, memberentitlementmanagementextras.SearchUserEntitlementsArgs{Filter: &filter, ContinuationToken: converter.String("token")}).
+			Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+				Members: &[]memberentitlementmanagement.UserEntitlement{testUserEntitlement("two@contoso.com", "aad", nil)},
+			}, nil).
+			Times(1),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, nil)
+	resourceData.Set("license_type", "express")
+
+	err := dataUserEntitlementsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+
+	users := resourceData.Get("users").([]interface{})
+	require.Len(t, users, 2)
+	require.Equal(t, "one@contoso.com", users[0].(map[string]interface{})["principal_name"])
+	require.Equal(t, "two@contoso.com", users[1].(map[string]interface{})["principal_name"])
+}
+
+// verifies that origin and last_accessed_before are applied to the returned user entitlements
+func TestDataUserEntitlements_Read_FiltersOriginAndLastAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: extrasClient,
+		Ctx:                                 context.Background(),
+	}
+
+	recent := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	stale := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+			Members: &[]memberentitlementmanagement.UserEntitlement{
+				testUserEntitlement("recent@contoso.com", "aad", &recent),
+				testUserEntitlement("stale@contoso.com", "aad", &stale),
+				testUserEntitlement("never@contoso.com", "aad", nil),
+				testUserEntitlement("msa@contoso.com", "msa", &stale),
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, nil)
+	resourceData.Set("origin", "aad")
+	resourceData.Set("last_accessed_before", "2023-01-01T00:00:00Z")
+
+	err := dataUserEntitlementsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	users := resourceData.Get("users").([]interface{})
+	require.Len(t, users, 2)
+	require.Equal(t, "stale@contoso.com", users[0].(map[string]interface{})["principal_name"])
+	require.Equal(t, "2022-06-01T00:00:00Z", users[0].(map[string]interface{})["last_accessed_date"])
+	require.Equal(t, "never@contoso.com", users[1].(map[string]interface{})["principal_name"])
+}
+
+// verifies that the filter arguments are combined into a single $filter expression
+func TestDataUserEntitlements_ExpandFilter(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, nil)
+	resourceData.Set("license_type", "stakeholder")
+	resourceData.Set("license_status", "Disabled")
+	resourceData.Set("user_type", "guest")
+	resourceData.Set("name", "O'Brien")
+
+	require.Equal(t,
+		"licenseId eq 'Account-Stakeholder' and licenseStatus eq 'Disabled' and userType eq 'guest' and name eq 'O''Brien'",
+		expandUserEntitlementsFilter(resourceData))
+
+	resourceData = schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, nil)
+	resourceData.Set("filter", "userType eq 'member'")
+	require.Equal(t, "userType eq 'member'", expandUserEntitlementsFilter(resourceData))
+}
+
+// verifies that an error of the user entitlement search is returned
+func TestDataUserEntitlements_Read_ReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClientExtras: extrasClient,
+		Ctx:                                 context.Background(),
+	}
+
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("SearchUserEntitlements() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, nil)
+	err := dataUserEntitlementsRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SearchUserEntitlements() Failed")
+}
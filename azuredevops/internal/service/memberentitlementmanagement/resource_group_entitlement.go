@@ -3,15 +3,19 @@ package memberentitlementmanagement
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/ahmetb/go-linq"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensingrule"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -36,6 +40,12 @@ func ResourceGroupEntitlement() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: importGroupEntitlement,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"principal_name": {
 				Type:     schema.TypeString,
@@ -117,6 +127,29 @@ func ResourceGroupEntitlement() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"project_entitlements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"group_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(memberentitlementmanagement.GroupTypeValues.ProjectStakeholder),
+								string(memberentitlementmanagement.GroupTypeValues.ProjectReader),
+								string(memberentitlementmanagement.GroupTypeValues.ProjectContributor),
+								string(memberentitlementmanagement.GroupTypeValues.ProjectAdministrator),
+							}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -134,6 +167,12 @@ func resourceGroupEntitlementCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	d.SetId(addedGroupEntitlement.Id.String())
+
+	if groupEntitlementRuleStatus(addedGroupEntitlement) != string(licensingrule.GroupLicensingRuleStatusValues.Applied) {
+		if err := waitForGroupEntitlementRuleApplied(clients, addedGroupEntitlement.Id, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("Creating group entitlement: %v", err)
+		}
+	}
 	return resourceGroupEntitlementRead(d, m)
 }
 
@@ -221,23 +260,29 @@ func resourceGroupEntitlementUpdate(d *schema.ResourceData, m interface{}) error
 
 	clients := m.(*client.AggregatedClient)
 
+	document := []webapi.JsonPatchOperation{
+		{
+			Op:   &webapi.OperationValues.Replace,
+			From: nil,
+			Path: converter.String("/accessLevel"),
+			Value: struct {
+				AccountLicenseType string `json:"accountLicenseType"`
+				LicensingSource    string `json:"licensingSource"`
+			}{
+				string(*accountLicenseType),
+				licensingSource.(string),
+			},
+		},
+	}
+	if d.HasChange("project_entitlements") {
+		oldEntitlements, newEntitlements := d.GetChange("project_entitlements")
+		document = append(document, expandProjectEntitlementsPatch(oldEntitlements.(*schema.Set), newEntitlements.(*schema.Set))...)
+	}
+
 	patchResponse, err := clients.MemberEntitleManagementClient.UpdateGroupEntitlement(clients.Ctx,
 		memberentitlementmanagement.UpdateGroupEntitlementArgs{
-			GroupId: &id,
-			Document: &[]webapi.JsonPatchOperation{
-				{
-					Op:   &webapi.OperationValues.Replace,
-					From: nil,
-					Path: converter.String("/accessLevel"),
-					Value: struct {
-						AccountLicenseType string `json:"accountLicenseType"`
-						LicensingSource    string `json:"licensingSource"`
-					}{
-						string(*accountLicenseType),
-						licensingSource.(string),
-					},
-				},
-			},
+			GroupId:  &id,
+			Document: &document,
 		})
 
 	if err != nil {
@@ -249,18 +294,34 @@ func resourceGroupEntitlementUpdate(d *schema.ResourceData, m interface{}) error
 	if !*result[0].IsSuccess {
 		return fmt.Errorf("Updating group entitlement: %s", getGroupEntitlementAPIErrorMessage(&result))
 	}
+
+	if groupEntitlementRuleStatus(result[0].Result) != string(licensingrule.GroupLicensingRuleStatusValues.Applied) {
+		if err := waitForGroupEntitlementRuleApplied(clients, &id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Updating group entitlement: %v", err)
+		}
+	}
 	return resourceGroupEntitlementRead(d, m)
 }
 
+var groupPrincipalNameRegexp = regexp.MustCompile(`^\[[^\]]+\]\\.+$`)
+
 func importGroupEntitlement(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	upn := d.Id()
-	id, err := uuid.Parse(upn)
+	clients := m.(*client.AggregatedClient)
 
+	id, err := uuid.Parse(upn)
 	if err != nil {
-		return nil, fmt.Errorf("Only UUID values can used for import [%s]", upn)
+		if !groupPrincipalNameRegexp.MatchString(upn) {
+			return nil, fmt.Errorf("Only UUID and principal name values can used for import [%s]", upn)
+		}
+
+		groupID, err := readEntitlementIDByPrincipalName(clients, upn)
+		if err != nil {
+			return nil, err
+		}
+		id = *groupID
 	}
 
-	clients := m.(*client.AggregatedClient)
 	result, err := clients.MemberEntitleManagementClient.GetGroupEntitlement(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementArgs{
 		GroupId: &id,
 	})
@@ -284,6 +345,79 @@ func flattenGroupEntitlement(d *schema.ResourceData, groupEntitlement *memberent
 	d.Set("display_name", *groupEntitlement.Group.DisplayName)
 	d.Set("account_license_type", string(*groupEntitlement.LicenseRule.AccountLicenseType))
 	d.Set("licensing_source", *groupEntitlement.LicenseRule.LicensingSource)
+	if groupEntitlement.ProjectEntitlements != nil {
+		managedProjects := map[string]bool{}
+		for _, raw := range d.Get("project_entitlements").(*schema.Set).List() {
+			managedProjects[strings.ToLower(raw.(map[string]interface{})["project_id"].(string))] = true
+		}
+		d.Set("project_entitlements", flattenProjectEntitlements(groupEntitlement.ProjectEntitlements, managedProjects))
+	}
+}
+
+// flattenProjectEntitlements only returns the entitlements of the managed projects, project access granted outside
+// of Terraform is left untouched
+func flattenProjectEntitlements(projectEntitlements *[]memberentitlementmanagement.ProjectEntitlement, managedProjects map[string]bool) []interface{} {
+	result := []interface{}{}
+	for _, projectEntitlement := range *projectEntitlements {
+		if projectEntitlement.ProjectRef == nil || projectEntitlement.ProjectRef.Id == nil ||
+			projectEntitlement.Group == nil || projectEntitlement.Group.GroupType == nil {
+			continue
+		}
+		if !managedProjects[strings.ToLower(projectEntitlement.ProjectRef.Id.String())] {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"project_id": projectEntitlement.ProjectRef.Id.String(),
+			"group_type": string(*projectEntitlement.Group.GroupType),
+		})
+	}
+	return result
+}
+
+func expandProjectEntitlements(projectEntitlements *schema.Set) *[]memberentitlementmanagement.ProjectEntitlement {
+	result := []memberentitlementmanagement.ProjectEntitlement{}
+	for _, raw := range projectEntitlements.List() {
+		projectEntitlement := raw.(map[string]interface{})
+		projectID := uuid.MustParse(projectEntitlement["project_id"].(string))
+		groupType := memberentitlementmanagement.GroupType(projectEntitlement["group_type"].(string))
+		result = append(result, memberentitlementmanagement.ProjectEntitlement{
+			ProjectRef: &memberentitlementmanagement.ProjectRef{
+				Id: &projectID,
+			},
+			Group: &memberentitlementmanagement.Group{
+				GroupType: &groupType,
+			},
+		})
+	}
+	return &result
+}
+
+// expandProjectEntitlementsPatch removes the project entitlements of projects no longer configured and adds the new
+// or changed ones, adding a project entitlement replaces the one of the same project
+func expandProjectEntitlementsPatch(oldEntitlements *schema.Set, newEntitlements *schema.Set) []webapi.JsonPatchOperation {
+	operations := []webapi.JsonPatchOperation{}
+
+	configuredProjects := map[string]bool{}
+	for _, raw := range newEntitlements.List() {
+		configuredProjects[raw.(map[string]interface{})["project_id"].(string)] = true
+	}
+	for _, raw := range oldEntitlements.Difference(newEntitlements).List() {
+		projectID := raw.(map[string]interface{})["project_id"].(string)
+		if !configuredProjects[projectID] {
+			operations = append(operations, webapi.JsonPatchOperation{
+				Op:   &webapi.OperationValues.Remove,
+				Path: converter.String("/projectEntitlements/" + projectID),
+			})
+		}
+	}
+	for _, projectEntitlement := range *expandProjectEntitlements(newEntitlements.Difference(oldEntitlements)) {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String("/projectEntitlements"),
+			Value: projectEntitlement,
+		})
+	}
+	return operations
 }
 
 func expandGroupEntitlement(d *schema.ResourceData) (*memberentitlementmanagement.GroupEntitlement, error) {
@@ -300,7 +434,7 @@ func expandGroupEntitlement(d *schema.ResourceData) (*memberentitlementmanagemen
 		return nil, err
 	}
 
-	return &memberentitlementmanagement.GroupEntitlement{
+	groupEntitlement := &memberentitlementmanagement.GroupEntitlement{
 		LicenseRule: &licensing.AccessLevel{
 			AccountLicenseType: accountLicenseType,
 			LicensingSource:    licensingSource,
@@ -312,7 +446,11 @@ func expandGroupEntitlement(d *schema.ResourceData) (*memberentitlementmanagemen
 			DisplayName: &displayName,
 			SubjectKind: converter.String("group"),
 		},
-	}, nil
+	}
+	if projectEntitlements, ok := d.GetOk("project_entitlements"); ok {
+		groupEntitlement.ProjectEntitlements = expandProjectEntitlements(projectEntitlements.(*schema.Set))
+	}
+	return groupEntitlement, nil
 }
 
 func addGroupEntitlement(clients *client.AggregatedClient, groupEntitlement *memberentitlementmanagement.GroupEntitlement) (*memberentitlementmanagement.GroupEntitlement, error) {
@@ -337,6 +475,46 @@ func addGroupEntitlement(clients *client.AggregatedClient, groupEntitlement *mem
 	return result[0].Result, nil
 }
 
+// waitForGroupEntitlementRuleApplied waits until the license rule of a group entitlement has been evaluated for all
+// members of the group, which happens asynchronously after the group entitlement has been added or updated
+func waitForGroupEntitlementRuleApplied(clients *client.AggregatedClient, id *uuid.UUID, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(licensingrule.GroupLicensingRuleStatusValues.ApplyPending)},
+		Target:  []string{string(licensingrule.GroupLicensingRuleStatusValues.Applied)},
+		Refresh: func() (interface{}, string, error) {
+			groupEntitlement, err := clients.MemberEntitleManagementClient.GetGroupEntitlement(clients.Ctx, memberentitlementmanagement.GetGroupEntitlementArgs{
+				GroupId: id,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf(" reading group entitlement: %v", err)
+			}
+
+			status := groupEntitlementRuleStatus(groupEntitlement)
+			if status == string(licensingrule.GroupLicensingRuleStatusValues.Incompatible) ||
+				status == string(licensingrule.GroupLicensingRuleStatusValues.UnableToApply) {
+				return nil, "", fmt.Errorf(" the license rule of the group entitlement could not be applied. Status: %s", status)
+			}
+			return groupEntitlement, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for the license rule of the group entitlement to be applied. %v ", err)
+	}
+	return nil
+}
+
+// groupEntitlementRuleStatus returns the status of the license rule, a group entitlement without status is treated as applied
+func groupEntitlementRuleStatus(groupEntitlement *memberentitlementmanagement.GroupEntitlement) string {
+	if groupEntitlement == nil || groupEntitlement.Status == nil {
+		return string(licensingrule.GroupLicensingRuleStatusValues.Applied)
+	}
+	return string(*groupEntitlement.Status)
+}
+
 func getGroupEntitlementAPIErrorMessage(operationResults *[]memberentitlementmanagement.GroupOperationResult) string {
 	errMsg := "Unknown API error"
 	if operationResults != nil && len(*operationResults) > 0 {
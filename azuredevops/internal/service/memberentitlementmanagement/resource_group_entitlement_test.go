@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensingrule"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
@@ -72,6 +74,100 @@ func TestGroupEntitlement_CreateGroupEntitlement_WithDisplayName(t *testing.T) {
 	assert.Nil(t, err, "err should not be nil")
 }
 
+// If the license rule is pending, create should wait until it has been applied
+func TestGroupEntitlement_CreateGroupEntitlement_WaitsForLicenseRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	pendingGroupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	pendingGroupEntitlement.Status = &licensingrule.GroupLicensingRuleStatusValues.ApplyPending
+	appliedGroupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	appliedGroupEntitlement.Status = &licensingrule.GroupLicensingRuleStatusValues.Applied
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	resourceData.Set("display_name", "displayName")
+	expectedIsSuccess := true
+	memberEntitlementClient.
+		EXPECT().
+		AddGroupEntitlement(gomock.Any(), gomock.Any()).
+		Return(&memberentitlementmanagement.GroupEntitlementOperationReference{
+			Results: &[]memberentitlementmanagement.GroupOperationResult{
+				{
+					IsSuccess: &expectedIsSuccess,
+					Result:    pendingGroupEntitlement,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	gomock.InOrder(
+		memberEntitlementClient.
+			EXPECT().
+			GetGroupEntitlement(gomock.Any(), memberentitlementmanagement.GetGroupEntitlementArgs{GroupId: &id}).
+			Return(pendingGroupEntitlement, nil).
+			Times(1),
+		memberEntitlementClient.
+			EXPECT().
+			GetGroupEntitlement(gomock.Any(), memberentitlementmanagement.GetGroupEntitlementArgs{GroupId: &id}).
+			Return(appliedGroupEntitlement, nil).
+			Times(2),
+	)
+
+	err := resourceGroupEntitlementCreate(resourceData, clients)
+	assert.Nil(t, err)
+}
+
+// If the license rule cannot be applied, create should fail
+func TestGroupEntitlement_CreateGroupEntitlement_LicenseRuleUnableToApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	pendingGroupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	pendingGroupEntitlement.Status = &licensingrule.GroupLicensingRuleStatusValues.ApplyPending
+	failedGroupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	failedGroupEntitlement.Status = &licensingrule.GroupLicensingRuleStatusValues.UnableToApply
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	resourceData.Set("display_name", "displayName")
+	expectedIsSuccess := true
+	memberEntitlementClient.
+		EXPECT().
+		AddGroupEntitlement(gomock.Any(), gomock.Any()).
+		Return(&memberentitlementmanagement.GroupEntitlementOperationReference{
+			Results: &[]memberentitlementmanagement.GroupOperationResult{
+				{
+					IsSuccess: &expectedIsSuccess,
+					Result:    pendingGroupEntitlement,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlement(gomock.Any(), memberentitlementmanagement.GetGroupEntitlementArgs{GroupId: &id}).
+		Return(failedGroupEntitlement, nil).
+		Times(1)
+
+	err := resourceGroupEntitlementCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unableToApply")
+}
+
 // if the REST-API return the failure, it should fail.
 func TestGroupEntitlement_CreateGroupEntitlement_WithError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -293,6 +389,52 @@ func TestGroupEntitlement_Import_TestID(t *testing.T) {
 	assert.Equal(t, id.String(), d[0].Id())
 }
 
+// TestGroupEntitlement_Import_TestPrincipalName tests if import is successful using the principal name of a group
+func TestGroupEntitlement_Import_TestPrincipalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient:                identityClient,
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	principalName := "[TEAM FOUNDATION]\\MyGroup"
+	id := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identity.ReadIdentitiesArgs{
+			SearchFilter: converter.String("General"),
+			FilterValue:  &principalName,
+		}).
+		Return(&[]identity.Identity{
+			{
+				Id: &id,
+			},
+		}, nil).
+		Times(1)
+
+	mockGroupEntitlement := getMockGroupEntitlement(&id, "", "", "", "", "", "")
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlement(gomock.Any(), memberentitlementmanagement.GetGroupEntitlementArgs{
+			GroupId: &id,
+		}).
+		Return(mockGroupEntitlement, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	resourceData.SetId(principalName)
+
+	d, err := importGroupEntitlement(resourceData, clients)
+	assert.Nil(t, err)
+	assert.Len(t, d, 1)
+	assert.Equal(t, id.String(), d[0].Id())
+}
+
 // TestGroupEntitlement_Import_TestInvalidValue tests if only a valid UPN and UUID can be used to import a resource
 func TestGroupEntitlement_Import_TestInvalidValue(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -311,7 +453,7 @@ func TestGroupEntitlement_Import_TestInvalidValue(t *testing.T) {
 	d, err := importGroupEntitlement(resourceData, clients)
 	assert.Nil(t, d)
 	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "Only UUID values can used for import")
+	assert.Contains(t, err.Error(), "Only UUID and principal name values can used for import")
 }
 
 func TestGroupEntitlement_Create_TestErrorFormatting(t *testing.T) {
@@ -510,6 +652,115 @@ func TestGroupEntitlement_Update_TestEmptyErrors(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unknown API error")
 }
 
+// verifies that changed project entitlements are patched per project
+func TestGroupEntitlement_ExpandProjectEntitlementsPatch(t *testing.T) {
+	keptProjectID := uuid.New().String()
+	changedProjectID := uuid.New().String()
+	removedProjectID := uuid.New().String()
+	addedProjectID := uuid.New().String()
+
+	projectEntitlementsSchema := ResourceGroupEntitlement().Schema["project_entitlements"]
+	newSet := func(entitlements ...map[string]interface{}) *schema.Set {
+		set := schema.NewSet(schema.HashResource(projectEntitlementsSchema.Elem.(*schema.Resource)), nil)
+		for _, entitlement := range entitlements {
+			set.Add(entitlement)
+		}
+		return set
+	}
+
+	oldEntitlements := newSet(
+		map[string]interface{}{"project_id": keptProjectID, "group_type": "projectReader"},
+		map[string]interface{}{"project_id": changedProjectID, "group_type": "projectReader"},
+		map[string]interface{}{"project_id": removedProjectID, "group_type": "projectContributor"},
+	)
+	newEntitlements := newSet(
+		map[string]interface{}{"project_id": keptProjectID, "group_type": "projectReader"},
+		map[string]interface{}{"project_id": changedProjectID, "group_type": "projectContributor"},
+		map[string]interface{}{"project_id": addedProjectID, "group_type": "projectAdministrator"},
+	)
+
+	operations := expandProjectEntitlementsPatch(oldEntitlements, newEntitlements)
+	require.Len(t, operations, 3)
+
+	require.Equal(t, webapi.OperationValues.Remove, *operations[0].Op)
+	require.Equal(t, "/projectEntitlements/"+removedProjectID, *operations[0].Path)
+
+	added := map[string]memberentitlementmanagement.GroupType{}
+	for _, operation := range operations[1:] {
+		require.Equal(t, webapi.OperationValues.Add, *operation.Op)
+		require.Equal(t, "/projectEntitlements", *operation.Path)
+		projectEntitlement := operation.Value.(memberentitlementmanagement.ProjectEntitlement)
+		added[projectEntitlement.ProjectRef.Id.String()] = *projectEntitlement.Group.GroupType
+	}
+	require.Equal(t, map[string]memberentitlementmanagement.GroupType{
+		changedProjectID: memberentitlementmanagement.GroupTypeValues.ProjectContributor,
+		addedProjectID:   memberentitlementmanagement.GroupTypeValues.ProjectAdministrator,
+	}, added)
+}
+
+// verifies that project entitlements are read back, skipping entries without project or group type
+func TestGroupEntitlement_FlattenProjectEntitlements(t *testing.T) {
+	id := uuid.New()
+	projectID := uuid.New()
+	groupType := memberentitlementmanagement.GroupTypeValues.ProjectContributor
+	groupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	groupEntitlement.ProjectEntitlements = &[]memberentitlementmanagement.ProjectEntitlement{
+		{
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &projectID},
+			Group:      &memberentitlementmanagement.Group{GroupType: &groupType},
+		},
+		{
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &projectID},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	resourceData.Set("project_entitlements", []interface{}{
+		map[string]interface{}{"project_id": projectID.String(), "group_type": "projectReader"},
+	})
+	flattenGroupEntitlement(resourceData, groupEntitlement)
+
+	projectEntitlements := resourceData.Get("project_entitlements").(*schema.Set).List()
+	require.Len(t, projectEntitlements, 1)
+	require.Equal(t, projectID.String(), projectEntitlements[0].(map[string]interface{})["project_id"])
+	require.Equal(t, "projectContributor", projectEntitlements[0].(map[string]interface{})["group_type"])
+}
+
+// verifies that project access granted outside of Terraform is not read into the state
+func TestGroupEntitlement_FlattenProjectEntitlements_IgnoresUnmanagedProjects(t *testing.T) {
+	id := uuid.New()
+	managedProjectID := uuid.New()
+	unmanagedProjectID := uuid.New()
+	readerGroupType := memberentitlementmanagement.GroupTypeValues.ProjectReader
+	contributorGroupType := memberentitlementmanagement.GroupTypeValues.ProjectContributor
+	groupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contso]\\displayName", "displayName", "baz")
+	groupEntitlement.ProjectEntitlements = &[]memberentitlementmanagement.ProjectEntitlement{
+		{
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &managedProjectID},
+			Group:      &memberentitlementmanagement.Group{GroupType: &readerGroupType},
+		},
+		{
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &unmanagedProjectID},
+			Group:      &memberentitlementmanagement.Group{GroupType: &contributorGroupType},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	resourceData.Set("project_entitlements", []interface{}{
+		map[string]interface{}{"project_id": managedProjectID.String(), "group_type": "projectReader"},
+	})
+	flattenGroupEntitlement(resourceData, groupEntitlement)
+
+	projectEntitlements := resourceData.Get("project_entitlements").(*schema.Set).List()
+	require.Len(t, projectEntitlements, 1)
+	require.Equal(t, managedProjectID.String(), projectEntitlements[0].(map[string]interface{})["project_id"])
+
+	// nothing is managed without configured project entitlements
+	resourceData = schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, nil)
+	flattenGroupEntitlement(resourceData, groupEntitlement)
+	require.Zero(t, resourceData.Get("project_entitlements").(*schema.Set).Len())
+}
+
 func getMockGroupEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, displayName string, descriptor string) *memberentitlementmanagement.GroupEntitlement {
 	subjectKind := "group"
 	licensingSource := licensing.LicensingSourceValues.Account
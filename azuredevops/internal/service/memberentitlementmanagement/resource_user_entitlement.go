@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/ahmetb/go-linq"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
@@ -20,6 +22,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 var (
@@ -30,6 +33,11 @@ var (
 	}
 )
 
+const (
+	userEntitlementPending      = "Pending"
+	userEntitlementMaterialized = "Materialized"
+)
+
 // ResourceUserEntitlement schema and implementation for user entitlement resource
 func ResourceUserEntitlement() *schema.Resource {
 	return &schema.Resource{
@@ -40,6 +48,9 @@ func ResourceUserEntitlement() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: importUserEntitlement,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"principal_name": {
 				Type:             schema.TypeString,
@@ -114,10 +125,22 @@ func ResourceUserEntitlement() *schema.Resource {
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"extensions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
 			"descriptor": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"user_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -134,10 +157,62 @@ func resourceUserEntitlementCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Creating user entitlement: %v", err)
 	}
 
+	// users added by origin ID, e.g. invited Azure AD guests, are returned before they exist in the identity service
+	if d.Get("origin_id").(string) != "" {
+		d.SetId(addedUserEntitlement.Id.String())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{userEntitlementPending},
+			Target:     []string{userEntitlementMaterialized},
+			Refresh:    userEntitlementRefreshFunc(clients, addedUserEntitlement.Id),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+			return fmt.Errorf("Waiting for user entitlement %s: %v", addedUserEntitlement.Id, err)
+		}
+		return resourceUserEntitlementRead(d, m)
+	}
+
 	flattenUserEntitlement(d, addedUserEntitlement)
 	return resourceUserEntitlementRead(d, m)
 }
 
+// userEntitlementRefreshFunc reports a user entitlement as materialized once it has a descriptor that the identity
+// service resolves
+func userEntitlementRefreshFunc(clients *client.AggregatedClient, id *uuid.UUID) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		userEntitlement, err := readUserEntitlement(clients, id)
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return userEntitlementPending, userEntitlementPending, nil
+			}
+			return nil, "", fmt.Errorf("Reading user entitlement %s: %v", id, err)
+		}
+		if userEntitlement == nil || userEntitlement.User == nil || userEntitlement.User.Descriptor == nil {
+			return userEntitlementPending, userEntitlementPending, nil
+		}
+
+		identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SubjectDescriptors: userEntitlement.User.Descriptor,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return userEntitlementPending, userEntitlementPending, nil
+			}
+			return nil, "", fmt.Errorf("Reading identity of user entitlement %s: %v", id, err)
+		}
+		if identities != nil {
+			for _, item := range *identities {
+				if item.Id != nil {
+					return userEntitlement, userEntitlementMaterialized, nil
+				}
+			}
+		}
+		return userEntitlementPending, userEntitlementPending, nil
+	}
+}
+
 func resourceUserEntitlementRead(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	userEntitlementID := d.Id()
@@ -192,6 +267,7 @@ func expandUserEntitlement(d *schema.ResourceData) (*memberentitlementmanagement
 			AccountLicenseType: accountLicenseType,
 			LicensingSource:    licensingSource,
 		},
+		Extensions: expandUserEntitlementExtensions(d.Get("extensions").(*schema.Set)),
 
 		// TODO check if it works in both case for GitHub and AzureDevOps
 		User: &graph.GraphUser{
@@ -211,8 +287,59 @@ func flattenUserEntitlement(d *schema.ResourceData, userEntitlement *memberentit
 		d.Set("origin_id", *userEntitlement.User.OriginId)
 	}
 	d.Set("principal_name", *userEntitlement.User.PrincipalName)
+	d.Set("user_type", converter.ToString(userEntitlement.User.MetaType, ""))
 	d.Set("account_license_type", string(*userEntitlement.AccessLevel.AccountLicenseType))
 	d.Set("licensing_source", *userEntitlement.AccessLevel.LicensingSource)
+	d.Set("extensions", flattenUserEntitlementExtensions(userEntitlement.Extensions, d.Get("extensions").(*schema.Set)))
+}
+
+func expandUserEntitlementExtensions(extensions *schema.Set) *[]memberentitlementmanagement.Extension {
+	result := []memberentitlementmanagement.Extension{}
+	for _, id := range tfhelper.ExpandStringSet(extensions) {
+		result = append(result, memberentitlementmanagement.Extension{
+			Id: converter.String(id),
+		})
+	}
+	return &result
+}
+
+// flattenUserEntitlementExtensions returns the IDs of the managed extensions assigned directly to the user. Extensions
+// assigned through a group rule are managed by the group entitlement, and extensions assigned outside of Terraform
+// are left untouched.
+func flattenUserEntitlementExtensions(extensions *[]memberentitlementmanagement.Extension, managedExtensions *schema.Set) []string {
+	result := []string{}
+	if extensions == nil {
+		return result
+	}
+	for _, extension := range *extensions {
+		if extension.Id == nil || !managedExtensions.Contains(*extension.Id) {
+			continue
+		}
+		if extension.AssignmentSource != nil && *extension.AssignmentSource == licensing.AssignmentSourceValues.GroupRule {
+			continue
+		}
+		result = append(result, *extension.Id)
+	}
+	return result
+}
+
+// expandUserEntitlementExtensionsPatch returns the operations to unassign the removed and assign the added extensions
+func expandUserEntitlementExtensionsPatch(oldExtensions *schema.Set, newExtensions *schema.Set) []webapi.JsonPatchOperation {
+	operations := []webapi.JsonPatchOperation{}
+	for _, id := range tfhelper.ExpandStringSet(oldExtensions.Difference(newExtensions)) {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String("/extensions/" + id),
+		})
+	}
+	for _, extension := range *expandUserEntitlementExtensions(newExtensions.Difference(oldExtensions)) {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String("/extensions"),
+			Value: extension,
+		})
+	}
+	return operations
 }
 
 func addUserEntitlement(clients *client.AggregatedClient, userEntitlement *memberentitlementmanagement.UserEntitlement) (*memberentitlementmanagement.UserEntitlement, error) {
@@ -283,23 +410,29 @@ func resourceUserEntitlementUpdate(d *schema.ResourceData, m interface{}) error
 
 	clients := m.(*client.AggregatedClient)
 
+	operations := []webapi.JsonPatchOperation{
+		{
+			Op:   &webapi.OperationValues.Replace,
+			From: nil,
+			Path: converter.String("/accessLevel"),
+			Value: struct {
+				AccountLicenseType string `json:"accountLicenseType"`
+				LicensingSource    string `json:"licensingSource"`
+			}{
+				string(*accountLicenseType),
+				licensingSource.(string),
+			},
+		},
+	}
+	if d.HasChange("extensions") {
+		oldExtensions, newExtensions := d.GetChange("extensions")
+		operations = append(operations, expandUserEntitlementExtensionsPatch(oldExtensions.(*schema.Set), newExtensions.(*schema.Set))...)
+	}
+
 	patchResponse, err := clients.MemberEntitleManagementClient.UpdateUserEntitlement(clients.Ctx,
 		memberentitlementmanagement.UpdateUserEntitlementArgs{
-			UserId: &id,
-			Document: &[]webapi.JsonPatchOperation{
-				{
-					Op:   &webapi.OperationValues.Replace,
-					From: nil,
-					Path: converter.String("/accessLevel"),
-					Value: struct {
-						AccountLicenseType string `json:"accountLicenseType"`
-						LicensingSource    string `json:"licensingSource"`
-					}{
-						string(*accountLicenseType),
-						licensingSource.(string),
-					},
-				},
-			},
+			UserId:   &id,
+			Document: &operations,
 		})
 
 	if err != nil {
@@ -323,26 +456,35 @@ func importUserEntitlement(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		}
 
 		clients := m.(*client.AggregatedClient)
-		result, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
-			SearchFilter: converter.String("General"),
-			FilterValue:  &upn,
-		})
+		id, err := readEntitlementIDByPrincipalName(clients, upn)
 		if err != nil {
 			return nil, err
 		}
 
-		if result == nil || len(*result) <= 0 {
-			return nil, fmt.Errorf("No entitlement found for [%s]", upn)
-		}
-		if len(*result) > 1 {
-			return nil, fmt.Errorf("More than one entitle found for [%s]", upn)
-		}
-
-		d.SetId((*result)[0].Id.String())
+		d.SetId(id.String())
 	}
 	return []*schema.ResourceData{d}, nil
 }
 
+// readEntitlementIDByPrincipalName resolves a principal name to the ID of its identity, which is also the ID of its entitlement
+func readEntitlementIDByPrincipalName(clients *client.AggregatedClient, upn string) (*uuid.UUID, error) {
+	result, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SearchFilter: converter.String("General"),
+		FilterValue:  &upn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil || len(*result) <= 0 {
+		return nil, fmt.Errorf("No entitlement found for [%s]", upn)
+	}
+	if len(*result) > 1 {
+		return nil, fmt.Errorf("More than one entitle found for [%s]", upn)
+	}
+	return (*result)[0].Id, nil
+}
+
 func getAPIErrorMessage(operationResults *[]memberentitlementmanagement.UserEntitlementOperationResult) string {
 	errMsg := "Unknown API error"
 	if operationResults != nil && len(*operationResults) > 0 {
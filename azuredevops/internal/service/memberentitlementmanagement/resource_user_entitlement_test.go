@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/accounts"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
@@ -573,6 +574,195 @@ func TestUserEntitlement_Update_TestEmptyErrors(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unknown API error")
 }
 
+// TestUserEntitlement_Update_TestChangeExtensions verifies that removed extensions are unassigned and added extensions are assigned
+func TestUserEntitlement_Update_TestChangeExtensions(t *testing.T) {
+	oldExtensions := schema.NewSet(schema.HashString, []interface{}{"ms.vss-testmanager-web", "ms.feed"})
+	newExtensions := schema.NewSet(schema.HashString, []interface{}{"ms.feed", "ms.vss-code-search"})
+
+	operations := expandUserEntitlementExtensionsPatch(oldExtensions, newExtensions)
+	require.Len(t, operations, 2)
+	require.Equal(t, webapi.OperationValues.Remove, *operations[0].Op)
+	require.Equal(t, "/extensions/ms.vss-testmanager-web", *operations[0].Path)
+	require.Equal(t, webapi.OperationValues.Add, *operations[1].Op)
+	require.Equal(t, "/extensions", *operations[1].Path)
+	require.Equal(t, memberentitlementmanagement.Extension{Id: converter.String("ms.vss-code-search")}, operations[1].Value)
+}
+
+// TestUserEntitlement_Read_TestIgnoresGroupRuleExtensions verifies that only directly assigned extensions are read into the state
+func TestUserEntitlement_Read_TestIgnoresGroupRuleExtensions(t *testing.T) {
+	id := uuid.New()
+	mockUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "foobar@microsoft.com", "baz")
+	mockUserEntitlement.Extensions = &[]memberentitlementmanagement.Extension{
+		{
+			Id:               converter.String("ms.vss-testmanager-web"),
+			AssignmentSource: &licensing.AssignmentSourceValues.Unknown,
+		},
+		{
+			Id:               converter.String("ms.vss-code-search"),
+			AssignmentSource: &licensing.AssignmentSourceValues.GroupRule,
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlement().Schema, nil)
+	resourceData.Set("extensions", []interface{}{"ms.vss-testmanager-web", "ms.vss-code-search"})
+	flattenUserEntitlement(resourceData, mockUserEntitlement)
+
+	extensions := resourceData.Get("extensions").(*schema.Set)
+	require.Equal(t, 1, extensions.Len())
+	require.True(t, extensions.Contains("ms.vss-testmanager-web"))
+}
+
+// TestUserEntitlement_Read_TestIgnoresUnmanagedExtensions verifies that extensions assigned outside of Terraform are not read into the state
+func TestUserEntitlement_Read_TestIgnoresUnmanagedExtensions(t *testing.T) {
+	id := uuid.New()
+	mockUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "foobar@microsoft.com", "baz")
+	mockUserEntitlement.Extensions = &[]memberentitlementmanagement.Extension{
+		{
+			Id:               converter.String("ms.vss-testmanager-web"),
+			AssignmentSource: &licensing.AssignmentSourceValues.Unknown,
+		},
+		{
+			Id:               converter.String("ms.vss-code-search"),
+			AssignmentSource: &licensing.AssignmentSourceValues.Unknown,
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlement().Schema, nil)
+	resourceData.Set("extensions", []interface{}{"ms.vss-testmanager-web"})
+	flattenUserEntitlement(resourceData, mockUserEntitlement)
+
+	extensions := resourceData.Get("extensions").(*schema.Set)
+	require.Equal(t, 1, extensions.Len())
+	require.True(t, extensions.Contains("ms.vss-testmanager-web"))
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceUserEntitlement().Schema, nil)
+	flattenUserEntitlement(resourceData, mockUserEntitlement)
+	require.Zero(t, resourceData.Get("extensions").(*schema.Set).Len())
+}
+
+// TestUserEntitlement_Create_TestWaitsForGuestUser verifies that a user added by origin ID is read once the identity service resolves it
+func TestUserEntitlement_Create_TestWaitsForGuestUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		IdentityClient:                identityClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	originID := uuid.NewString()
+	invitedUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Stakeholder, "aad", originID, "", "")
+	invitedUserEntitlement.User.Descriptor = nil
+	guestUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Stakeholder, "aad", originID, "guest_contoso.com#EXT#@fabrikam.onmicrosoft.com", "aad.guest")
+	guestUserEntitlement.User.MetaType = converter.String("guest")
+	guestUserEntitlement.AccessLevel.Status = &accounts.AccountUserStatusValues.Pending
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlement().Schema, nil)
+	resourceData.Set("origin", "aad")
+	resourceData.Set("origin_id", originID)
+	resourceData.Set("account_license_type", "stakeholder")
+
+	memberEntitlementClient.
+		EXPECT().
+		AddUserEntitlement(gomock.Any(), gomock.Any()).
+		Return(&memberentitlementmanagement.UserEntitlementsPostResponse{
+			IsSuccess:       converter.Bool(true),
+			UserEntitlement: invitedUserEntitlement,
+		}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(gomock.Any(), memberentitlementmanagement.GetUserEntitlementArgs{UserId: &id}).
+		Return(guestUserEntitlement, nil).
+		Times(2)
+	identityClient.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.guest")}).
+		Return(&[]identity.Identity{{Id: &id}}, nil).
+		Times(1)
+
+	err := resourceUserEntitlementCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, "aad.guest", resourceData.Get("descriptor"))
+	require.Equal(t, "guest", resourceData.Get("user_type"))
+}
+
+// TestUserEntitlement_Create_TestKeepsIDWhenWaitFails verifies that a user entitlement stays tracked when the wait for it fails
+func TestUserEntitlement_Create_TestKeepsIDWhenWaitFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	originID := uuid.NewString()
+	invitedUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Stakeholder, "aad", originID, "", "")
+	invitedUserEntitlement.User.Descriptor = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlement().Schema, nil)
+	resourceData.Set("origin", "aad")
+	resourceData.Set("origin_id", originID)
+	resourceData.Set("account_license_type", "stakeholder")
+
+	memberEntitlementClient.
+		EXPECT().
+		AddUserEntitlement(gomock.Any(), gomock.Any()).
+		Return(&memberentitlementmanagement.UserEntitlementsPostResponse{
+			IsSuccess:       converter.Bool(true),
+			UserEntitlement: invitedUserEntitlement,
+		}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(gomock.Any(), memberentitlementmanagement.GetUserEntitlementArgs{UserId: &id}).
+		Return(nil, fmt.Errorf("GetUserEntitlement() Failed")).
+		Times(1)
+
+	err := resourceUserEntitlementCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetUserEntitlement() Failed")
+	require.Equal(t, id.String(), resourceData.Id())
+}
+
+// TestUserEntitlement_RefreshFunc_TestPendingUntilIdentityExists verifies that a user unknown to the identity service is pending
+func TestUserEntitlement_RefreshFunc_TestPendingUntilIdentityExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		IdentityClient:                identityClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(gomock.Any(), gomock.Any()).
+		Return(getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", uuid.NewString(), "", "aad.guest"), nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(gomock.Any(), gomock.Any()).
+		Return(&[]identity.Identity{{}}, nil).
+		Times(1)
+
+	_, state, err := userEntitlementRefreshFunc(clients, &id)()
+	require.Nil(t, err)
+	require.Equal(t, userEntitlementPending, state)
+}
+
 func getMockUserEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, descriptor string) *memberentitlementmanagement.UserEntitlement {
 	subjectKind := "user"
 	licensingSource := licensing.LicensingSourceValues.Account
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -20,6 +21,9 @@ func ResourceGitPermissions() *schema.Resource {
 		Read:   resourceGitPermissionsRead,
 		Update: resourceGitPermissionsCreateOrUpdate,
 		Delete: resourceGitPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitPermissionsImport,
+		},
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -97,6 +101,69 @@ func resourceGitPermissionsDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceGitPermissionsImport imports the explicitly allowed and denied permissions of a principal. The ID has the
+// format <project_id>[/<repository_id>[/<branch_name>]]/<principal>
+func resourceGitPermissionsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	if err := parseGitPermissionsImportID(d); err != nil {
+		return nil, err
+	}
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := d.Get("principal").(string)
+	principalPermissions, err := sn.GetPrincipalPermissions(&[]string{principal})
+	if err != nil {
+		return nil, fmt.Errorf(" reading permissions of principal %s: %v", principal, err)
+	}
+
+	permissions := map[string]string{}
+	if principalPermissions != nil {
+		for _, principalPermission := range *principalPermissions {
+			for action, permission := range principalPermission.Permissions {
+				if permission != securityhelper.PermissionTypeValues.NotSet {
+					permissions[string(action)] = string(permission)
+				}
+			}
+		}
+	}
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf(" No permissions are set for principal %s on ACL token %s", principal, sn.GetToken())
+	}
+
+	d.Set("permissions", permissions)
+	d.Set("replace", true)
+	d.SetId(fmt.Sprintf("%s/%s", sn.GetToken(), principal))
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseGitPermissionsImportID(d *schema.ResourceData) error {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[len(parts)-1] == "" {
+		return fmt.Errorf(" Unexpected format of import ID %q, expected <project_id>[/<repository_id>[/<branch_name>]]/<principal>", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return fmt.Errorf(" Project ID %q of import ID %q is not a UUID", parts[0], d.Id())
+	}
+
+	d.Set("project_id", parts[0])
+	d.Set("principal", parts[len(parts)-1])
+	if len(parts) > 2 {
+		if _, err := uuid.Parse(parts[1]); err != nil {
+			return fmt.Errorf(" Repository ID %q of import ID %q is not a UUID", parts[1], d.Id())
+		}
+		d.Set("repository_id", parts[1])
+	}
+	if len(parts) > 3 {
+		d.Set("branch_name", strings.Join(parts[2:len(parts)-1], "/"))
+	}
+	return nil
+}
+
 func createGitToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
 	projectID, ok := d.GetOk("project_id")
 	if !ok {
@@ -99,6 +99,37 @@ func TestGitPermissions_CreateGitTokenWithBranch(t *testing.T) {
 	assert.Equal(t, gitTokenSubBranch, token)
 }
 
+func TestGitPermissions_ParseImportID(t *testing.T) {
+	principal := "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5"
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+	d.SetId(gitProjectID + "/" + principal)
+	assert.Nil(t, parseGitPermissionsImportID(d))
+	assert.Equal(t, gitProjectID, d.Get("project_id"))
+	assert.Equal(t, principal, d.Get("principal"))
+	assert.Empty(t, d.Get("repository_id"))
+
+	d = schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+	d.SetId(gitProjectID + "/" + gitRepositoryID + "/" + principal)
+	assert.Nil(t, parseGitPermissionsImportID(d))
+	assert.Equal(t, gitRepositoryID, d.Get("repository_id"))
+	assert.Empty(t, d.Get("branch_name"))
+
+	d = schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+	d.SetId(gitProjectID + "/" + gitRepositoryID + "/" + gitBranchNameValid + "/" + gitSubBranchNameValid + "/" + principal)
+	assert.Nil(t, parseGitPermissionsImportID(d))
+	assert.Equal(t, gitBranchNameValid+"/"+gitSubBranchNameValid, d.Get("branch_name"))
+	token, err := createGitToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, gitTokenSubBranch, token)
+
+	for _, id := range []string{principal, gitProjectID + "/", "not-a-uuid/" + principal, gitProjectID + "/not-a-uuid/" + principal} {
+		d = schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+		d.SetId(id)
+		assert.NotNil(t, parseGitPermissionsImportID(d), id)
+	}
+}
+
 func encodeBranchName(branchName string) string {
 	ret, _ := converter.EncodeUtf16HexString(branchName)
 	return ret
@@ -246,7 +246,9 @@ func expandSettings(d *schema.ResourceData) (map[string]interface{}, error) {
 				scopeSetting["matchKind"] = matchType
 			}
 		}
-		if strings.EqualFold(scopeSetting["matchKind"].(string), matchTypeDefaultBranch) && (scopeSetting["repositoryId"] != nil || scopeSetting["refName"] != nil) {
+		// a default branch scope applies to the default branch of every repository in the project
+		matchKind, _ := scopeSetting["matchKind"].(string)
+		if strings.EqualFold(matchKind, matchTypeDefaultBranch) && (scopeSetting["repositoryId"] != nil || scopeSetting["refName"] != nil) {
 			return nil, fmt.Errorf(" neither 'repository_id' nor 'repository_ref' can be set when 'match_type=DefaultBranch'")
 		}
 		scopes[index] = scopeSetting
@@ -294,7 +296,7 @@ func genPolicyReadFunc(crudArgs *policyCrudArgs) schema.ReadFunc { //nolint:stat
 			ConfigurationId: &policyID,
 		})
 
-		if utils.ResponseWasNotFound(err) || (policyConfig != nil && *policyConfig.IsDeleted) {
+		if utils.ResponseWasNotFound(err) || (policyConfig != nil && converter.ToBool(policyConfig.IsDeleted, false)) {
 			d.SetId("")
 			return nil
 		}
@@ -165,3 +165,68 @@ func TestBranchPolicyCRUD_DeleteError_NotSwallowed(t *testing.T) {
 	err := testResource.Delete(resourceData, clients)
 	require.Regexp(t, ".*DeletePolicyConfiguration\\(\\) Failed$", err.Error())
 }
+
+// verifies that a default branch scope without repository applies to the whole project
+func TestBranchPolicyCRUD_Expand_ProjectWideDefaultBranch(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.Set(SchemaProjectID, projectID)
+	resourceData.Set(SchemaSettings, []interface{}{
+		map[string]interface{}{
+			SchemaScope: []interface{}{
+				map[string]interface{}{SchemaMatchType: matchTypeDefaultBranch},
+			},
+		},
+	})
+
+	expandedPolicy, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{
+			"repositoryId": nil,
+			"refName":      nil,
+			"matchKind":    matchTypeDefaultBranch,
+		},
+	}, expandedPolicy.Settings.(map[string]interface{})[SchemaScope])
+
+	flattened := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	expandedPolicy.Id = converter.Int(1)
+	require.Nil(t, baseFlattenFunc(flattened, expandedPolicy, &projectID))
+	require.Equal(t, "", flattened.Get("settings.0.scope.0.repository_id"))
+	require.Equal(t, matchTypeDefaultBranch, flattened.Get("settings.0.scope.0.match_type"))
+}
+
+// verifies that a default branch scope cannot be limited to a repository or ref
+func TestBranchPolicyCRUD_Expand_DefaultBranchWithRepository(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.Set(SchemaProjectID, projectID)
+	resourceData.Set(SchemaSettings, []interface{}{
+		map[string]interface{}{
+			SchemaScope: []interface{}{
+				map[string]interface{}{
+					SchemaRepositoryID: "test-repo-id",
+					SchemaMatchType:    "defaultbranch",
+				},
+			},
+		},
+	})
+
+	_, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "match_type=DefaultBranch")
+}
+
+// verifies that a scope without match type does not fail the expand
+func TestBranchPolicyCRUD_Expand_EmptyMatchType(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.Set(SchemaProjectID, projectID)
+	resourceData.Set(SchemaSettings, []interface{}{
+		map[string]interface{}{
+			SchemaScope: []interface{}{
+				map[string]interface{}{SchemaRepositoryRef: "refs/heads/main"},
+			},
+		},
+	})
+
+	_, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+}
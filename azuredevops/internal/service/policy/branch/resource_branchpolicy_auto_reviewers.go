@@ -1,13 +1,19 @@
 package branch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 type autoReviewerPolicySettings struct {
@@ -19,11 +25,13 @@ type autoReviewerPolicySettings struct {
 }
 
 const (
-	autoReviewerIds        = "auto_reviewer_ids"
-	pathFilters            = "path_filters"
-	displayMessage         = "message"
-	schemaSubmitterCanVote = "submitter_can_vote"
-	minimumApproverCount   = "minimum_number_of_reviewers"
+	autoReviewerIds                 = "auto_reviewer_ids"
+	autoReviewerDescriptors         = "auto_reviewer_descriptors"
+	schemaAutoReviewerDescriptorIds = "auto_reviewer_descriptor_ids"
+	pathFilters                     = "path_filters"
+	displayMessage                  = "message"
+	schemaSubmitterCanVote          = "submitter_can_vote"
+	minimumApproverCount            = "minimum_number_of_reviewers"
 )
 
 // ResourceBranchPolicyAutoReviewers schema and implementation for automatic code reviewer policy resource
@@ -37,11 +45,21 @@ func ResourceBranchPolicyAutoReviewers() *schema.Resource {
 	settingsSchema := resource.Schema[SchemaSettings].Elem.(*schema.Resource).Schema
 	settingsSchema[autoReviewerIds] = &schema.Schema{
 		Type:     schema.TypeList,
-		Required: true,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		AtLeastOneOf: []string{"settings.0." + autoReviewerIds, "settings.0." + autoReviewerDescriptors},
+	}
+	settingsSchema[autoReviewerDescriptors] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
 		Elem: &schema.Schema{
 			Type:         schema.TypeString,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		AtLeastOneOf: []string{"settings.0." + autoReviewerIds, "settings.0." + autoReviewerDescriptors},
 	}
 	settingsSchema[pathFilters] = &schema.Schema{
 		Type:     schema.TypeList,
@@ -68,9 +86,132 @@ func ResourceBranchPolicyAutoReviewers() *schema.Resource {
 		ValidateFunc: validation.IntAtLeast(1),
 	}
 
+	// the identity IDs the reviewer descriptors were resolved to, policies only accept identity IDs
+	resource.Schema[schemaAutoReviewerDescriptorIds] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if d.HasChange("settings.0." + autoReviewerDescriptors) {
+			return d.SetNewComputed(schemaAutoReviewerDescriptorIds)
+		}
+		return nil
+	}
+
+	create := resource.Create
+	resource.Create = func(d *schema.ResourceData, m interface{}) error {
+		if err := resolveAutoReviewerDescriptors(d, m.(*client.AggregatedClient)); err != nil {
+			return err
+		}
+		return create(d, m)
+	}
+	update := resource.Update
+	resource.Update = func(d *schema.ResourceData, m interface{}) error {
+		if err := resolveAutoReviewerDescriptors(d, m.(*client.AggregatedClient)); err != nil {
+			return err
+		}
+		return update(d, m)
+	}
+	read := resource.Read
+	resource.Read = func(d *schema.ResourceData, m interface{}) error {
+		// an imported policy has no settings in its state yet, so nothing is known about which reviewers are descriptors
+		imported := len(d.Get(SchemaSettings).([]interface{})) == 0
+		if err := read(d, m); err != nil {
+			return err
+		}
+		if imported && d.Id() != "" {
+			return resolveAutoReviewerIds(d, m.(*client.AggregatedClient))
+		}
+		return nil
+	}
 	return resource
 }
 
+// resolveAutoReviewerDescriptors looks up the identity IDs of the configured reviewer descriptors
+func resolveAutoReviewerDescriptors(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	descriptors := tfhelper.ExpandStringList(d.Get("settings.0." + autoReviewerDescriptors).([]interface{}))
+	if len(descriptors) == 0 {
+		return d.Set(schemaAutoReviewerDescriptorIds, nil)
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(strings.Join(descriptors, ",")),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading identities of auto reviewers: %+v", err)
+	}
+
+	descriptorIds := map[string]interface{}{}
+	if identities != nil {
+		for _, item := range *identities {
+			if item.SubjectDescriptor != nil && item.Id != nil {
+				descriptorIds[*item.SubjectDescriptor] = item.Id.String()
+			}
+		}
+	}
+	for _, descriptor := range descriptors {
+		if _, ok := descriptorIds[descriptor]; !ok {
+			return fmt.Errorf("Unable to find identity of auto reviewer with descriptor %s", descriptor)
+		}
+	}
+	return d.Set(schemaAutoReviewerDescriptorIds, descriptorIds)
+}
+
+// resolveAutoReviewerIds looks up the descriptors of the policy's reviewers, so an imported policy reports them as descriptors
+func resolveAutoReviewerIds(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	settingsList := d.Get(SchemaSettings).([]interface{})
+	if len(settingsList) == 0 {
+		return nil
+	}
+	settings := settingsList[0].(map[string]interface{})
+	ids := tfhelper.ExpandStringList(settings[autoReviewerIds].([]interface{}))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		IdentityIds: converter.String(strings.Join(ids, ",")),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading identities of auto reviewers: %+v", err)
+	}
+
+	descriptorIds := map[string]interface{}{}
+	if identities != nil {
+		for _, item := range *identities {
+			if item.SubjectDescriptor != nil && item.Id != nil {
+				descriptorIds[*item.SubjectDescriptor] = item.Id.String()
+			}
+		}
+	}
+	if err := d.Set(schemaAutoReviewerDescriptorIds, descriptorIds); err != nil {
+		return err
+	}
+
+	settings[autoReviewerIds], settings[autoReviewerDescriptors] = splitAutoReviewers(ids, descriptorIds)
+	return d.Set(SchemaSettings, settingsList)
+}
+
+// splitAutoReviewers separates the reviewers given by a resolved descriptor from the ones given by identity ID
+func splitAutoReviewers(ids []string, descriptorIds map[string]interface{}) (reviewerIds []string, reviewerDescriptors []string) {
+	descriptorsByID := map[string]string{}
+	for descriptor, id := range descriptorIds {
+		descriptorsByID[strings.ToLower(id.(string))] = descriptor
+	}
+	for _, id := range ids {
+		if descriptor, ok := descriptorsByID[strings.ToLower(id)]; ok {
+			reviewerDescriptors = append(reviewerDescriptors, descriptor)
+		} else {
+			reviewerIds = append(reviewerIds, id)
+		}
+	}
+	return reviewerIds, reviewerDescriptors
+}
+
 func autoReviewersFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
 	err := baseFlattenFunc(d, policyConfig, projectID)
 	if err != nil {
@@ -91,7 +232,7 @@ func autoReviewersFlattenFunc(d *schema.ResourceData, policyConfig *policy.Polic
 	settings := settingsList[0].(map[string]interface{})
 
 	settings[schemaSubmitterCanVote] = policySettings.SubmitterCanVote
-	settings[autoReviewerIds] = policySettings.AutoReviewerIds
+	settings[autoReviewerIds], settings[autoReviewerDescriptors] = splitAutoReviewers(policySettings.AutoReviewerIds, d.Get(schemaAutoReviewerDescriptorIds).(map[string]interface{}))
 	settings[pathFilters] = policySettings.PathFilters
 	settings[displayMessage] = policySettings.DisplayMessage
 	settings[minimumApproverCount] = policySettings.MinimumApproverCount
@@ -118,6 +259,14 @@ func autoReviewersExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.
 		for _, item := range value.([]interface{}) {
 			reviewersID = append(reviewersID, item.(string))
 		}
+		descriptorIds := d.Get(schemaAutoReviewerDescriptorIds).(map[string]interface{})
+		for _, item := range settings[autoReviewerDescriptors].([]interface{}) {
+			id, ok := descriptorIds[item.(string)]
+			if !ok {
+				return nil, nil, fmt.Errorf("Identity of auto reviewer with descriptor %s has not been resolved", item)
+			}
+			reviewersID = append(reviewersID, id.(string))
+		}
 		policySettings["requiredReviewerIds"] = reviewersID
 	}
 
@@ -5,13 +5,18 @@
 package branch
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 
 	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
@@ -51,3 +56,120 @@ func TestBranchPolicyAutoReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that reviewer descriptors are resolved to identity IDs and kept as descriptors in the state
+func TestBranchPolicyAutoReviewers_ResolveDescriptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	groupID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyAutoReviewers().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("settings", []interface{}{
+		map[string]interface{}{
+			"auto_reviewer_ids":         []interface{}{"some-user"},
+			"auto_reviewer_descriptors": []interface{}{"vssgp.group"},
+			"scope": []interface{}{
+				map[string]interface{}{"match_type": "DefaultBranch"},
+			},
+		},
+	})
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.group")}).
+		Return(&[]identity.Identity{{Id: &groupID, SubjectDescriptor: converter.String("vssgp.group")}}, nil).
+		Times(1)
+
+	err := resolveAutoReviewerDescriptors(resourceData, clients)
+	require.Nil(t, err)
+
+	expandedPolicy, projectID, err := autoReviewersExpandFunc(resourceData, AutoReviewers)
+	require.Nil(t, err)
+	require.Equal(t, []string{"some-user", groupID.String()}, expandedPolicy.Settings.(map[string]interface{})["requiredReviewerIds"])
+
+	expandedPolicy.Id = converter.Int(1)
+	err = autoReviewersFlattenFunc(resourceData, expandedPolicy, projectID)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"some-user"}, resourceData.Get("settings.0.auto_reviewer_ids"))
+	require.Equal(t, []interface{}{"vssgp.group"}, resourceData.Get("settings.0.auto_reviewer_descriptors"))
+}
+
+// verifies that unknown reviewer descriptors result in an error
+func TestBranchPolicyAutoReviewers_ResolveDescriptors_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyAutoReviewers().Schema, nil)
+	resourceData.Set("settings", []interface{}{
+		map[string]interface{}{
+			"auto_reviewer_descriptors": []interface{}{"aad.unknown"},
+		},
+	})
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{}, nil).
+		Times(1)
+
+	err := resolveAutoReviewerDescriptors(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "aad.unknown")
+}
+
+// verifies that the reviewers of an imported policy are reported as descriptors where they can be resolved
+func TestBranchPolicyAutoReviewers_Read_ResolvesIdsAfterImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	groupID := uuid.New()
+	userID := uuid.New()
+	r := ResourceBranchPolicyAutoReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("1")
+	resourceData.Set("project_id", projectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         &projectID,
+			ConfigurationId: converter.Int(1),
+		}).
+		Return(&policy.PolicyConfiguration{
+			Id:         converter.Int(1),
+			IsEnabled:  converter.Bool(true),
+			IsBlocking: converter.Bool(true),
+			Type:       &policy.PolicyTypeRef{Id: &AutoReviewers},
+			Settings: map[string]interface{}{
+				"scope":                []map[string]interface{}{{"matchKind": "DefaultBranch"}},
+				"requiredReviewerIds":  []string{groupID.String(), userID.String()},
+				"minimumApproverCount": 1,
+			},
+		}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			IdentityIds: converter.String(groupID.String() + "," + userID.String()),
+		}).
+		Return(&[]identity.Identity{{Id: &groupID, SubjectDescriptor: converter.String("vssgp.group")}}, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{userID.String()}, resourceData.Get("settings.0.auto_reviewer_ids"))
+	require.Equal(t, []interface{}{"vssgp.group"}, resourceData.Get("settings.0.auto_reviewer_descriptors"))
+	require.Equal(t, map[string]interface{}{"vssgp.group": groupID.String()}, resourceData.Get("auto_reviewer_descriptor_ids"))
+}
@@ -22,7 +22,7 @@ const (
 
 type buildValidationPolicySettings struct {
 	BuildDefinitionID       int      `json:"buildDefinitionId"`
-	PolicyDisplayName       string   `json:"displayName"`
+	PolicyDisplayName       string   `json:"displayName,omitempty"`
 	ManualQueueOnly         bool     `json:"manualQueueOnly"`
 	QueueOnSourceUpdateOnly bool     `json:"queueOnSourceUpdateOnly"`
 	ValidDuration           int      `json:"validDuration"`
@@ -39,12 +39,13 @@ func ResourceBranchPolicyBuildValidation() *schema.Resource {
 
 	settingsSchema := resource.Schema[SchemaSettings].Elem.(*schema.Resource).Schema
 	settingsSchema[buildDefinitionID] = &schema.Schema{
-		Type:     schema.TypeInt,
-		Required: true,
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
 	}
 	settingsSchema[policyDisplayName] = &schema.Schema{
 		Type:         schema.TypeString,
-		Required:     true,
+		Optional:     true,
 		ValidateFunc: validation.StringIsNotEmpty,
 	}
 	settingsSchema[manualQueueOnly] = &schema.Schema{
@@ -115,7 +116,10 @@ func buildValidationExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*polic
 	policySettings := policyConfig.Settings.(map[string]interface{})
 
 	policySettings["buildDefinitionId"] = settings[buildDefinitionID].(int)
-	policySettings["displayName"] = settings[policyDisplayName].(string)
+	// without a display name the policy is shown with the name of the build definition
+	if displayName := settings[policyDisplayName].(string); displayName != "" {
+		policySettings["displayName"] = displayName
+	}
 	policySettings["manualQueueOnly"] = settings[manualQueueOnly].(bool)
 	policySettings["queueOnSourceUpdateOnly"] = settings[queueOnSourceUpdateOnly].(bool)
 	policySettings["validDuration"] = settings[validDuration].(int)
@@ -52,3 +52,24 @@ func TestBranchPolicyBuildValidation_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that the display name is omitted from the policy settings if it is not configured
+func TestBranchPolicyBuildValidation_Expand_WithoutDisplayName(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyBuildValidation().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("settings", []interface{}{
+		map[string]interface{}{
+			"build_definition_id": 77,
+			"scope": []interface{}{
+				map[string]interface{}{"match_type": "DefaultBranch"},
+			},
+		},
+	})
+
+	expandedPolicy, _, err := buildValidationExpandFunc(resourceData, uuid.New())
+	require.Nil(t, err)
+
+	policySettings := expandedPolicy.Settings.(map[string]interface{})
+	require.Equal(t, 77, policySettings["buildDefinitionId"])
+	require.NotContains(t, policySettings, "displayName")
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 )
 
-// ResourceBranchPolicyCommentResolution schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyCommentResolution schema and implementation for comment resolution policy resource
 func ResourceBranchPolicyCommentResolution() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: commentResolutionFlattenFunc,
@@ -1,9 +1,11 @@
 package branch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,7 +20,7 @@ type mergeTypePolicySettings struct {
 	AllowRebaseMerge   bool `json:"allowRebaseMerge" tf:"allow_rebase_with_merge"`
 }
 
-// ResourceBranchPolicyMinReviewers schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyMergeTypes schema and implementation for merge types policy resource
 func ResourceBranchPolicyMergeTypes() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: mergeTypesFlattenFunc,
@@ -44,9 +46,24 @@ func ResourceBranchPolicyMergeTypes() *schema.Resource {
 			}
 		}
 	}
+	resource.CustomizeDiff = validateMergeTypes
 	return resource
 }
 
+// validateMergeTypes ensures that the policy allows at least one merge type, otherwise pull requests cannot be completed
+func validateMergeTypes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	metaField := reflect.TypeOf(mergeTypePolicySettings{})
+	tfNames := make([]string, metaField.NumField())
+	for i := 0; i < metaField.NumField(); i++ {
+		key := fmt.Sprintf("%s.0.%s", SchemaSettings, metaField.Field(i).Tag.Get("tf"))
+		if !d.NewValueKnown(key) || d.Get(key).(bool) {
+			return nil
+		}
+		tfNames[i] = metaField.Field(i).Tag.Get("tf")
+	}
+	return fmt.Errorf(" At least one of %s must be set to true", strings.Join(tfNames, ", "))
+}
+
 // API to TF
 func mergeTypesFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
 	err := baseFlattenFunc(d, policyConfig, projectID)
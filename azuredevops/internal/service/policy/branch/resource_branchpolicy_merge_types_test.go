@@ -5,9 +5,11 @@
 package branch
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
 	"github.com/google/uuid"
@@ -50,3 +52,26 @@ func TestBranchPolicyMergeTypes_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that a policy which does not allow any merge type is rejected during planning
+func TestBranchPolicyMergeTypes_Diff_RequiresMergeType(t *testing.T) {
+	resource := ResourceBranchPolicyMergeTypes()
+	config := map[string]interface{}{
+		"project_id": uuid.New().String(),
+		"settings": []interface{}{
+			map[string]interface{}{
+				"scope": []interface{}{
+					map[string]interface{}{"match_type": "DefaultBranch"},
+				},
+			},
+		},
+	}
+
+	_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "At least one of allow_squash")
+
+	config["settings"].([]interface{})[0].(map[string]interface{})["allow_squash"] = true
+	_, err = resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+}
@@ -66,20 +66,25 @@ func ResourceBranchPolicyMinReviewers() *schema.Resource {
 
 // API to TF
 func minReviewersFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	// resetting all votes implies resetting the approved votes, which is therefore only kept if it was configured
+	policySettings := policyConfig.Settings.(map[string]interface{})
+	resetApprovedVotes, _ := policySettings["resetOnSourcePush"].(bool)
+	if resetAllVotes, _ := policySettings["resetRejectionsOnSourcePush"].(bool); resetAllVotes {
+		resetApprovedVotes = resetApprovedVotes && d.Get("settings.0.on_push_reset_approved_votes").(bool)
+	}
+
 	err := baseFlattenFunc(d, policyConfig, projectID)
 	if err != nil {
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
-
 	settingsList := d.Get(SchemaSettings).([]interface{})
 	settings := settingsList[0].(map[string]interface{})
 
 	settings["reviewer_count"] = policySettings["minimumApproverCount"]
 	settings["submitter_can_vote"] = policySettings["creatorVoteCounts"]
 	settings["allow_completion_with_rejects_or_waits"] = policySettings["allowDownvotes"]
-	settings["on_push_reset_approved_votes"] = policySettings["resetOnSourcePush"]
+	settings["on_push_reset_approved_votes"] = resetApprovedVotes
 	settings["on_last_iteration_require_vote"] = policySettings["requireVoteOnLastIteration"]
 	settings["on_push_reset_all_votes"] = policySettings["resetRejectionsOnSourcePush"]
 	settings["last_pusher_cannot_approve"] = policySettings["blockLastPusherVote"]
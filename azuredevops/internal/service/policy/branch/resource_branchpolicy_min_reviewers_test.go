@@ -53,3 +53,34 @@ func TestBranchPolicyMinReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that resetting all votes on push does not mark the approved votes reset as changed
+func TestBranchPolicyMinReviewers_Flatten_ResetAllVotes(t *testing.T) {
+	var projectID = uuid.New().String()
+	var testPolicy = &policy.PolicyConfiguration{
+		Id: converter.Int(1),
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"matchKind": "DefaultBranch",
+				},
+			},
+			"minimumApproverCount":        1,
+			"resetOnSourcePush":           true,
+			"resetRejectionsOnSourcePush": true,
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyMinReviewers().Schema, nil)
+	err := minReviewersFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("settings.0.on_push_reset_all_votes").(bool))
+	require.False(t, resourceData.Get("settings.0.on_push_reset_approved_votes").(bool))
+
+	settingsList := resourceData.Get("settings").([]interface{})
+	settingsList[0].(map[string]interface{})["on_push_reset_approved_votes"] = true
+	resourceData.Set("settings", settingsList)
+	err = minReviewersFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("settings.0.on_push_reset_approved_votes").(bool))
+}
@@ -17,6 +17,7 @@ var applicability = policyApplicability{
 	Conditional: "conditional",
 }
 
+// ResourceBranchPolicyStatusCheck schema and implementation for the external status check policy resource
 func ResourceBranchPolicyStatusCheck() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: statusCheckFlattenFunc,
@@ -27,12 +28,14 @@ func ResourceBranchPolicyStatusCheck() *schema.Resource {
 	settingsSchema := resource.Schema[SchemaSettings].Elem.(*schema.Resource).Schema
 
 	settingsSchema["name"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Required: true,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
 	}
 	settingsSchema["genre"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
 	}
 	settingsSchema["author_id"] = &schema.Schema{
 		Type:         schema.TypeString,
@@ -94,7 +97,7 @@ func statusCheckFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyC
 
 	settings["applicability"] = applicability.Default
 	if policyApplicability, ok := policySettings["policyApplicability"]; ok {
-		if policyApplicability != nil && policyApplicability.(float64) == 1 {
+		if value, ok := policyApplicability.(float64); ok && value == 1 {
 			settings["applicability"] = applicability.Conditional
 		}
 	}
@@ -113,8 +116,13 @@ func statusCheckExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Po
 
 	policySettings := policyConfig.Settings.(map[string]interface{})
 	policySettings["statusName"] = settings["name"].(string)
-	policySettings["statusGenre"] = settings["genre"].(string)
-	policySettings["authorId"] = settings["author_id"].(string)
+	// the genre and the authorized identity are optional and omitted if not set, as an empty author is not a valid ID
+	if genre := settings["genre"].(string); genre != "" {
+		policySettings["statusGenre"] = genre
+	}
+	if authorID := settings["author_id"].(string); authorID != "" {
+		policySettings["authorId"] = authorID
+	}
 	policySettings["invalidateOnSourceUpdate"] = settings["invalidate_on_update"].(bool)
 	policySettings["defaultDisplayName"] = settings["display_name"].(string)
 
@@ -0,0 +1,90 @@
+//go:build (all || resource_branchpolicy_status_check) && !exclude_resource_branchpolicy_status_check
+// +build all resource_branchpolicy_status_check
+// +build !exclude_resource_branchpolicy_status_check
+
+package branch
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// verifies that the flatten/expand round trip path produces repeatable results
+func TestBranchPolicyStatusCheck_ExpandFlatten_Roundtrip(t *testing.T) {
+	var projectID = uuid.New().String()
+	var randomUUID = uuid.New()
+	var authorID = uuid.New().String()
+	var testPolicy = &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": "test-repo-id",
+					"refName":      "test-ref-name",
+					"matchKind":    "test-match-kind",
+				},
+			},
+			"statusName":               "build",
+			"statusGenre":              "github-actions",
+			"authorId":                 authorID,
+			"invalidateOnSourceUpdate": true,
+			"defaultDisplayName":       "GitHub build",
+			"filenamePatterns":         []string{"/src/*"},
+			"policyApplicability":      1,
+		},
+	}
+
+	// the service returns the settings decoded from JSON
+	flattenPolicy := *testPolicy
+	flattenPolicy.Settings = map[string]interface{}{}
+	for key, value := range testPolicy.Settings.(map[string]interface{}) {
+		flattenPolicy.Settings.(map[string]interface{})[key] = value
+	}
+	flattenPolicy.Settings.(map[string]interface{})["filenamePatterns"] = []interface{}{"/src/*"}
+	flattenPolicy.Settings.(map[string]interface{})["policyApplicability"] = float64(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyStatusCheck().Schema, nil)
+	err := statusCheckFlattenFunc(resourceData, &flattenPolicy, &projectID)
+	require.Nil(t, err)
+	require.Equal(t, "conditional", resourceData.Get("settings.0.applicability"))
+
+	expandedPolicy, expandedProjectID, err := statusCheckExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+
+	require.Equal(t, testPolicy, expandedPolicy)
+	require.Equal(t, projectID, *expandedProjectID)
+}
+
+// verifies that the optional genre and author are not sent if they are not configured
+func TestBranchPolicyStatusCheck_Expand_OmitsOptionalSettings(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyStatusCheck().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("settings", []interface{}{
+		map[string]interface{}{
+			"name": "build",
+			"scope": []interface{}{
+				map[string]interface{}{"match_type": "DefaultBranch"},
+			},
+		},
+	})
+
+	expandedPolicy, _, err := statusCheckExpandFunc(resourceData, uuid.New())
+	require.Nil(t, err)
+
+	policySettings := expandedPolicy.Settings.(map[string]interface{})
+	require.Equal(t, "build", policySettings["statusName"])
+	require.NotContains(t, policySettings, "statusGenre")
+	require.NotContains(t, policySettings, "authorId")
+	require.NotContains(t, policySettings, "policyApplicability")
+}
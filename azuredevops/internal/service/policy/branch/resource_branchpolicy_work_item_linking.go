@@ -7,7 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 )
 
-// ResourceBranchPolicyWorkItemLinking schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyWorkItemLinking schema and implementation for work item linking policy resource
 func ResourceBranchPolicyWorkItemLinking() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: workItemLinkingFlattenFunc,
@@ -117,6 +117,14 @@ func flattenSettings(policyConfig *policy.PolicyConfiguration) ([]interface{}, e
 	return repoIds, nil
 }
 
+// getPolicySettings returns the settings of a policy configuration, which are empty if the service omitted them
+func getPolicySettings(policyConfig *policy.PolicyConfiguration) map[string]interface{} {
+	if policySettings, ok := policyConfig.Settings.(map[string]interface{}); ok {
+		return policySettings
+	}
+	return map[string]interface{}{}
+}
+
 // baseExpandFunc expands each of the base elements of the schema
 func baseExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
 	projectID := d.Get("project_id").(string)
@@ -202,7 +210,7 @@ func genPolicyReadFunc(crudArgs *policyCrudArgs) schema.ReadFunc { //nolint:stat
 			ConfigurationId: &policyID,
 		})
 
-		if utils.ResponseWasNotFound(err) || (policyConfig != nil && *policyConfig.IsDeleted) {
+		if utils.ResponseWasNotFound(err) || (policyConfig != nil && converter.ToBool(policyConfig.IsDeleted, false)) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,140 @@
+//go:build (all || policy) && !exclude_policy
+// +build all policy
+// +build !exclude_policy
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var projectID = uuid.New().String()
+
+// verifies that a policy without repositories is scoped to the whole project
+func TestRepositoryPolicy_Expand_ProjectScope(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryPolicyAuthorEmailPatterns().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("author_email_patterns", []interface{}{"*@contoso.com"})
+
+	policyConfig, _, err := authorEmailPatternExpandFunc(resourceData, AuthorEmailPattern)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{
+		"scope":               []map[string]interface{}{{"repositoryId": ""}},
+		"authorEmailPatterns": []interface{}{"*@contoso.com"},
+	}, policyConfig.Settings)
+}
+
+// verifies that the repository scope and file path patterns survive the flatten/expand round trip
+func TestRepositoryPolicy_FilePathPattern_Roundtrip(t *testing.T) {
+	repoID := uuid.New().String()
+	policyConfig := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Settings: map[string]interface{}{
+			"scope":            []interface{}{map[string]interface{}{"repositoryId": repoID}},
+			"filenamePatterns": []interface{}{"*.exe", "/secrets/*"},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryFilePathPatterns().Schema, nil)
+	err := filePathPatternFlattenFunc(resourceData, policyConfig, &projectID)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{repoID}, resourceData.Get("repository_ids"))
+	require.Equal(t, []interface{}{"*.exe", "/secrets/*"}, resourceData.Get("filepath_patterns"))
+	require.False(t, resourceData.Get("blocking").(bool))
+
+	expanded, expandedProjectID, err := filePathPatternExpandFunc(resourceData, FilePathPattern)
+	require.Nil(t, err)
+	require.Equal(t, projectID, *expandedProjectID)
+	require.Equal(t, 1, *expanded.Id)
+	require.Equal(t, FilePathPattern, *expanded.Type.Id)
+	require.Equal(t, []interface{}{"*.exe", "/secrets/*"}, expanded.Settings.(map[string]interface{})["filenamePatterns"])
+}
+
+// verifies that policies returned without settings do not fail the flatten
+func TestRepositoryPolicy_Flatten_MissingSettings(t *testing.T) {
+	policyConfig := &policy.PolicyConfiguration{Id: converter.Int(1)}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryEnforceConsistentCase().Schema, nil)
+	require.Nil(t, enforceConsistentCaseFlattenFunc(resourceData, policyConfig, &projectID))
+	require.Equal(t, "1", resourceData.Id())
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceRepositoryFilePathPatterns().Schema, nil)
+	require.Nil(t, filePathPatternFlattenFunc(resourceData, policyConfig, &projectID))
+	require.Empty(t, resourceData.Get("filepath_patterns"))
+}
+
+// verifies that a deleted policy is removed from the state
+func TestRepositoryPolicy_Read_DeletedPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resource := ResourceRepositoryReservedNames()
+	resourceData := schema.TestResourceDataRaw(t, resource.Schema, nil)
+	resourceData.SetId("1")
+	resourceData.Set("project_id", projectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         &projectID,
+			ConfigurationId: converter.Int(1),
+		}).
+		Return(&policy.PolicyConfiguration{Id: converter.Int(1), IsDeleted: converter.Bool(true)}, nil).
+		Times(1)
+
+	err := resource.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the size limits are converted between megabytes and bytes
+func TestRepositoryPolicy_MaxFileSize_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryMaxFileSize().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("max_file_size", 10)
+	resourceData.Set("use_uncompressed_size", true)
+
+	policyConfig, _, err := fileSizeExpandFunc(resourceData, FileSize)
+	require.Nil(t, err)
+	policySettings := policyConfig.Settings.(map[string]interface{})
+	require.Equal(t, 10*UNIT, policySettings["maximumGitBlobSizeInBytes"])
+	require.Equal(t, true, policySettings["useUncompressedSize"])
+
+	// the service returns numbers decoded from JSON
+	policyConfig.Id = converter.Int(1)
+	policySettings["maximumGitBlobSizeInBytes"] = float64(10 * UNIT)
+	flattened := schema.TestResourceDataRaw(t, ResourceRepositoryMaxFileSize().Schema, nil)
+	require.Nil(t, fileSizeFlattenFunc(flattened, policyConfig, &projectID))
+	require.Equal(t, 10, flattened.Get("max_file_size"))
+	require.True(t, flattened.Get("use_uncompressed_size").(bool))
+}
+
+// verifies that the path length policy tolerates missing settings
+func TestRepositoryPolicy_MaxPathLength_Flatten(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryMaxPathLength().Schema, nil)
+	policyConfig := &policy.PolicyConfiguration{
+		Id:       converter.Int(1),
+		Settings: map[string]interface{}{"maxPathLength": float64(260)},
+	}
+	require.Nil(t, pathLengthFlattenFunc(resourceData, policyConfig, &projectID))
+	require.Equal(t, 260, resourceData.Get("max_path_length"))
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceRepositoryMaxPathLength().Schema, nil)
+	require.Nil(t, pathLengthFlattenFunc(resourceData, &policy.PolicyConfiguration{Id: converter.Int(1)}, &projectID))
+	require.Equal(t, "1", resourceData.Id())
+}
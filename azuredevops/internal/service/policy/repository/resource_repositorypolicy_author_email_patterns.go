@@ -32,7 +32,7 @@ func authorEmailPatternFlattenFunc(d *schema.ResourceData, policyConfig *policy.
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
+	policySettings := getPolicySettings(policyConfig)
 	_ = d.Set("author_email_patterns", policySettings["authorEmailPatterns"])
 	return nil
 }
@@ -25,7 +25,7 @@ func enforceConsistentCaseFlattenFunc(d *schema.ResourceData, policyConfig *poli
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
+	policySettings := getPolicySettings(policyConfig)
 	_ = d.Set("enforce_consistent_case", policySettings["enforceConsistentCase"])
 	return nil
 }
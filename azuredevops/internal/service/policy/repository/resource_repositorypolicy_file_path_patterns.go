@@ -32,8 +32,8 @@ func filePathPatternFlattenFunc(d *schema.ResourceData, policyConfig *policy.Pol
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
-	_ = d.Set("filepath_patterns", policySettings["filenamePatterns"].([]interface{}))
+	policySettings := getPolicySettings(policyConfig)
+	_ = d.Set("filepath_patterns", policySettings["filenamePatterns"])
 	return nil
 }
 
@@ -21,6 +21,11 @@ func ResourceRepositoryMaxFileSize() *schema.Resource {
 		Required:     true,
 		ValidateFunc: validation.IntInSlice([]int{1, 2, 5, 10, 100, 200}),
 	}
+	resource.Schema["use_uncompressed_size"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 	return resource
 }
 
@@ -30,8 +35,12 @@ func fileSizeFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConf
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
-	_ = d.Set("max_file_size", policySettings["maximumGitBlobSizeInBytes"].(float64)/UNIT)
+	policySettings := getPolicySettings(policyConfig)
+	if maxSize, ok := policySettings["maximumGitBlobSizeInBytes"].(float64); ok {
+		_ = d.Set("max_file_size", int(maxSize/UNIT))
+	}
+	useUncompressedSize, _ := policySettings["useUncompressedSize"].(bool)
+	_ = d.Set("use_uncompressed_size", useUncompressedSize)
 	return nil
 }
 
@@ -43,5 +52,6 @@ func fileSizeExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Polic
 
 	policySettings := policyConfig.Settings.(map[string]interface{})
 	policySettings["maximumGitBlobSizeInBytes"] = d.Get("max_file_size").(int) * UNIT
+	policySettings["useUncompressedSize"] = d.Get("use_uncompressed_size").(bool)
 	return policyConfig, projectID, nil
 }
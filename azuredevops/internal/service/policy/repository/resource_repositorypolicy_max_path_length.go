@@ -28,8 +28,10 @@ func pathLengthFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyCo
 		return err
 	}
 
-	policySettings := policyConfig.Settings.(map[string]interface{})
-	_ = d.Set("max_path_length", policySettings["maxPathLength"].(float64))
+	policySettings := getPolicySettings(policyConfig)
+	if maxPathLength, ok := policySettings["maxPathLength"].(float64); ok {
+		_ = d.Set("max_path_length", int(maxPathLength))
+	}
 	return nil
 }
 
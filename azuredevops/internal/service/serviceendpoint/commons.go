@@ -61,6 +61,31 @@ func baseSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		// secrets cannot be read back from Azure DevOps, so changing this map is the way to
+		// force the service endpoint, including its secrets, to be sent again
+		"rotation_trigger": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"verify_connection": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether or not to verify the connection after create or before update operations",
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The owner of the service endpoint, e.g. library or agentcloud",
+		},
+		"is_shared": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the service endpoint is shared with other projects",
+		},
 	}
 }
 
@@ -97,10 +122,27 @@ func createServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedCli
 		return nil, fmt.Errorf(" waiting for service endpoint ready. %v ", err)
 	}
 
+	// a connection failing the verification is not kept, so the apply can simply be retried
+	if err := verifyServiceEndpoint(d, clients, endpoint, createdServiceEndpoint.Id); err != nil {
+		if delErr := deleteServiceEndpoint(clients, projectID, createdServiceEndpoint.Id, d.Timeout(schema.TimeoutDelete)); delErr != nil {
+			log.Printf("[DEBUG] Failed to delete the unverified service endpoint: %v ", delErr)
+		}
+		return nil, err
+	}
+
 	return createdServiceEndpoint, err
 }
 
-func updateServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
+func updateServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
+	// the new configuration is verified before it is applied, so a working connection is not replaced by a broken one
+	if err := verifyServiceEndpoint(d, clients, endpoint, endpoint.Id); err != nil {
+		return nil, err
+	}
+
+	if err := preserveSharedProjectReferences(clients, endpoint); err != nil {
+		return nil, err
+	}
+
 	updatedServiceEndpoint, err := clients.ServiceEndpointClient.UpdateServiceEndpoint(
 		clients.Ctx,
 		serviceendpoint.UpdateServiceEndpointArgs{
@@ -111,6 +153,35 @@ func updateServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceen
 	return updatedServiceEndpoint, err
 }
 
+// preserveSharedProjectReferences adds the projects the service endpoint is shared with, e.g. by
+// azuredevops_serviceendpoint_share, to the endpoint. An update replaces all project references,
+// so sending only the owning project would unshare the endpoint.
+func preserveSharedProjectReferences(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) error {
+	projectID := (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
+	existing, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(
+		clients.Ctx,
+		serviceendpoint.GetServiceEndpointDetailsArgs{
+			Project:    converter.String(projectID.String()),
+			EndpointId: endpoint.Id,
+		})
+	if err != nil {
+		return fmt.Errorf(" reading the project references of service endpoint %s: %+v", endpoint.Id, err)
+	}
+	if existing == nil || existing.ServiceEndpointProjectReferences == nil {
+		return nil
+	}
+
+	references := *endpoint.ServiceEndpointProjectReferences
+	for _, reference := range *existing.ServiceEndpointProjectReferences {
+		if reference.ProjectReference == nil || reference.ProjectReference.Id == nil || *reference.ProjectReference.Id == *projectID {
+			continue
+		}
+		references = append(references, reference)
+	}
+	endpoint.ServiceEndpointProjectReferences = &references
+	return nil
+}
+
 func deleteServiceEndpoint(clients *client.AggregatedClient, projectID *uuid.UUID, serviceEndpointID *uuid.UUID, timeout time.Duration) error {
 	if err := clients.ServiceEndpointClient.DeleteServiceEndpoint(
 		clients.Ctx,
@@ -139,6 +210,14 @@ func deleteServiceEndpoint(clients *client.AggregatedClient, projectID *uuid.UUI
 	return nil
 }
 
+// verifyServiceEndpoint verifies the connection of the service endpoint if verify_connection is set
+func verifyServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, serviceEndpointID *uuid.UUID) error {
+	if !d.Get("verify_connection").(bool) {
+		return nil
+	}
+	return validateServiceEndpoint(clients, endpoint, converter.String(serviceEndpointID.String()), endpointValidationTimeoutSeconds)
+}
+
 func validateServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, serviceEndpointID *string, retryTimeout time.Duration) error {
 	reqArgs := serviceendpoint.ExecuteServiceEndpointRequestArgs{
 		ServiceEndpointRequest: &serviceendpoint.ServiceEndpointRequest{
@@ -164,9 +243,10 @@ func validateServiceEndpoint(clients *client.AggregatedClient, endpoint *service
 			log.Printf(":: %s :: error during endpoint validation request", *endpoint.Name)
 			return resource.NonRetryableError(err)
 		}
-		if !strings.EqualFold(*reqResult.StatusCode, "ok") {
-			log.Printf(":: %s :: validation failed with StatusCode '%s', retrying...", *endpoint.Name, *reqResult.StatusCode)
-			return resource.RetryableError(fmt.Errorf("Error validating connection: (type: %s, name: %s, code: %s, message: %s)", *endpoint.Type, *endpoint.Name, *reqResult.StatusCode, *reqResult.ErrorMessage))
+		statusCode := converter.ToString(reqResult.StatusCode, "")
+		if !strings.EqualFold(statusCode, "ok") {
+			log.Printf(":: %s :: validation failed with StatusCode '%s', retrying...", *endpoint.Name, statusCode)
+			return resource.RetryableError(fmt.Errorf("Error validating connection: (type: %s, name: %s, code: %s, message: %s)", *endpoint.Type, *endpoint.Name, statusCode, converter.ToString(reqResult.ErrorMessage, "")))
 		}
 		log.Printf(":: %s :: successfully validated connection", *endpoint.Name)
 		return nil
@@ -205,11 +285,13 @@ func checkServiceEndpointStatus(clients *client.AggregatedClient, projectID *uui
 			return nil, opState.Failed, fmt.Errorf(errMsgServiceDelete, endPointID, *projectID, err)
 		}
 		if serviceEndpoint != nil && serviceEndpoint.OperationStatus != nil {
-			opStatus := (serviceEndpoint.OperationStatus).(map[string]interface{})["state"]
-			if opStatus == opState.Failed {
-				return nil, opState.Failed, fmt.Errorf(errMsgServiceDelete, endPointID, *projectID, serviceEndpoint.OperationStatus)
+			state, message := getServiceEndpointOperationStatus(serviceEndpoint)
+			if strings.EqualFold(state, opState.Failed) {
+				return nil, opState.Failed, fmt.Errorf(errMsgServiceDelete, endPointID, *projectID, message)
+			}
+			if state != "" {
+				return serviceendpoint.ServiceEndpoint{}, state, nil
 			}
-			return serviceendpoint.ServiceEndpoint{}, opStatus.(string), nil
 		}
 		return serviceendpoint.ServiceEndpoint{}, opState.Ready, nil
 	}
@@ -228,18 +310,33 @@ func getServiceEndpoint(client *client.AggregatedClient, serviceEndpointID *uuid
 		if err != nil {
 			return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, err)
 		}
+		if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+			return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, "service endpoint not found")
+		}
 
-		if *serviceEndpoint.IsReady {
+		if converter.ToBool(serviceEndpoint.IsReady, false) {
 			return serviceEndpoint, opState.Ready, nil
-		} else if serviceEndpoint.OperationStatus != nil {
-			opStatus := (serviceEndpoint.OperationStatus).(map[string]interface{})["state"]
-			if opStatus == opState.Failed {
-				return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, serviceEndpoint.OperationStatus)
-			}
-			return nil, opStatus.(string), nil
 		}
-		return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, serviceEndpoint.OperationStatus)
+
+		state, message := getServiceEndpointOperationStatus(serviceEndpoint)
+		if strings.EqualFold(state, opState.Failed) {
+			return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, message)
+		}
+		// the endpoint is still being provisioned, e.g. while the service principal of an AzureRM service endpoint
+		// is created. A result has to be returned, since a nil result is treated as a missing endpoint.
+		return serviceEndpoint, opState.InProgress, nil
+	}
+}
+
+// getServiceEndpointOperationStatus returns the state and the status message of the endpoint's asynchronous operation
+func getServiceEndpointOperationStatus(serviceEndpoint *serviceendpoint.ServiceEndpoint) (string, string) {
+	operationStatus, ok := serviceEndpoint.OperationStatus.(map[string]interface{})
+	if !ok {
+		return "", ""
 	}
+	state, _ := operationStatus["state"].(string)
+	message, _ := operationStatus["statusMessage"].(string)
+	return state, message
 }
 
 // doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
@@ -276,7 +373,9 @@ func doBaseFlattening(d *schema.ResourceData, serviceEndpoint *serviceendpoint.S
 	d.SetId(serviceEndpoint.Id.String())
 	d.Set("service_endpoint_name", serviceEndpoint.Name)
 	d.Set("project_id", projectID)
-	d.Set("description", serviceEndpoint.Description)
+	d.Set("description", flattenServiceEndpointDescription(serviceEndpoint, projectID))
+	d.Set("owner", converter.ToString(serviceEndpoint.Owner, ""))
+	d.Set("is_shared", converter.ToBool(serviceEndpoint.IsShared, false))
 
 	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Scheme != nil {
 		d.Set("authorization", &map[string]interface{}{
@@ -285,6 +384,20 @@ func doBaseFlattening(d *schema.ResourceData, serviceEndpoint *serviceendpoint.S
 	}
 }
 
+// flattenServiceEndpointDescription returns the description of the service endpoint in the given project. A shared
+// service endpoint has a description per project, the top level description belongs to the project owning it.
+func flattenServiceEndpointDescription(serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) string {
+	if serviceEndpoint.ServiceEndpointProjectReferences != nil {
+		for _, reference := range *serviceEndpoint.ServiceEndpointProjectReferences {
+			if reference.ProjectReference != nil && reference.ProjectReference.Id != nil &&
+				strings.EqualFold(reference.ProjectReference.Id.String(), projectID) && reference.Description != nil {
+				return *reference.Description
+			}
+		}
+	}
+	return converter.ToString(serviceEndpoint.Description, "")
+}
+
 // data resources
 
 func dataSourceGenBaseServiceEndpointResource(dataSourceReadFunc schema.ReadFunc) *schema.Resource { //nolint:staticcheck
@@ -325,6 +438,16 @@ func dataSourceGenBaseServiceEndpointResource(dataSourceReadFunc schema.ReadFunc
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
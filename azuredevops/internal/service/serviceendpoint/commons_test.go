@@ -0,0 +1,148 @@
+//go:build (all || serviceendpoints) && !exclude_serviceendpoints
+// +build all serviceendpoints
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var commonsTestServiceEndpointID = uuid.New()
+var commonsTestProjectID = uuid.New()
+var commonsTestSharedProjectID = uuid.New()
+
+func getCommonsTestResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, baseSchema(), map[string]interface{}{
+		"project_id":            commonsTestProjectID.String(),
+		"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+		"description":           "UNIT_TEST_CONN_DESCRIPTION",
+	})
+	resourceData.SetId(commonsTestServiceEndpointID.String())
+	return resourceData
+}
+
+// verifies that the description, owner and sharing of a service endpoint shared with another project are read for the given project
+func TestServiceEndpoint_DoBaseFlattening_SharedServiceEndpoint(t *testing.T) {
+	serviceEndpoint := serviceendpoint.ServiceEndpoint{
+		Id:          &commonsTestServiceEndpointID,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		IsShared:    converter.Bool(true),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &commonsTestSharedProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_SHARED_DESCRIPTION"),
+			},
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &commonsTestProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, baseSchema(), nil)
+	doBaseFlattening(resourceData, &serviceEndpoint, commonsTestProjectID.String())
+	require.Equal(t, "UNIT_TEST_CONN_DESCRIPTION", resourceData.Get("description"))
+	require.Equal(t, "library", resourceData.Get("owner"))
+	require.Equal(t, true, resourceData.Get("is_shared"))
+
+	doBaseFlattening(resourceData, &serviceEndpoint, commonsTestSharedProjectID.String())
+	require.Equal(t, "UNIT_TEST_SHARED_DESCRIPTION", resourceData.Get("description"))
+}
+
+// verifies that an update keeps the projects the service endpoint is shared with
+func TestServiceEndpoint_Update_PreservesSharedProjectReferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	resourceData := getCommonsTestResourceData(t)
+	endpoint, _ := doBaseExpansion(resourceData)
+	ownerReference := (*endpoint.ServiceEndpointProjectReferences)[0]
+	sharedReference := serviceendpoint.ServiceEndpointProjectReference{
+		ProjectReference: &serviceendpoint.ProjectReference{
+			Id: &commonsTestSharedProjectID,
+		},
+		Name:        converter.String("UNIT_TEST_SHARED_NAME"),
+		Description: converter.String("UNIT_TEST_SHARED_DESCRIPTION"),
+	}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			Project:    converter.String(commonsTestProjectID.String()),
+			EndpointId: &commonsTestServiceEndpointID,
+		}).
+		Return(&serviceendpoint.ServiceEndpoint{
+			Id: &commonsTestServiceEndpointID,
+			ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{
+						Id: &commonsTestProjectID,
+					},
+					Name: converter.String("UNIT_TEST_OLD_NAME"),
+				},
+				sharedReference,
+			},
+		}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, []serviceendpoint.ServiceEndpointProjectReference{
+				ownerReference,
+				sharedReference,
+			}, *args.Endpoint.ServiceEndpointProjectReferences)
+			return args.Endpoint, nil
+		}).
+		Times(1)
+
+	_, err := updateServiceEndpoint(resourceData, clients, endpoint)
+	require.Nil(t, err)
+}
+
+// verifies that the update is not sent if the shared projects cannot be read
+func TestServiceEndpoint_Update_ReadingProjectReferencesDoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpointDetails() Failed")).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := getCommonsTestResourceData(t)
+	endpoint, _ := doBaseExpansion(resourceData)
+	_, err := updateServiceEndpoint(resourceData, clients, endpoint)
+	require.Contains(t, err.Error(), "GetServiceEndpointDetails() Failed")
+}
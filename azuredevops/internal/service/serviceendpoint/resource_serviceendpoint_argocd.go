@@ -137,7 +137,7 @@ func resourceServiceEndpointArgoCDUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -1,7 +1,10 @@
 package serviceendpoint
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +16,9 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
+// audience of the OpenID Connect tokens issued by Azure DevOps
+const awsOidcAudience = "api://AzureADTokenExchange"
+
 // ResourceServiceEndpointAws schema and implementation for aws service endpoint resource
 func ResourceServiceEndpointAws() *schema.Resource {
 	r := &schema.Resource{
@@ -26,19 +32,20 @@ func ResourceServiceEndpointAws() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
-		Schema:   baseSchema(),
+		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:        baseSchema(),
+		CustomizeDiff: validateAwsAuthentication,
 	}
 
 	r.Schema["access_key_id"] = &schema.Schema{
 		Type:        schema.TypeString,
-		Required:    true,
+		Optional:    true,
 		DefaultFunc: schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_ACCESS_KEY_ID", nil),
 		Description: "The AWS access key ID for signing programmatic requests.",
 	}
 	r.Schema["secret_access_key"] = &schema.Schema{
 		Type:        schema.TypeString,
-		Required:    true,
+		Optional:    true,
 		DefaultFunc: schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SECRET_ACCESS_KEY", nil),
 		Description: "The AWS secret access key for signing programmatic requests.",
 		Sensitive:   true,
@@ -68,6 +75,27 @@ func ResourceServiceEndpointAws() *schema.Resource {
 		DefaultFunc: schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_EXTERNAL_ID", nil),
 		Description: "A unique identifier that is used by third parties when assuming roles in their customers' accounts, aka cross-account role access.",
 	}
+	r.Schema["use_oidc"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Assume the role given by role_to_assume with an OpenID Connect token issued by Azure DevOps instead of access keys.",
+	}
+	r.Schema["oidc_issuer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The issuer of the OpenID Connect tokens, to be configured as identity provider in AWS IAM.",
+	}
+	r.Schema["oidc_subject"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The subject of the OpenID Connect tokens, to be used in the trust policy of the role to assume.",
+	}
+	r.Schema["oidc_audience"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The audience of the OpenID Connect tokens, to be used in the trust policy of the role to assume.",
+	}
 	return r
 }
 
@@ -104,6 +132,9 @@ func resourceServiceEndpointAwsRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	flattenServiceEndpointAws(d, serviceEndpoint, (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	if d.Get("use_oidc").(bool) && d.Get("oidc_subject").(string) == "" {
+		d.Set("oidc_subject", awsOidcSubject(clients.OrganizationURL, serviceEndpoint))
+	}
 	return nil
 }
 
@@ -114,7 +145,7 @@ func resourceServiceEndpointAwsUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -134,19 +165,42 @@ func resourceServiceEndpointAwsDelete(d *schema.ResourceData, m interface{}) err
 	return deleteServiceEndpoint(clients, projectId, serviceEndpoint.Id, d.Timeout(schema.TimeoutDelete))
 }
 
+// validateAwsAuthentication ensures that either access keys or, with use_oidc, a role to assume are configured
+func validateAwsAuthentication(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("use_oidc") {
+		return nil
+	}
+	if d.Get("use_oidc").(bool) {
+		if d.NewValueKnown("role_to_assume") && d.Get("role_to_assume").(string) == "" {
+			return fmt.Errorf(" `role_to_assume` is required when `use_oidc` is enabled")
+		}
+		return nil
+	}
+	for _, key := range []string{"access_key_id", "secret_access_key"} {
+		if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf(" `access_key_id` and `secret_access_key` are required unless `use_oidc` is enabled")
+		}
+	}
+	return nil
+}
+
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointAws(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	parameters := map[string]string{
+		"username":        d.Get("access_key_id").(string),
+		"password":        d.Get("secret_access_key").(string),
+		"sessionToken":    d.Get("session_token").(string),
+		"assumeRoleArn":   d.Get("role_to_assume").(string),
+		"roleSessionName": d.Get("role_session_name").(string),
+		"externalId":      d.Get("external_id").(string),
+	}
+	if d.Get("use_oidc").(bool) {
+		parameters["useOIDC"] = "true"
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
-		Parameters: &map[string]string{
-			"username":        d.Get("access_key_id").(string),
-			"password":        d.Get("secret_access_key").(string),
-			"sessionToken":    d.Get("session_token").(string),
-			"assumeRoleArn":   d.Get("role_to_assume").(string),
-			"roleSessionName": d.Get("role_session_name").(string),
-			"externalId":      d.Get("external_id").(string),
-		},
-		Scheme: converter.String("UsernamePassword"),
+		Parameters: &parameters,
+		Scheme:     converter.String("UsernamePassword"),
 	}
 	serviceEndpoint.Type = converter.String("aws")
 	serviceEndpoint.Url = converter.String("https://aws.amazon.com/")
@@ -161,4 +215,40 @@ func flattenServiceEndpointAws(d *schema.ResourceData, serviceEndpoint *servicee
 	d.Set("role_to_assume", (*serviceEndpoint.Authorization.Parameters)["assumeRoleArn"])
 	d.Set("role_session_name", (*serviceEndpoint.Authorization.Parameters)["roleSessionName"])
 	d.Set("external_id", (*serviceEndpoint.Authorization.Parameters)["externalId"])
+
+	useOidc := strings.EqualFold((*serviceEndpoint.Authorization.Parameters)["useOIDC"], "true")
+	d.Set("use_oidc", useOidc)
+	if useOidc {
+		d.Set("oidc_issuer", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationIssuer"])
+		d.Set("oidc_subject", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationSubject"])
+		d.Set("oidc_audience", awsOidcAudience)
+	} else {
+		d.Set("oidc_issuer", "")
+		d.Set("oidc_subject", "")
+		d.Set("oidc_audience", "")
+	}
+}
+
+// awsOidcSubject builds the subject of the tokens issued for a service connection, sc://<organization>/<project>/<service connection>
+func awsOidcSubject(organizationURL string, serviceEndpoint *serviceendpoint.ServiceEndpoint) string {
+	if serviceEndpoint.ServiceEndpointProjectReferences == nil || len(*serviceEndpoint.ServiceEndpointProjectReferences) == 0 {
+		return ""
+	}
+	projectReference := (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference
+	if projectReference == nil || projectReference.Name == nil {
+		return ""
+	}
+
+	organization := ""
+	if u, err := url.Parse(organizationURL); err == nil {
+		if host := strings.ToLower(u.Hostname()); strings.HasSuffix(host, ".visualstudio.com") {
+			organization = strings.TrimSuffix(host, ".visualstudio.com")
+		} else {
+			organization = strings.Split(strings.Trim(u.Path, "/"), "/")[0]
+		}
+	}
+	if organization == "" {
+		return ""
+	}
+	return fmt.Sprintf("sc://%s/%s/%s", organization, *projectReference.Name, converter.ToString(serviceEndpoint.Name, ""))
 }
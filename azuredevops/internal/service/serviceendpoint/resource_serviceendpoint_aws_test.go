@@ -12,6 +12,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -52,6 +53,106 @@ var awsTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
 	},
 }
 
+var awsOidcTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username":        "",
+			"password":        "",
+			"sessionToken":    "",
+			"assumeRoleArn":   "AWS_TEST_assumeRoleArn",
+			"roleSessionName": "",
+			"externalId":      "",
+			"useOIDC":         "true",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:          &awsTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("aws"),
+	Url:         converter.String("https://aws.amazon.com/"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: awsTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint when using OIDC
+func TestServiceEndpointAws_ExpandFlatten_RoundtripOidc(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAws().Schema, nil)
+	flattenServiceEndpointAws(resourceData, &awsOidcTestServiceEndpoint, awsTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointAws(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, awsOidcTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, awsTestServiceEndpointProjectID, projectID)
+	require.Equal(t, awsOidcAudience, resourceData.Get("oidc_audience"))
+}
+
+// verifies that access keys are required unless OIDC is used, and a role to assume when it is
+func TestServiceEndpointAws_CustomizeDiff_ValidatesAuthentication(t *testing.T) {
+	tests := []struct {
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			config:      map[string]interface{}{},
+			expectedErr: "`access_key_id` and `secret_access_key` are required unless `use_oidc` is enabled",
+		},
+		{
+			config:      map[string]interface{}{"access_key_id": "AWS_TEST_username"},
+			expectedErr: "`access_key_id` and `secret_access_key` are required unless `use_oidc` is enabled",
+		},
+		{
+			config: map[string]interface{}{"access_key_id": "AWS_TEST_username", "secret_access_key": "AWS_TEST_password"},
+		},
+		{
+			config:      map[string]interface{}{"use_oidc": true},
+			expectedErr: "`role_to_assume` is required when `use_oidc` is enabled",
+		},
+		{
+			config: map[string]interface{}{"use_oidc": true, "role_to_assume": "AWS_TEST_assumeRoleArn"},
+		},
+	}
+
+	r := ResourceServiceEndpointAws()
+	for _, test := range tests {
+		test.config["project_id"] = awsTestServiceEndpointProjectID.String()
+		test.config["service_endpoint_name"] = "UNIT_TEST_CONN_NAME"
+
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(test.config), nil)
+		if test.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.Contains(t, err.Error(), test.expectedErr)
+		}
+	}
+}
+
+// verifies that the token subject is derived from the organization, project and service connection names
+func TestServiceEndpointAws_OidcSubject(t *testing.T) {
+	ep := awsOidcTestServiceEndpoint
+	ep.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id:   awsTestServiceEndpointProjectID,
+				Name: converter.String("UNIT_TEST_PROJECT"),
+			},
+		},
+	}
+
+	require.Equal(t, "sc://myorg/UNIT_TEST_PROJECT/UNIT_TEST_CONN_NAME", awsOidcSubject("https://dev.azure.com/myorg", &ep))
+	require.Equal(t, "sc://myorg/UNIT_TEST_PROJECT/UNIT_TEST_CONN_NAME", awsOidcSubject("https://myorg.visualstudio.com/", &ep))
+	require.Equal(t, "", awsOidcSubject("", &ep))
+}
+
 // verifies that the flatten/expand round trip yields the same service endpoint
 func TestServiceEndpointAws_ExpandFlatten_Roundtrip(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAws().Schema, nil)
@@ -158,6 +259,12 @@ func TestServiceEndpointAws_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: awsTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
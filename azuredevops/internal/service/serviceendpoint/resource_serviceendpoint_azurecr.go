@@ -31,6 +31,9 @@ func ResourceServiceEndpointAzureCR() *schema.Resource {
 		Schema:   baseSchema(),
 	}
 
+	// the service principal is created by Azure DevOps, so there are no secrets to send again
+	delete(r.Schema, "rotation_trigger")
+
 	r.Schema["azurecr_spn_tenantid"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
@@ -135,7 +138,7 @@ func resourceServiceEndpointAzureCRUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -173,6 +173,12 @@ func TestServiceEndpointAzureCR_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: azureCRTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
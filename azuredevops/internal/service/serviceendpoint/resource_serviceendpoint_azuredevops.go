@@ -100,7 +100,7 @@ func resourceServiceEndpointAzureDevOpsUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -63,7 +63,7 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		Optional:      true,
 		DefaultFunc:   schema.EnvDefaultFunc("ARM_SUBSCRIPTION_NAME", nil),
 		Description:   "The Azure subscription name which should be used.",
-		ConflictsWith: []string{"azurerm_management_group_id"},
+		ConflictsWith: []string{"azurerm_management_group_id", "azurerm_management_group_name"},
 	}
 
 	// ManagementGroup scopeLevel
@@ -72,7 +72,7 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		Optional:      true,
 		DefaultFunc:   schema.EnvDefaultFunc("ARM_MGMT_GROUP_ID", nil),
 		Description:   "The Azure managementGroup Id which should be used.",
-		ConflictsWith: []string{"azurerm_subscription_id", "resource_group"},
+		ConflictsWith: []string{"azurerm_subscription_id", "azurerm_subscription_name", "resource_group"},
 	}
 
 	r.Schema["azurerm_management_group_name"] = &schema.Schema{
@@ -80,7 +80,7 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		Optional:      true,
 		DefaultFunc:   schema.EnvDefaultFunc("ARM_MGMT_GROUP_NAME", nil),
 		Description:   "The Azure managementGroup name which should be used.",
-		ConflictsWith: []string{"azurerm_subscription_id", "resource_group"},
+		ConflictsWith: []string{"azurerm_subscription_id", "azurerm_subscription_name", "resource_group"},
 	}
 
 	r.Schema["credentials"] = &schema.Schema{
@@ -96,10 +96,19 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 					Description: "The service principal id which should be used.",
 				},
 				"serviceprincipalkey": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The service principal secret which should be used.",
-					Sensitive:   true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "The service principal secret which should be used.",
+					Sensitive:     true,
+					ConflictsWith: []string{"credentials.0.serviceprincipalcertificate"},
+				},
+				"serviceprincipalcertificate": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "The PEM encoded certificate and private key of the service principal which should be used.",
+					Sensitive:     true,
+					ValidateFunc:  validation.StringIsNotWhiteSpace,
+					ConflictsWith: []string{"credentials.0.serviceprincipalkey"},
 				},
 			},
 		},
@@ -183,7 +192,7 @@ func resourceServiceEndpointAzureRMCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	if shouldValidate(endpointFeatures(d)) {
+	if shouldValidate(endpointFeatures(d)) && !d.Get("verify_connection").(bool) {
 		if err := validateServiceEndpoint(clients, serviceEndpoint, converter.String(serviceEndPoint.Id.String()), endpointValidationTimeoutSeconds); err != nil {
 			if delErr := clients.ServiceEndpointClient.DeleteServiceEndpoint(
 				clients.Ctx,
@@ -237,12 +246,12 @@ func resourceServiceEndpointAzureRMUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	if shouldValidate(endpointFeatures(d)) {
+	if shouldValidate(endpointFeatures(d)) && !d.Get("verify_connection").(bool) {
 		if err := validateServiceEndpoint(clients, serviceEndpoint, converter.String(serviceEndpoint.Id.String()), endpointValidationTimeoutSeconds); err != nil {
 			return err
 		}
 	}
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -290,7 +299,11 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 
 	serviceEndPointAuthenticationSchemeHasCreationMode := serviceEndPointAuthenticationScheme == ServicePrincipal || serviceEndPointAuthenticationScheme == WorkloadIdentityFederation
 
-	if _, ok := d.GetOk("azurerm_subscription_id"); ok {
+	// the subscription may be set from the environment, the management group takes precedence when configured
+	if _, ok := d.GetOk("azurerm_management_group_id"); ok {
+		scope = fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", d.Get("azurerm_management_group_id"))
+		scopeLevel = "ManagementGroup"
+	} else if _, ok := d.GetOk("azurerm_subscription_id"); ok {
 		scope = fmt.Sprintf("/subscriptions/%s", d.Get("azurerm_subscription_id"))
 		scopeLevel = "Subscription"
 		if serviceEndPointAuthenticationSchemeHasCreationMode {
@@ -333,14 +346,20 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 			}
 		}
 		if serviceEndpointCreationMode == Manual {
+			parameters := map[string]string{
+				"authenticationType":  "spnKey",
+				"serviceprincipalid":  credentials["serviceprincipalid"].(string),
+				"serviceprincipalkey": credentials["serviceprincipalkey"].(string),
+				"tenantid":            d.Get("azurerm_spn_tenantid").(string),
+			}
+			if certificate := credentials["serviceprincipalcertificate"].(string); certificate != "" {
+				delete(parameters, "serviceprincipalkey")
+				parameters["authenticationType"] = "spnCertificate"
+				parameters["servicePrincipalCertificate"] = certificate
+			}
 			serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
-				Parameters: &map[string]string{
-					"authenticationType":  "spnKey",
-					"serviceprincipalid":  credentials["serviceprincipalid"].(string),
-					"serviceprincipalkey": credentials["serviceprincipalkey"].(string),
-					"tenantid":            d.Get("azurerm_spn_tenantid").(string),
-				},
-				Scheme: converter.String(string(serviceEndPointAuthenticationScheme)),
+				Parameters: &parameters,
+				Scheme:     converter.String(string(serviceEndPointAuthenticationScheme)),
 			}
 		}
 
@@ -408,10 +427,15 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 		(*serviceEndpoint.Authorization.Parameters)["scope"] = scope
 	}
 
-	if _, ok := d.GetOk("azurerm_management_group_id"); ok {
+	if scopeLevel == "ManagementGroup" {
 		(*serviceEndpoint.Data)["scopeLevel"] = "ManagementGroup"
 		(*serviceEndpoint.Data)["managementGroupId"] = d.Get("azurerm_management_group_id").(string)
 		(*serviceEndpoint.Data)["managementGroupName"] = d.Get("azurerm_management_group_name").(string)
+
+		// Azure DevOps assigns the role of an automatically created service principal on the management group
+		if serviceEndpointCreationMode == Automatic {
+			(*serviceEndpoint.Authorization.Parameters)["scope"] = scope
+		}
 	}
 
 	serviceEndpoint.Type = converter.String("azurerm")
@@ -440,12 +464,13 @@ func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serv
 			credentials := make(map[string]interface{})
 			credentials["serviceprincipalid"] = (*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"]
 			credentials["serviceprincipalkey"] = d.Get("credentials.0.serviceprincipalkey").(string)
+			credentials["serviceprincipalcertificate"] = d.Get("credentials.0.serviceprincipalcertificate").(string)
 			d.Set("credentials", []interface{}{credentials})
 		}
 	}
 
 	s := strings.SplitN(scope, "/", -1)
-	if len(s) == 5 {
+	if len(s) == 5 && strings.EqualFold(s[1], "subscriptions") {
 		d.Set("resource_group", s[4])
 	}
 
@@ -470,7 +495,7 @@ func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serv
 func validateScopeLevel(scopeMap map[string][]string) error {
 	// Check for empty
 	if strings.TrimSpace(strings.Join(scopeMap["subscription"], "")) == "" && strings.TrimSpace(strings.Join(scopeMap["managementGroup"], "")) == "" {
-		return fmt.Errorf("One of either subscription scoped (azurerm_subscription_id, azurerm_subscription_name) or managementGroup scoped (azurerm_management_ggroup_id, azurerm_management_group_name) details must be provided")
+		return fmt.Errorf("One of either subscription scoped (azurerm_subscription_id, azurerm_subscription_name) or managementGroup scoped (azurerm_management_group_id, azurerm_management_group_name) details must be provided")
 	}
 
 	// check for valid managementGroup details
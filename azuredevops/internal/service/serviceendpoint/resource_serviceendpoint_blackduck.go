@@ -0,0 +1,150 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointBlackDuck schema and implementation for Black Duck service endpoint resource
+func ResourceServiceEndpointBlackDuck() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointBlackDuckCreate,
+		Read:   resourceServiceEndpointBlackDuckRead,
+		Update: resourceServiceEndpointBlackDuckUpdate,
+		Delete: resourceServiceEndpointBlackDuckDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:   baseSchema(),
+	}
+
+	r.Schema["url"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: func(i interface{}, key string) (_ []string, errors []error) {
+			url, ok := i.(string)
+			if !ok {
+				errors = append(errors, fmt.Errorf("expected type of %q to be string", key))
+				return
+			}
+			if strings.HasSuffix(url, "/") {
+				errors = append(errors, fmt.Errorf("%q should not end with slash, got %q.", key, url))
+				return
+			}
+			return validation.IsURLWithHTTPorHTTPS(url, key)
+		},
+		Description: "Url of the Black Duck server, e.g. https://blackduck.contoso.com",
+	}
+
+	r.Schema["api_token"] = &schema.Schema{
+		Description:  "The Black Duck API token.",
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		DefaultFunc:  schema.EnvDefaultFunc("AZDO_BLACKDUCK_SERVICE_CONNECTION_API_TOKEN", nil),
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+
+	return r
+}
+
+func resourceServiceEndpointBlackDuckCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, _, err := expandServiceEndpointBlackDuck(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointBlackDuckRead(d, m)
+}
+
+func resourceServiceEndpointBlackDuckRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenServiceEndpointBlackDuck(d, serviceEndpoint, (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	return nil
+}
+
+func resourceServiceEndpointBlackDuckUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectID, err := expandServiceEndpointBlackDuck(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	flattenServiceEndpointBlackDuck(d, updatedServiceEndpoint, projectID.String())
+	return resourceServiceEndpointBlackDuckRead(d, m)
+}
+
+func resourceServiceEndpointBlackDuckDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectId, err := expandServiceEndpointBlackDuck(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	return deleteServiceEndpoint(clients, projectId, serviceEndpoint.Id, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointBlackDuck(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("BlackDuck")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": d.Get("api_token").(string),
+		},
+		Scheme: converter.String("Token"),
+	}
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointBlackDuck(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("url", *serviceEndpoint.Url)
+}
@@ -0,0 +1,232 @@
+//go:build (all || resource_serviceendpoint_blackduck) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_blackduck
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var blackDuckTestServiceEndpointProjectID = uuid.New()
+
+func newBlackDuckTestServiceEndpoint(url string, apiToken string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": apiToken,
+			},
+			Scheme: converter.String("Token"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("BlackDuck"),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &blackDuckTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the API token cannot be read back from Azure DevOps, so each case carries the configuration providing it
+var blackDuckTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newBlackDuckTestServiceEndpoint("https://blackduck.contoso.com", "BLACKDUCK_TEST_api_token"),
+		config: map[string]interface{}{
+			"api_token": "BLACKDUCK_TEST_api_token",
+		},
+	},
+	{
+		endpoint: newBlackDuckTestServiceEndpoint("http://blackduck.internal:8443/hub", "BLACKDUCK_TEST_other_api_token"),
+		config: map[string]interface{}{
+			"api_token": "BLACKDUCK_TEST_other_api_token",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointBlackDuck_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range blackDuckTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointBlackDuck().Schema, tc.config)
+		flattenServiceEndpointBlackDuck(resourceData, &tc.endpoint, blackDuckTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointBlackDuck(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, blackDuckTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the url is read back while the API token is kept from the configuration
+func TestServiceEndpointBlackDuck_Flatten_KeepsApiToken(t *testing.T) {
+	tc := blackDuckTestCases[0]
+	ep := newBlackDuckTestServiceEndpoint("https://blackduck.fabrikam.com", "")
+	ep.Authorization.Parameters = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointBlackDuck().Schema, tc.config)
+	flattenServiceEndpointBlackDuck(resourceData, &ep, blackDuckTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "https://blackduck.fabrikam.com", resourceData.Get("url"))
+	require.Equal(t, "BLACKDUCK_TEST_api_token", resourceData.Get("api_token"))
+	require.Equal(t, "Token", resourceData.Get("authorization").(map[string]interface{})["scheme"])
+}
+
+// validates that http and https server urls pass validation
+func TestServiceEndpointBlackDuck_UrlIsValid(t *testing.T) {
+	urlSchema := ResourceServiceEndpointBlackDuck().Schema["url"]
+
+	for _, url := range []string{"https://blackduck.contoso.com", "http://blackduck.internal:8443/hub"} {
+		_, errors := urlSchema.ValidateFunc(url, "url")
+		require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+	}
+}
+
+// validates that an error is thrown if the url ends with a slash or is not a http(s) url
+func TestServiceEndpointBlackDuck_UrlIsInvalid(t *testing.T) {
+	urlSchema := ResourceServiceEndpointBlackDuck().Schema["url"]
+
+	for _, url := range []string{"https://blackduck.contoso.com/", "ftp://blackduck.contoso.com", "blackduck.contoso.com"} {
+		_, errors := urlSchema.ValidateFunc(url, "url")
+		require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+	}
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointBlackDuck_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range blackDuckTestCases {
+		r := ResourceServiceEndpointBlackDuck()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointBlackDuck(resourceData, &tc.endpoint, blackDuckTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointBlackDuck_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range blackDuckTestCases {
+		r := ResourceServiceEndpointBlackDuck()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointBlackDuck(resourceData, &tc.endpoint, blackDuckTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(blackDuckTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointBlackDuck_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range blackDuckTestCases {
+		r := ResourceServiceEndpointBlackDuck()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointBlackDuck(resourceData, &tc.endpoint, blackDuckTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				blackDuckTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointBlackDuck_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range blackDuckTestCases {
+		r := ResourceServiceEndpointBlackDuck()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointBlackDuck(resourceData, &tc.endpoint, blackDuckTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
@@ -0,0 +1,248 @@
+//go:build (all || resource_serviceendpoint_datadog) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_datadog
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var datadogTestServiceEndpointProjectID = uuid.New()
+
+func newDatadogTestServiceEndpoint(url string, apiKey string, appKey string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apiKey": apiKey,
+				"appKey": appKey,
+			},
+			Scheme: converter.String("Token"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("datadog"),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &datadogTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the keys cannot be read back from Azure DevOps, so each case carries the configuration providing them
+var datadogTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newDatadogTestServiceEndpoint("https://api.datadoghq.com", "DATADOG_TEST_api_key", "DATADOG_TEST_app_key"),
+		config: map[string]interface{}{
+			"api_key": "DATADOG_TEST_api_key",
+			"app_key": "DATADOG_TEST_app_key",
+		},
+	},
+	{
+		endpoint: newDatadogTestServiceEndpoint("https://api.datadoghq.eu", "DATADOG_TEST_eu_api_key", "DATADOG_TEST_eu_app_key"),
+		config: map[string]interface{}{
+			"api_key": "DATADOG_TEST_eu_api_key",
+			"app_key": "DATADOG_TEST_eu_app_key",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointDatadog_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range datadogTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointDatadog().Schema, tc.config)
+		flattenServiceEndpointDatadog(resourceData, &tc.endpoint, datadogTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointDatadog(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, datadogTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the API and application keys are sent as separate token parameters
+func TestServiceEndpointDatadog_Expand_SendsBothKeys(t *testing.T) {
+	tc := datadogTestCases[1]
+	config := map[string]interface{}{
+		"project_id": datadogTestServiceEndpointProjectID.String(),
+		"url":        "https://api.datadoghq.eu",
+	}
+	for k, v := range tc.config {
+		config[k] = v
+	}
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointDatadog().Schema, config)
+
+	serviceEndpoint, _, err := expandServiceEndpointDatadog(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "Token", *serviceEndpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{
+		"apiKey": "DATADOG_TEST_eu_api_key",
+		"appKey": "DATADOG_TEST_eu_app_key",
+	}, *serviceEndpoint.Authorization.Parameters)
+}
+
+// verifies that the url is read back while the keys are kept from the configuration
+func TestServiceEndpointDatadog_Flatten_KeepsKeys(t *testing.T) {
+	tc := datadogTestCases[0]
+	ep := newDatadogTestServiceEndpoint("https://us5.datadoghq.com", "", "")
+	ep.Authorization.Parameters = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointDatadog().Schema, tc.config)
+	flattenServiceEndpointDatadog(resourceData, &ep, datadogTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "https://us5.datadoghq.com", resourceData.Get("url"))
+	require.Equal(t, "DATADOG_TEST_api_key", resourceData.Get("api_key"))
+	require.Equal(t, "DATADOG_TEST_app_key", resourceData.Get("app_key"))
+}
+
+// validates that an error is thrown if the url ends with a slash
+func TestServiceEndpointDatadog_UrlTrailingSlashIsError(t *testing.T) {
+	urlSchema := ResourceServiceEndpointDatadog().Schema["url"]
+
+	_, errors := urlSchema.ValidateFunc("https://api.datadoghq.com", "url")
+	require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+
+	_, errors = urlSchema.ValidateFunc("https://api.datadoghq.com/", "url")
+	require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointDatadog_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range datadogTestCases {
+		r := ResourceServiceEndpointDatadog()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointDatadog(resourceData, &tc.endpoint, datadogTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointDatadog_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range datadogTestCases {
+		r := ResourceServiceEndpointDatadog()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointDatadog(resourceData, &tc.endpoint, datadogTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(datadogTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointDatadog_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range datadogTestCases {
+		r := ResourceServiceEndpointDatadog()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointDatadog(resourceData, &tc.endpoint, datadogTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				datadogTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointDatadog_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range datadogTestCases {
+		r := ResourceServiceEndpointDatadog()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointDatadog(resourceData, &tc.endpoint, datadogTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
@@ -174,6 +174,12 @@ func TestServiceEndpointExternalTFS_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: externalTfsTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
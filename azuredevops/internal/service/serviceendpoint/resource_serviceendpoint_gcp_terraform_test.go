@@ -159,6 +159,12 @@ func TestServiceEndpointGcp_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: gcpForTerraformTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
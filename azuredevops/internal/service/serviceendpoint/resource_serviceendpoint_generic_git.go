@@ -101,7 +101,7 @@ func resourceServiceEndpointGenericGitUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -141,8 +141,12 @@ func expandServiceEndpointGenericGit(d *schema.ResourceData) (*serviceendpoint.S
 func flattenServiceEndpointGenericGit(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("repository_url", *serviceEndpoint.Url)
-	if v, err := strconv.ParseBool((*serviceEndpoint.Data)["accessExternalGitServer"]); err != nil {
-		d.Set("enable_pipelines_access", v)
+	if serviceEndpoint.Data != nil {
+		if v, err := strconv.ParseBool((*serviceEndpoint.Data)["accessExternalGitServer"]); err == nil {
+			d.Set("enable_pipelines_access", v)
+		}
+	}
+	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
+		d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
 	}
-	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
 }
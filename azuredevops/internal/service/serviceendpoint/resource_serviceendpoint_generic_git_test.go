@@ -0,0 +1,178 @@
+//go:build (all || resource_serviceendpoint_generic_git) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_generic_git
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var genericGitTestServiceEndpointID = uuid.New()
+var genericGitTestServiceEndpointProjectID = uuid.New()
+
+var genericGitTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "GENERIC_GIT_TEST_username",
+			"password": "GENERIC_GIT_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"accessExternalGitServer": "false",
+	},
+	Id:          &genericGitTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+	Type:        converter.String("git"),
+	Url:         converter.String("https://git.contoso.com/repository.git"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &genericGitTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// the password cannot be read back from Azure DevOps, so it is provided through the configuration
+var genericGitTestConfig = map[string]interface{}{
+	"password": "GENERIC_GIT_TEST_password",
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointGenericGit_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericGit().Schema, genericGitTestConfig)
+	flattenServiceEndpointGenericGit(resourceData, &genericGitTestServiceEndpoint, genericGitTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointGenericGit(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, genericGitTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, genericGitTestServiceEndpointProjectID, *projectID)
+	require.Equal(t, false, resourceData.Get("enable_pipelines_access"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointGenericGit_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGenericGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, genericGitTestConfig)
+	flattenServiceEndpointGenericGit(resourceData, &genericGitTestServiceEndpoint, genericGitTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &genericGitTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointGenericGit_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGenericGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, genericGitTestConfig)
+	flattenServiceEndpointGenericGit(resourceData, &genericGitTestServiceEndpoint, genericGitTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: genericGitTestServiceEndpoint.Id,
+		Project:    converter.String(genericGitTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointGenericGit_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGenericGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, genericGitTestConfig)
+	flattenServiceEndpointGenericGit(resourceData, &genericGitTestServiceEndpoint, genericGitTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: genericGitTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			genericGitTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointGenericGit_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGenericGit()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, genericGitTestConfig)
+	flattenServiceEndpointGenericGit(resourceData, &genericGitTestServiceEndpoint, genericGitTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &genericGitTestServiceEndpoint,
+		EndpointId: genericGitTestServiceEndpoint.Id,
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -0,0 +1,184 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointGenericRaw schema and implementation for a service endpoint of any type
+func ResourceServiceEndpointGenericRaw() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointGenericRawCreate,
+		Read:   resourceServiceEndpointGenericRawRead,
+		Update: resourceServiceEndpointGenericRawUpdate,
+		Delete: resourceServiceEndpointGenericRawDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:   baseSchema(),
+	}
+
+	r.Schema["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The type of the service endpoint, e.g. externalnpmregistry.",
+	}
+
+	r.Schema["url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "ssh", "sb"}),
+		Description:  "The URL of the service endpoint.",
+	}
+
+	r.Schema["authorization_scheme"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The authorization scheme of the service endpoint, e.g. Token or UsernamePassword.",
+	}
+
+	// confidential parameters are not returned by Azure DevOps, so the configured parameters are kept in the state
+	r.Schema["parameters"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Sensitive:   true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The parameters of the authorization scheme.",
+	}
+
+	r.Schema["data"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Additional data of the service endpoint.",
+	}
+
+	return r
+}
+
+func resourceServiceEndpointGenericRawCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, _, err := expandServiceEndpointGenericRaw(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointGenericRawRead(d, m)
+}
+
+func resourceServiceEndpointGenericRawRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenServiceEndpointGenericRaw(d, serviceEndpoint, (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	return nil
+}
+
+func resourceServiceEndpointGenericRawUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectID, err := expandServiceEndpointGenericRaw(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	flattenServiceEndpointGenericRaw(d, updatedServiceEndpoint, projectID.String())
+	return resourceServiceEndpointGenericRawRead(d, m)
+}
+
+func resourceServiceEndpointGenericRawDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectId, err := expandServiceEndpointGenericRaw(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	return deleteServiceEndpoint(clients, projectId, serviceEndpoint.Id, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointGenericRaw(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String(d.Get("type").(string))
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: expandStringMap(d.Get("parameters").(map[string]interface{})),
+		Scheme:     converter.String(d.Get("authorization_scheme").(string)),
+	}
+	serviceEndpoint.Data = expandStringMap(d.Get("data").(map[string]interface{}))
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointGenericRaw(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("type", converter.ToString(serviceEndpoint.Type, ""))
+	d.Set("url", converter.ToString(serviceEndpoint.Url, ""))
+	if serviceEndpoint.Authorization != nil {
+		d.Set("authorization_scheme", converter.ToString(serviceEndpoint.Authorization.Scheme, ""))
+	}
+
+	// Azure DevOps adds data keys of its own, so only the configured keys are tracked unless none are configured
+	if serviceEndpoint.Data != nil {
+		configured := d.Get("data").(map[string]interface{})
+		data := map[string]interface{}{}
+		for k, v := range *serviceEndpoint.Data {
+			if _, ok := configured[k]; ok || len(configured) == 0 {
+				data[k] = v
+			}
+		}
+		d.Set("data", data)
+	}
+}
+
+func expandStringMap(m map[string]interface{}) *map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return &result
+}
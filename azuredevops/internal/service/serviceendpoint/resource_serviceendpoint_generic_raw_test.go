@@ -0,0 +1,281 @@
+//go:build (all || resource_serviceendpoint_generic_raw) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_generic_raw
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var genericRawTestServiceEndpointProjectID = uuid.New()
+
+func newGenericRawTestServiceEndpoint(endpointType string, url string, scheme string, params map[string]string, data map[string]string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &params,
+			Scheme:     converter.String(scheme),
+		},
+		Data:        &data,
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String(endpointType),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &genericRawTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the parameters cannot be read back from Azure DevOps, so each case carries the configuration providing them
+var genericRawTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newGenericRawTestServiceEndpoint("contosoregistry", "https://registry.contoso.com", "UsernamePassword", map[string]string{
+			"username": "GENERIC_RAW_TEST_username",
+			"password": "GENERIC_RAW_TEST_password",
+		}, map[string]string{
+			"releaseUrl": "https://release.contoso.com",
+		}),
+		config: map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"username": "GENERIC_RAW_TEST_username",
+				"password": "GENERIC_RAW_TEST_password",
+			},
+		},
+	},
+	{
+		endpoint: newGenericRawTestServiceEndpoint("externalnpmregistry", "https://registry.npmjs.org", "Token", map[string]string{
+			"apitoken": "GENERIC_RAW_TEST_apitoken",
+		}, map[string]string{}),
+		config: map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"apitoken": "GENERIC_RAW_TEST_apitoken",
+			},
+		},
+	},
+	{
+		endpoint: newGenericRawTestServiceEndpoint("contosogit", "ssh://git.contoso.com:22", "None", map[string]string{}, map[string]string{
+			"acceptUntrustedCerts": "false",
+			"branch":               "main",
+		}),
+		config: map[string]interface{}{},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointGenericRaw_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range genericRawTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericRaw().Schema, tc.config)
+		flattenServiceEndpointGenericRaw(resourceData, &tc.endpoint, genericRawTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointGenericRaw(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, genericRawTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that data keys added by Azure DevOps are only tracked when they are configured
+func TestServiceEndpointGenericRaw_Flatten_OnlyConfiguredData(t *testing.T) {
+	serviceEndpoint := genericRawTestCases[0].endpoint
+	serviceEndpoint.Data = &map[string]string{
+		"releaseUrl":   "https://release.contoso.com",
+		"creationMode": "Manual",
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericRaw().Schema, map[string]interface{}{
+		"data": map[string]interface{}{"releaseUrl": "https://release.contoso.com"},
+	})
+	flattenServiceEndpointGenericRaw(resourceData, &serviceEndpoint, genericRawTestServiceEndpointProjectID.String())
+	require.Equal(t, map[string]interface{}{"releaseUrl": "https://release.contoso.com"}, resourceData.Get("data"))
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericRaw().Schema, nil)
+	flattenServiceEndpointGenericRaw(resourceData, &serviceEndpoint, genericRawTestServiceEndpointProjectID.String())
+	require.Len(t, resourceData.Get("data"), 2)
+}
+
+// verifies that the configured parameters are kept when Azure DevOps does not return the secrets
+func TestServiceEndpointGenericRaw_Flatten_KeepsParameters(t *testing.T) {
+	tc := genericRawTestCases[0]
+	serviceEndpoint := tc.endpoint
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "GENERIC_RAW_TEST_username",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericRaw().Schema, tc.config)
+	flattenServiceEndpointGenericRaw(resourceData, &serviceEndpoint, genericRawTestServiceEndpointProjectID.String())
+
+	require.Equal(t, tc.config["parameters"], resourceData.Get("parameters"))
+	require.Equal(t, "UsernamePassword", resourceData.Get("authorization_scheme"))
+}
+
+// verifies that the configured authorization scheme is kept when Azure DevOps returns no authorization
+func TestServiceEndpointGenericRaw_Flatten_NoAuthorization(t *testing.T) {
+	serviceEndpoint := genericRawTestCases[1].endpoint
+	serviceEndpoint.Authorization = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericRaw().Schema, map[string]interface{}{
+		"authorization_scheme": "Token",
+	})
+	flattenServiceEndpointGenericRaw(resourceData, &serviceEndpoint, genericRawTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "Token", resourceData.Get("authorization_scheme"))
+	require.Equal(t, "externalnpmregistry", resourceData.Get("type"))
+	require.Equal(t, "https://registry.npmjs.org", resourceData.Get("url"))
+}
+
+// validates that an error is thrown if the url scheme is not supported by the resource
+func TestServiceEndpointGenericRaw_UrlSchemes(t *testing.T) {
+	urlSchema := ResourceServiceEndpointGenericRaw().Schema["url"]
+
+	for _, url := range []string{"https://registry.contoso.com", "http://registry.contoso.com", "ssh://git.contoso.com:22", "sb://contoso.servicebus.windows.net"} {
+		_, errors := urlSchema.ValidateFunc(url, "url")
+		require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+	}
+
+	_, errors := urlSchema.ValidateFunc("ftp://files.contoso.com", "url")
+	require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointGenericRaw_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range genericRawTestCases {
+		r := ResourceServiceEndpointGenericRaw()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGenericRaw(resourceData, &tc.endpoint, genericRawTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointGenericRaw_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range genericRawTestCases {
+		r := ResourceServiceEndpointGenericRaw()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGenericRaw(resourceData, &tc.endpoint, genericRawTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(genericRawTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointGenericRaw_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range genericRawTestCases {
+		r := ResourceServiceEndpointGenericRaw()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGenericRaw(resourceData, &tc.endpoint, genericRawTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				genericRawTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointGenericRaw_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range genericRawTestCases {
+		r := ResourceServiceEndpointGenericRaw()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGenericRaw(resourceData, &tc.endpoint, genericRawTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
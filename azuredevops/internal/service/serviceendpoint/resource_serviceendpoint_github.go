@@ -2,6 +2,7 @@ package serviceendpoint
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -53,7 +54,7 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 				},
 			},
 		},
-		ConflictsWith: []string{"auth_oauth"},
+		ConflictsWith: []string{"auth_oauth", "auth_github_app"},
 	}
 
 	r.Schema["auth_oauth"] = &schema.Schema{
@@ -69,7 +70,25 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 				},
 			},
 		},
-		ConflictsWith: []string{"auth_personal"},
+		ConflictsWith: []string{"auth_personal", "auth_github_app"},
+	}
+
+	r.Schema["auth_github_app"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"installation_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The ID of the installation of the Azure Pipelines GitHub App in the GitHub organization or account.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric GitHub App installation ID"),
+				},
+			},
+		},
+		ConflictsWith: []string{"auth_personal", "auth_oauth"},
 	}
 
 	return r
@@ -118,7 +137,7 @@ func resourceServiceEndpointGitHubUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -155,6 +174,10 @@ func expandServiceEndpointGitHub(d *schema.ResourceData) (*serviceendpoint.Servi
 		parameters = expandAuthOauthSet(config.(*schema.Set))
 	}
 
+	if config, ok := d.GetOk("auth_github_app"); ok {
+		serviceEndpoint.Data = expandAuthGitHubAppSet(config.(*schema.Set))
+	}
+
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &parameters,
 		Scheme:     &scheme,
@@ -180,9 +203,29 @@ func expandAuthOauthSet(d *schema.Set) map[string]string {
 	return authConfig
 }
 
+// the installation token is issued by Azure DevOps, only the installation of the GitHub App is referenced
+func expandAuthGitHubAppSet(d *schema.Set) *map[string]string {
+	val := d.List()[0].(map[string]interface{})
+	return &map[string]string{
+		"installationId": val["installation_id"].(string),
+	}
+}
+
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointGitHub(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Scheme == nil {
+		return
+	}
+	if strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "InstallationToken") && serviceEndpoint.Data != nil {
+		if installationID, ok := (*serviceEndpoint.Data)["installationId"]; ok && installationID != "" {
+			d.Set("auth_github_app", &[]map[string]interface{}{
+				{
+					"installation_id": installationID,
+				},
+			})
+		}
+	}
 	if strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "OAuth") {
 		d.Set("auth_oauth", &[]map[string]interface{}{
 			{
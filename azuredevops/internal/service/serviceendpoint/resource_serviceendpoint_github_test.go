@@ -60,6 +60,44 @@ func TestServiceEndpointGitHub_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, ghTestServiceEndpointProjectID, projectID)
 }
 
+var ghAppTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{},
+		Scheme:     converter.String("InstallationToken"),
+	},
+	Data: &map[string]string{
+		"installationId": "12345678",
+	},
+	Id:          &ghTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("github"),
+	Url:         converter.String("https://github.com"),
+	Description: converter.String("UNIT_TEST_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: ghTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_NAME"),
+			Description: converter.String("UNIT_TEST_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip of a GitHub App installation yields the same service endpoint
+func TestServiceEndpointGitHub_ExpandFlatten_GitHubApp_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGitHub().Schema, nil)
+	flattenServiceEndpointGitHub(resourceData, &ghAppTestServiceEndpoint, ghTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointGitHub(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, ghAppTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, ghTestServiceEndpointProjectID, projectID)
+	require.Equal(t, 1, resourceData.Get("auth_github_app").(*schema.Set).Len())
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointGitHub_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -156,6 +194,12 @@ func TestServiceEndpointGitHub_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: ghTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -0,0 +1,238 @@
+//go:build (all || resource_serviceendpoint_gitlab) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_gitlab
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var gitLabTestServiceEndpointProjectID = uuid.New()
+
+func newGitLabTestServiceEndpoint(url string, username string, apiToken string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"username": username,
+				"apitoken": apiToken,
+			},
+			Scheme: converter.String("UsernamePassword"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("gitlab"),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &gitLabTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the API token cannot be read back from Azure DevOps, so each case carries the configuration providing it
+var gitLabTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newGitLabTestServiceEndpoint("https://gitlab.com", "GITLAB_TEST_username", "GITLAB_TEST_api_token"),
+		config: map[string]interface{}{
+			"api_token": "GITLAB_TEST_api_token",
+		},
+	},
+	{
+		endpoint: newGitLabTestServiceEndpoint("http://gitlab.contoso.com:8080", "GITLAB_TEST_other_username", "GITLAB_TEST_other_api_token"),
+		config: map[string]interface{}{
+			"api_token": "GITLAB_TEST_other_api_token",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointGitLab_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range gitLabTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGitLab().Schema, tc.config)
+		flattenServiceEndpointGitLab(resourceData, &tc.endpoint, gitLabTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointGitLab(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, gitLabTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the username is read back while the API token is kept from the configuration
+func TestServiceEndpointGitLab_Flatten_KeepsApiToken(t *testing.T) {
+	tc := gitLabTestCases[0]
+	ep := newGitLabTestServiceEndpoint("https://gitlab.com", "GITLAB_TEST_renamed_username", "")
+	delete(*ep.Authorization.Parameters, "apitoken")
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGitLab().Schema, tc.config)
+	flattenServiceEndpointGitLab(resourceData, &ep, gitLabTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "GITLAB_TEST_renamed_username", resourceData.Get("username"))
+	require.Equal(t, "GITLAB_TEST_api_token", resourceData.Get("api_token"))
+	require.Equal(t, "UsernamePassword", resourceData.Get("authorization").(map[string]interface{})["scheme"])
+}
+
+// verifies that flattening does not fail when Azure DevOps returns no authorization
+func TestServiceEndpointGitLab_Flatten_NoAuthorization(t *testing.T) {
+	ep := newGitLabTestServiceEndpoint("https://gitlab.com", "", "")
+	ep.Authorization = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGitLab().Schema, map[string]interface{}{
+		"username": "GITLAB_TEST_username",
+	})
+	flattenServiceEndpointGitLab(resourceData, &ep, gitLabTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "GITLAB_TEST_username", resourceData.Get("username"))
+	require.Equal(t, "https://gitlab.com", resourceData.Get("url"))
+}
+
+// validates that an error is thrown if the url ends with a slash
+func TestServiceEndpointGitLab_UrlTrailingSlashIsError(t *testing.T) {
+	urlSchema := ResourceServiceEndpointGitLab().Schema["url"]
+
+	_, errors := urlSchema.ValidateFunc("https://gitlab.com", "url")
+	require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+
+	_, errors = urlSchema.ValidateFunc("https://gitlab.com/", "url")
+	require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointGitLab_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range gitLabTestCases {
+		r := ResourceServiceEndpointGitLab()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGitLab(resourceData, &tc.endpoint, gitLabTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointGitLab_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range gitLabTestCases {
+		r := ResourceServiceEndpointGitLab()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGitLab(resourceData, &tc.endpoint, gitLabTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(gitLabTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointGitLab_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range gitLabTestCases {
+		r := ResourceServiceEndpointGitLab()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGitLab(resourceData, &tc.endpoint, gitLabTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				gitLabTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointGitLab_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range gitLabTestCases {
+		r := ResourceServiceEndpointGitLab()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointGitLab(resourceData, &tc.endpoint, gitLabTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
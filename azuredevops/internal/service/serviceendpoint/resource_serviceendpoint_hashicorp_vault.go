@@ -0,0 +1,336 @@
+package serviceendpoint
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	vaultAuthSchemeToken       = "Token"
+	vaultAuthSchemeAppRole     = "AppRole"
+	vaultAuthSchemeCertificate = "Certificate"
+)
+
+// ResourceServiceEndpointHashiCorpVault schema and implementation for HashiCorp Vault service endpoint resource
+func ResourceServiceEndpointHashiCorpVault() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointHashiCorpVaultCreate,
+		Read:   resourceServiceEndpointHashiCorpVaultRead,
+		Update: resourceServiceEndpointHashiCorpVaultUpdate,
+		Delete: resourceServiceEndpointHashiCorpVaultDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:   baseSchema(),
+	}
+
+	r.Schema["url"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: func(i interface{}, key string) (_ []string, errors []error) {
+			url, ok := i.(string)
+			if !ok {
+				errors = append(errors, fmt.Errorf("expected type of %q to be string", key))
+				return
+			}
+			if strings.HasSuffix(url, "/") {
+				errors = append(errors, fmt.Errorf("%q should not end with slash, got %q.", key, url))
+				return
+			}
+			return validation.IsURLWithHTTPorHTTPS(url, key)
+		},
+		Description: "Url of the HashiCorp Vault server",
+	}
+
+	r.Schema["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The Vault Enterprise namespace",
+	}
+
+	r.Schema["accept_untrusted_certs"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Accept self-signed TLS certificates presented by the Vault server",
+	}
+
+	authBlocks := []string{"authentication_token", "authentication_approle", "authentication_certificate"}
+
+	r.Schema["authentication_token"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token": {
+					Description:  "The Vault token.",
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		ExactlyOneOf: authBlocks,
+	}
+
+	r.Schema["authentication_approle"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_id": {
+					Description:  "The AppRole role ID.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"secret_id": {
+					Description:  "The AppRole secret ID.",
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"mount_path": {
+					Description:  "The path the AppRole auth method is mounted at.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "approle",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		ExactlyOneOf: authBlocks,
+	}
+
+	r.Schema["authentication_certificate"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate": {
+					Description:  "The PEM encoded client certificate.",
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"private_key": {
+					Description:  "The PEM encoded private key of the client certificate.",
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"mount_path": {
+					Description:  "The path the TLS certificate auth method is mounted at.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "cert",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		ExactlyOneOf: authBlocks,
+	}
+
+	return r
+}
+
+func resourceServiceEndpointHashiCorpVaultCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, _, err := expandServiceEndpointHashiCorpVault(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointHashiCorpVaultRead(d, m)
+}
+
+func resourceServiceEndpointHashiCorpVaultRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenServiceEndpointHashiCorpVault(d, serviceEndpoint, (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	return nil
+}
+
+func resourceServiceEndpointHashiCorpVaultUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectID, err := expandServiceEndpointHashiCorpVault(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
+
+	if err != nil {
+		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	flattenServiceEndpointHashiCorpVault(d, updatedServiceEndpoint, projectID.String())
+	return resourceServiceEndpointHashiCorpVaultRead(d, m)
+}
+
+func resourceServiceEndpointHashiCorpVaultDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint, projectId, err := expandServiceEndpointHashiCorpVault(d)
+	if err != nil {
+		return fmt.Errorf(errMsgTfConfigRead, err)
+	}
+
+	return deleteServiceEndpoint(clients, projectId, serviceEndpoint.Id, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointHashiCorpVault(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("hashicorpvault")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+
+	data := map[string]string{
+		"namespace":            d.Get("namespace").(string),
+		"acceptUntrustedCerts": strconv.FormatBool(d.Get("accept_untrusted_certs").(bool)),
+	}
+
+	var authScheme string
+	authParams := make(map[string]string)
+
+	if x, ok := d.GetOk("authentication_token"); ok {
+		authScheme = vaultAuthSchemeToken
+		msi := x.([]interface{})[0].(map[string]interface{})
+		authParams["token"], ok = msi["token"].(string)
+		if !ok {
+			return nil, nil, errors.New("Unable to read 'token'")
+		}
+	} else if x, ok := d.GetOk("authentication_approle"); ok {
+		authScheme = vaultAuthSchemeAppRole
+		msi := x.([]interface{})[0].(map[string]interface{})
+		authParams["roleId"], ok = msi["role_id"].(string)
+		if !ok {
+			return nil, nil, errors.New("Unable to read 'role_id'")
+		}
+		authParams["secretId"], ok = msi["secret_id"].(string)
+		if !ok {
+			return nil, nil, errors.New("Unable to read 'secret_id'")
+		}
+		data["authMountPath"] = msi["mount_path"].(string)
+	} else if x, ok := d.GetOk("authentication_certificate"); ok {
+		authScheme = vaultAuthSchemeCertificate
+		msi := x.([]interface{})[0].(map[string]interface{})
+		authParams["certificate"], ok = msi["certificate"].(string)
+		if !ok {
+			return nil, nil, errors.New("Unable to read 'certificate'")
+		}
+		authParams["privateKey"], ok = msi["private_key"].(string)
+		if !ok {
+			return nil, nil, errors.New("Unable to read 'private_key'")
+		}
+		data["authMountPath"] = msi["mount_path"].(string)
+	}
+
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &authParams,
+		Scheme:     &authScheme,
+	}
+	serviceEndpoint.Data = &data
+
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointHashiCorpVault(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("url", *serviceEndpoint.Url)
+
+	data := map[string]string{}
+	if serviceEndpoint.Data != nil {
+		data = *serviceEndpoint.Data
+	}
+	d.Set("namespace", data["namespace"])
+	if acceptUntrustedCerts, err := strconv.ParseBool(data["acceptUntrustedCerts"]); err == nil {
+		d.Set("accept_untrusted_certs", acceptUntrustedCerts)
+	}
+
+	// secrets are not returned by the service, keep the configured values
+	switch {
+	case strings.EqualFold(*serviceEndpoint.Authorization.Scheme, vaultAuthSchemeToken):
+		if _, ok := d.GetOk("authentication_token"); !ok {
+			d.Set("authentication_token", []interface{}{map[string]interface{}{
+				"token": "",
+			}})
+		}
+	case strings.EqualFold(*serviceEndpoint.Authorization.Scheme, vaultAuthSchemeAppRole):
+		auth := map[string]interface{}{
+			"role_id":    "",
+			"secret_id":  "",
+			"mount_path": data["authMountPath"],
+		}
+		if x, ok := d.GetOk("authentication_approle"); ok {
+			current := x.([]interface{})[0].(map[string]interface{})
+			auth["secret_id"] = current["secret_id"]
+		}
+		if serviceEndpoint.Authorization.Parameters != nil {
+			auth["role_id"] = (*serviceEndpoint.Authorization.Parameters)["roleId"]
+		}
+		d.Set("authentication_approle", []interface{}{auth})
+	case strings.EqualFold(*serviceEndpoint.Authorization.Scheme, vaultAuthSchemeCertificate):
+		auth := map[string]interface{}{
+			"certificate": "",
+			"private_key": "",
+			"mount_path":  data["authMountPath"],
+		}
+		if x, ok := d.GetOk("authentication_certificate"); ok {
+			current := x.([]interface{})[0].(map[string]interface{})
+			auth["certificate"] = current["certificate"]
+			auth["private_key"] = current["private_key"]
+		}
+		d.Set("authentication_certificate", []interface{}{auth})
+	}
+}
@@ -0,0 +1,357 @@
+//go:build (all || resource_serviceendpoint_hashicorp_vault) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_hashicorp_vault
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var vaultTestServiceEndpointProjectID = uuid.New()
+
+func newVaultTestServiceEndpoint(scheme string, params map[string]string, data map[string]string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &params,
+			Scheme:     converter.String(scheme),
+		},
+		Data:        &data,
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("hashicorpvault"),
+		Url:         converter.String("https://vault.example.com:8200"),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &vaultTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the secrets cannot be read back from Azure DevOps, so each case carries the configuration providing them
+var vaultTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newVaultTestServiceEndpoint(vaultAuthSchemeToken,
+			map[string]string{"token": "VAULT_TEST_token"},
+			map[string]string{"namespace": "", "acceptUntrustedCerts": "false"}),
+		config: map[string]interface{}{
+			"authentication_token": []interface{}{map[string]interface{}{"token": "VAULT_TEST_token"}},
+		},
+	},
+	{
+		endpoint: newVaultTestServiceEndpoint(vaultAuthSchemeAppRole,
+			map[string]string{"roleId": "ROLE_ID", "secretId": "VAULT_TEST_secret_id"},
+			map[string]string{"namespace": "admin", "acceptUntrustedCerts": "false", "authMountPath": "approle"}),
+		config: map[string]interface{}{
+			"authentication_approle": []interface{}{map[string]interface{}{
+				"role_id":   "ROLE_ID",
+				"secret_id": "VAULT_TEST_secret_id",
+			}},
+		},
+	},
+	{
+		endpoint: newVaultTestServiceEndpoint(vaultAuthSchemeCertificate,
+			map[string]string{"certificate": "VAULT_TEST_certificate", "privateKey": "VAULT_TEST_private_key"},
+			map[string]string{"namespace": "", "acceptUntrustedCerts": "true", "authMountPath": "tls"}),
+		config: map[string]interface{}{
+			"authentication_certificate": []interface{}{map[string]interface{}{
+				"certificate": "VAULT_TEST_certificate",
+				"private_key": "VAULT_TEST_private_key",
+			}},
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointHashiCorpVault_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range vaultTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointHashiCorpVault().Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointHashiCorpVault(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, vaultTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the configured secrets are kept when Azure DevOps does not return them
+func TestServiceEndpointHashiCorpVault_Flatten_KeepsSecrets(t *testing.T) {
+	for _, tc := range vaultTestCases {
+		serviceEndpoint := tc.endpoint
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{"roleId": "ROLE_ID"},
+			Scheme:     tc.endpoint.Authorization.Scheme,
+		}
+
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointHashiCorpVault().Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &serviceEndpoint, vaultTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, _, err := expandServiceEndpointHashiCorpVault(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, *tc.endpoint.Authorization, *serviceEndpointAfterRoundTrip.Authorization)
+	}
+}
+
+// verifies that the role ID and mount path are read back from Azure DevOps
+func TestServiceEndpointHashiCorpVault_Flatten_AppRoleReadsRoleIdAndMountPath(t *testing.T) {
+	tc := vaultTestCases[1]
+	serviceEndpoint := newVaultTestServiceEndpoint(vaultAuthSchemeAppRole,
+		map[string]string{"roleId": "OTHER_ROLE_ID"},
+		map[string]string{"namespace": "admin", "acceptUntrustedCerts": "false", "authMountPath": "ci-approle"})
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointHashiCorpVault().Schema, tc.config)
+	flattenServiceEndpointHashiCorpVault(resourceData, &serviceEndpoint, vaultTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "OTHER_ROLE_ID", resourceData.Get("authentication_approle.0.role_id"))
+	require.Equal(t, "VAULT_TEST_secret_id", resourceData.Get("authentication_approle.0.secret_id"))
+	require.Equal(t, "ci-approle", resourceData.Get("authentication_approle.0.mount_path"))
+	require.Equal(t, "admin", resourceData.Get("namespace"))
+}
+
+// verifies that an imported service endpoint has empty secrets for each authorization scheme
+func TestServiceEndpointHashiCorpVault_Flatten_Import(t *testing.T) {
+	for _, tc := range vaultTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointHashiCorpVault().Schema, nil)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, _, err := expandServiceEndpointHashiCorpVault(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, *tc.endpoint.Authorization.Scheme, *serviceEndpointAfterRoundTrip.Authorization.Scheme)
+		for k, v := range *serviceEndpointAfterRoundTrip.Authorization.Parameters {
+			if k != "roleId" {
+				require.Empty(t, v, "secret %s unexpectedly set on import", k)
+			}
+		}
+	}
+}
+
+// validates that an error is thrown if the url ends with a slash
+func TestServiceEndpointHashiCorpVault_UrlTrailingSlashIsError(t *testing.T) {
+	urlSchema := ResourceServiceEndpointHashiCorpVault().Schema["url"]
+
+	_, errors := urlSchema.ValidateFunc("https://vault.example.com:8200", "url")
+	require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+
+	_, errors = urlSchema.ValidateFunc("https://vault.example.com:8200/", "url")
+	require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointHashiCorpVault_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vaultTestCases {
+		r := ResourceServiceEndpointHashiCorpVault()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointHashiCorpVault_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vaultTestCases {
+		r := ResourceServiceEndpointHashiCorpVault()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(vaultTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that the resource is removed from state when the service endpoint no longer exists
+func TestServiceEndpointHashiCorpVault_Read_RemovesDeletedEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vaultTestCases {
+		r := ResourceServiceEndpointHashiCorpVault()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(vaultTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(&serviceendpoint.ServiceEndpoint{}, nil).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Nil(t, err)
+		require.Equal(t, "", resourceData.Id())
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointHashiCorpVault_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vaultTestCases {
+		r := ResourceServiceEndpointHashiCorpVault()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				vaultTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointHashiCorpVault_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vaultTestCases {
+		r := ResourceServiceEndpointHashiCorpVault()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointHashiCorpVault(resourceData, &tc.endpoint, vaultTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that changing rotation_trigger updates the service endpoint in place and sends the secret again
+func TestServiceEndpointHashiCorpVault_Update_RotationTriggerResendsSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointHashiCorpVault()
+	rawConfig := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			"project_id":            vaultTestServiceEndpointProjectID.String(),
+			"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+			"url":                   "https://vault.example.com:8200",
+			"authentication_token":  []interface{}{map[string]interface{}{"token": "UNIT_TEST_TOKEN"}},
+			"rotation_trigger":      map[string]interface{}{"version": version},
+		}
+	}
+	stateData := schema.TestResourceDataRaw(t, r.Schema, rawConfig("1"))
+	stateData.SetId(uuid.New().String())
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig("2")), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, "UNIT_TEST_TOKEN", (*args.Endpoint.Authorization.Parameters)["token"])
+			return nil, errors.New("UpdateServiceEndpoint() Failed")
+		}).
+		Times(1)
+
+	err = r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
@@ -31,10 +32,11 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 	}
 
 	r.Schema["webhook_name"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		DefaultFunc: schema.EnvDefaultFunc("AZDO_INCOMING_WEBHOOK_SERVICE_CONNECTION_WEBHOOK_NAME", nil),
-		Description: "The name of the WebHook.",
+		Type:         schema.TypeString,
+		Required:     true,
+		DefaultFunc:  schema.EnvDefaultFunc("AZDO_INCOMING_WEBHOOK_SERVICE_CONNECTION_WEBHOOK_NAME", nil),
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The name of the WebHook.",
 	}
 	r.Schema["secret"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -95,7 +97,7 @@ func resourceServiceEndpointIncomingWebhookUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -134,6 +136,8 @@ func expandServiceEndpointIncomingWebhook(d *schema.ResourceData) (*serviceendpo
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointIncomingWebhook(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
-	d.Set("webhook_name", (*serviceEndpoint.Authorization.Parameters)["webhookname"])
-	d.Set("http_header", (*serviceEndpoint.Authorization.Parameters)["header"])
+	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
+		d.Set("webhook_name", (*serviceEndpoint.Authorization.Parameters)["webhookname"])
+		d.Set("http_header", (*serviceEndpoint.Authorization.Parameters)["header"])
+	}
 }
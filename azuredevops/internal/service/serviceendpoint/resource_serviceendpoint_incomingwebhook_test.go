@@ -61,6 +61,18 @@ func TestServiceEndpointIncomingWebhook_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// verifies that flattening does not fail when Azure DevOps returns no authorization
+func TestServiceEndpointIncomingWebhook_Flatten_NoAuthorization(t *testing.T) {
+	serviceEndpoint := incomingWebhookTestServiceEndpoint
+	serviceEndpoint.Authorization = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointIncomingWebhook().Schema, nil)
+	flattenServiceEndpointIncomingWebhook(resourceData, &serviceEndpoint, incomingWebhookTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "UNIT_TEST_CONN_NAME", resourceData.Get("service_endpoint_name"))
+	require.Equal(t, "", resourceData.Get("webhook_name"))
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointIncomingWebhook_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -155,6 +167,12 @@ func TestServiceEndpointIncomingWebhook_Update_DoesNotSwallowError(t *testing.T)
 		EndpointId: incomingWebhookTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
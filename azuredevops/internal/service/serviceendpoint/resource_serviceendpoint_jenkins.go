@@ -2,6 +2,7 @@ package serviceendpoint
 
 import (
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -115,7 +116,7 @@ func resourceServiceEndpointJenkinsUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -162,10 +163,15 @@ func flattenServiceEndpointJenkins(d *schema.ResourceData, serviceEndpoint *serv
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("url", *serviceEndpoint.Url)
 	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	unsecured, err := strconv.ParseBool((*serviceEndpoint.Data)["AcceptUntrustedCerts"])
-	if err != nil {
-		fmt.Println(err)
+	if serviceEndpoint.Data == nil {
 		return
 	}
-	d.Set("accept_untrusted_certs", unsecured)
+	if v, ok := (*serviceEndpoint.Data)["AcceptUntrustedCerts"]; ok && v != "" {
+		acceptUntrustedCerts, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Printf("[WARN] Failed to parse Jenkins AcceptUntrustedCerts value %q (service endpoint: %s): %+v", v, converter.ToString(serviceEndpoint.Name, ""), err)
+			return
+		}
+		d.Set("accept_untrusted_certs", acceptUntrustedCerts)
+	}
 }
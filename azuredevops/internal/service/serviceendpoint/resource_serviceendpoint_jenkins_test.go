@@ -168,6 +168,12 @@ func testServiceEndpointJenkins_Update_DoesNotSwallowError(t *testing.T, ep *ser
 		EndpointId: ep.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -178,5 +184,18 @@ func testServiceEndpointJenkins_Update_DoesNotSwallowError(t *testing.T, ep *ser
 	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
 }
 func TestServiceEndpointJenkins_Update_DoesNotSwallowErrorPassword(t *testing.T) {
-	testServiceEndpointJenkins_Delete_DoesNotSwallowError(t, &jenkinsTestServiceEndpointPassword, jenkinsTestServiceEndpointProjectIDpassword)
+	testServiceEndpointJenkins_Update_DoesNotSwallowError(t, &jenkinsTestServiceEndpointPassword, jenkinsTestServiceEndpointProjectIDpassword)
+}
+
+// verifies that a missing AcceptUntrustedCerts data field does not break the flatten
+func TestServiceEndpointJenkins_Flatten_MissingAcceptUntrustedCerts(t *testing.T) {
+	ep := jenkinsTestServiceEndpointPassword
+	ep.Data = &map[string]string{}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointJenkins().Schema, nil)
+	flattenServiceEndpointJenkins(resourceData, &ep, jenkinsTestServiceEndpointProjectIDpassword.String())
+
+	require.Equal(t, "JENKINS_TEST_username", resourceData.Get("username"))
+	require.Equal(t, "https://www.jenkins.com", resourceData.Get("url"))
+	require.False(t, resourceData.Get("accept_untrusted_certs").(bool))
 }
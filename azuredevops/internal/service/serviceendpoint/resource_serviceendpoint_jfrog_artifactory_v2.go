@@ -3,6 +3,7 @@ package serviceendpoint
 import (
 	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -142,13 +143,13 @@ func resourceServiceEndpointJFrogArtifactoryV2Update(d *schema.ResourceData, m i
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
 	}
 
-	flattenServiceEndpointArtifactory(d, updatedServiceEndpoint, projectID.String())
+	flattenServiceEndpointArtifactoryV2(d, updatedServiceEndpoint, projectID.String())
 	return resourceServiceEndpointJFrogArtifactoryV2Read(d, m)
 }
 
@@ -182,7 +183,7 @@ func flattenServiceEndpointArtifactoryV2(d *schema.ResourceData, serviceEndpoint
 			d.Set("authentication_token", []interface{}{auth})
 		}
 	} else {
-		panic(fmt.Errorf("inconsistent authorization scheme. Expected: (Token, UsernamePassword)  , but got %s", *serviceEndpoint.Authorization.Scheme))
+		log.Printf("[WARN] Inconsistent authorization scheme for service endpoint %s. Expected: (Token, UsernamePassword), but got %s", converter.ToString(serviceEndpoint.Name, ""), *serviceEndpoint.Authorization.Scheme)
 	}
 
 	d.Set("url", *serviceEndpoint.Url)
@@ -21,7 +21,7 @@ import (
 
 var artifactoryV2TestServiceEndpointIDpassword = uuid.New()
 var artifactoryV2RandomServiceEndpointProjectIDpassword = uuid.New()
-var artifactoryV2TestServiceEndpointProjectIDpassword = &artifactoryRandomServiceEndpointProjectIDpassword
+var artifactoryV2TestServiceEndpointProjectIDpassword = &artifactoryV2RandomServiceEndpointProjectIDpassword
 
 var artifactoryV2TestServiceEndpointPassword = serviceendpoint.ServiceEndpoint{
 	Authorization: &serviceendpoint.EndpointAuthorization{
@@ -50,7 +50,7 @@ var artifactoryV2TestServiceEndpointPassword = serviceendpoint.ServiceEndpoint{
 
 var artifactoryV2TestServiceEndpointID = uuid.New()
 var artifactoryV2RandomServiceEndpointProjectID = uuid.New()
-var artifactoryV2TestServiceEndpointProjectID = &artifactoryRandomServiceEndpointProjectID
+var artifactoryV2TestServiceEndpointProjectID = &artifactoryV2RandomServiceEndpointProjectID
 
 var artifactoryV2TestServiceEndpoint = serviceendpoint.ServiceEndpoint{
 	Authorization: &serviceendpoint.EndpointAuthorization{
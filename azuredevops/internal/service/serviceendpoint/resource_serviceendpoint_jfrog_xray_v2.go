@@ -16,7 +16,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
-// ResourceServiceEndpointJFrogXRayV2 schema and implementation for JFrog Artifactory service endpoint resource
+// ResourceServiceEndpointJFrogXRayV2 schema and implementation for JFrog Xray service endpoint resource
 func ResourceServiceEndpointJFrogXRayV2() *schema.Resource {
 	r := &schema.Resource{
 		Create: resourceServiceEndpointJFrogXRayV2Create,
@@ -48,13 +48,13 @@ func ResourceServiceEndpointJFrogXRayV2() *schema.Resource {
 			}
 			return validation.IsURLWithHTTPorHTTPS(url, key)
 		},
-		Description: "Url for the JFrog Artifactory Server",
+		Description: "Url for the JFrog Xray Server",
 	}
 
 	at := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"token": {
-				Description: "The JFrog Artifactory access token.",
+				Description: "The JFrog Xray access token.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
@@ -65,13 +65,13 @@ func ResourceServiceEndpointJFrogXRayV2() *schema.Resource {
 	aup := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Description: "The JFrog Artifactory user name.",
+				Description: "The JFrog Xray user name.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
 			},
 			"password": {
-				Description: "The JFrog Artifactory password.",
+				Description: "The JFrog Xray password.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
@@ -142,7 +142,7 @@ func resourceServiceEndpointJFrogXRayV2Update(d *schema.ResourceData, m interfac
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -21,7 +21,7 @@ import (
 
 var xrayV2TestServiceEndpointIDpassword = uuid.New()
 var xrayV2RandomServiceEndpointProjectIDpassword = uuid.New()
-var xrayV2TestServiceEndpointProjectIDpassword = &artifactoryRandomServiceEndpointProjectIDpassword
+var xrayV2TestServiceEndpointProjectIDpassword = &xrayV2RandomServiceEndpointProjectIDpassword
 
 var xrayV2TestServiceEndpointPassword = serviceendpoint.ServiceEndpoint{
 	Authorization: &serviceendpoint.EndpointAuthorization{
@@ -50,7 +50,7 @@ var xrayV2TestServiceEndpointPassword = serviceendpoint.ServiceEndpoint{
 
 var xrayV2TestServiceEndpointID = uuid.New()
 var xrayV2RandomServiceEndpointProjectID = uuid.New()
-var xrayV2TestServiceEndpointProjectID = &artifactoryRandomServiceEndpointProjectID
+var xrayV2TestServiceEndpointProjectID = &xrayV2RandomServiceEndpointProjectID
 
 var xrayV2TestServiceEndpoint = serviceendpoint.ServiceEndpoint{
 	Authorization: &serviceendpoint.EndpointAuthorization{
@@ -227,7 +227,7 @@ func resourceServiceEndpointKubernetesUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -255,8 +255,10 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 
 	switch d.Get(resourceAttrAuthType).(string) {
 	case "AzureSubscription":
-		configurationRaw := d.Get(resourceBlockAzSubscription).(*schema.Set).List()
-		configuration := configurationRaw[0].(map[string]interface{})
+		configuration, err := expandKubernetesAuthorizationBlock(d, resourceBlockAzSubscription)
+		if err != nil {
+			return nil, nil, err
+		}
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"azureEnvironment": configuration["azure_environment"].(string),
@@ -275,20 +277,17 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 			"clusterAdmin":          strconv.FormatBool(configuration["cluster_admin"].(bool)),
 		}
 	case "Kubeconfig":
-		configurationRaw := d.Get(resourceBlockKubeconfig).([]interface{})
-		configuration := configurationRaw[0].(map[string]interface{})
+		configuration, err := expandKubernetesAuthorizationBlock(d, resourceBlockKubeconfig)
+		if err != nil {
+			return nil, nil, err
+		}
 
 		clusterContextInput := configuration["cluster_context"].(string)
 		if clusterContextInput == "" {
-			kubeConfigYAML := configuration["kube_config"].(string)
-			var kubeConfigYAMLUnmarshalled map[string]interface{}
-			err := yaml.Unmarshal([]byte(kubeConfigYAML), &kubeConfigYAMLUnmarshalled)
+			clusterContextInput, err = getKubeconfigDefaultContext(configuration["kube_config"].(string))
 			if err != nil {
-				errResult := fmt.Errorf("kube_config contains an invalid YAML: %s", err)
-				return nil, nil, errResult
+				return nil, nil, err
 			}
-			clusterContextInputList := kubeConfigYAMLUnmarshalled["contexts"].([]interface{})[0].(map[string]interface{})
-			clusterContextInput = clusterContextInputList["name"].(string)
 		}
 
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
@@ -304,8 +303,10 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 			"acceptUntrustedCerts": fmt.Sprintf("%v", configuration["accept_untrusted_certs"].(bool)),
 		}
 	case "ServiceAccount":
-		configurationRaw := d.Get(resourceBlockServiceAccount).([]interface{})
-		configuration := configurationRaw[0].(map[string]interface{})
+		configuration, err := expandKubernetesAuthorizationBlock(d, resourceBlockServiceAccount)
+		if err != nil {
+			return nil, nil, err
+		}
 
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
@@ -323,6 +324,41 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 	return serviceEndpoint, projectID, nil
 }
 
+// expandKubernetesAuthorizationBlock returns the configuration block matching the authorization type
+func expandKubernetesAuthorizationBlock(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+	var blocks []interface{}
+	switch v := d.Get(key).(type) {
+	case *schema.Set:
+		blocks = v.List()
+	case []interface{}:
+		blocks = v
+	}
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil, fmt.Errorf("a `%s` block is required when `%s` is %q", key, resourceAttrAuthType, d.Get(resourceAttrAuthType).(string))
+	}
+	return blocks[0].(map[string]interface{}), nil
+}
+
+// getKubeconfigDefaultContext returns the current context of a kubeconfig, or its first context if none is set
+func getKubeconfigDefaultContext(kubeConfigYAML string) (string, error) {
+	var kubeConfig struct {
+		CurrentContext string `yaml:"current-context"`
+		Contexts       []struct {
+			Name string `yaml:"name"`
+		} `yaml:"contexts"`
+	}
+	if err := yaml.Unmarshal([]byte(kubeConfigYAML), &kubeConfig); err != nil {
+		return "", fmt.Errorf("kube_config contains an invalid YAML: %s", err)
+	}
+	if kubeConfig.CurrentContext != "" {
+		return kubeConfig.CurrentContext, nil
+	}
+	if len(kubeConfig.Contexts) == 0 || kubeConfig.Contexts[0].Name == "" {
+		return "", fmt.Errorf("kube_config does not define any context, set `cluster_context` explicitly")
+	}
+	return kubeConfig.Contexts[0].Name, nil
+}
+
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
@@ -361,10 +397,14 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 		var kubeconfig map[string]interface{}
 		kubeconfigSet := d.Get("kubeconfig").([]interface{})
 
-		configuration := kubeconfigSet[0].(map[string]interface{})
+		// the kubeconfig is confidential and cannot be read back, e.g. when importing
+		kubeConfig := ""
+		if len(kubeconfigSet) > 0 && kubeconfigSet[0] != nil {
+			kubeConfig = kubeconfigSet[0].(map[string]interface{})["kube_config"].(string)
+		}
 		acceptUntrustedCerts, _ := strconv.ParseBool((*serviceEndpoint.Data)["acceptUntrustedCerts"])
 		kubeconfig = map[string]interface{}{
-			"kube_config":            configuration["kube_config"].(string),
+			"kube_config":            kubeConfig,
 			"cluster_context":        (*serviceEndpoint.Authorization.Parameters)["clusterContext"],
 			"accept_untrusted_certs": acceptUntrustedCerts,
 		}
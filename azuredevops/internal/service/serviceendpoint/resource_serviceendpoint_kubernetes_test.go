@@ -230,6 +230,12 @@ func TestServiceEndpointKubernetesForAzureSubscriptionUpdateDoesNotSwallowError(
 		EndpointId: kubernetesTestServiceEndpointForAzureSubscription.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -355,6 +361,12 @@ func TestServiceEndpointKubernetesForKubeconfigUpdateDoesNotSwallowError(t *test
 		EndpointId: kubernetesTestServiceEndpointForKubeconfig.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -379,6 +391,45 @@ func TestServiceEndpointKubernetesForServiceAccountExpandFlattenRoundtrip(t *tes
 	require.Equal(t, kubernetesTestServiceEndpointProjectID, projectID)
 }
 
+// verifies that expanding fails instead of panicking when the block for the authorization type is missing
+func TestServiceEndpointKubernetesExpandMissingAuthorizationBlock(t *testing.T) {
+	for _, authorizationType := range []string{"AzureSubscription", "Kubeconfig", "ServiceAccount"} {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointKubernetes().Schema, nil)
+		flattenServiceEndpointKubernetes(resourceData, createkubernetesTestServiceEndpointForServiceAccount(), kubernetesTestServiceEndpointProjectID.String())
+		resourceData.Set("service_account", nil)
+		resourceData.Set(resourceAttrAuthType, authorizationType)
+
+		_, _, err := expandServiceEndpointKubernetes(resourceData)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "block is required")
+	}
+}
+
+// verifies that the cluster context defaults to the current context of the kubeconfig, then to its first context
+func TestServiceEndpointKubernetesKubeconfigDefaultContext(t *testing.T) {
+	clusterContext, err := getKubeconfigDefaultContext("contexts:\n- name: dev-frontend\n- name: dev-backend\ncurrent-context: dev-backend\n")
+	require.Nil(t, err)
+	require.Equal(t, "dev-backend", clusterContext)
+
+	clusterContext, err = getKubeconfigDefaultContext("contexts:\n- name: dev-frontend\n- name: dev-backend\n")
+	require.Nil(t, err)
+	require.Equal(t, "dev-frontend", clusterContext)
+
+	_, err = getKubeconfigDefaultContext("apiVersion: v1\nkind: Config\n")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "cluster_context")
+}
+
+// verifies that flattening a kubeconfig service endpoint does not fail when no kubeconfig is known, e.g. on import
+func TestServiceEndpointKubernetesForKubeconfigFlattenWithoutConfiguration(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointKubernetes().Schema, nil)
+	flattenServiceEndpointKubernetes(resourceData, createkubernetesTestServiceEndpointForKubeconfig(), kubernetesTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "", resourceData.Get("kubeconfig.0.kube_config"))
+	require.Equal(t, "dev-frontend", resourceData.Get("kubeconfig.0.cluster_context"))
+	require.Equal(t, true, resourceData.Get("kubeconfig.0.accept_untrusted_certs"))
+}
+
 // verifies that if an error is produced on a read, it is not swallowed
 func TestServiceEndpointKubernetesForServiceAccountCreateDoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -481,6 +532,12 @@ func TestServiceEndpointKubernetesForServiceAccountUpdateDoesNotSwallowError(t *
 		EndpointId: kubernetesTestServiceEndpointForServiceAccount.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
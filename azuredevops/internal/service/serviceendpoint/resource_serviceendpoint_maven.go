@@ -3,6 +3,8 @@ package serviceendpoint
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -58,6 +60,16 @@ func ResourceServiceEndpointMaven() *schema.Resource {
 		Description:  "This is the ID of the server that matches the id element of the repository/mirror that Maven tries to connect to",
 	}
 
+	r.Schema["repository_layout"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"default", "legacy"}, false),
+		Description:  "The layout of the Maven repository.",
+	}
+
+	r.Schema["release_policy"] = mavenRepositoryPolicySchema("The policy for downloading releases from the Maven repository.")
+	r.Schema["snapshot_policy"] = mavenRepositoryPolicySchema("The policy for downloading snapshots from the Maven repository.")
+
 	r.Schema["authentication_token"] = &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -101,6 +113,34 @@ func ResourceServiceEndpointMaven() *schema.Resource {
 	return r
 }
 
+func mavenRepositoryPolicySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"update_policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(always|daily|never|interval:[1-9][0-9]*)$`), "must be one of always, daily, never or interval:<minutes>"),
+				},
+				"checksum_policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"fail", "warn", "ignore"}, false),
+				},
+			},
+		},
+	}
+}
+
 func resourceServiceEndpointMavenCreate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint, _, err := expandServiceEndpointMaven(d)
@@ -144,7 +184,7 @@ func resourceServiceEndpointMavenUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -197,13 +237,51 @@ func expandServiceEndpointMaven(d *schema.ResourceData) (*serviceendpoint.Servic
 		Scheme:     &authScheme,
 	}
 
-	serviceEndpoint.Data = &map[string]string{
+	data := map[string]string{
 		"RepositoryId": d.Get("repository_id").(string),
 	}
+	if v, ok := d.GetOk("repository_layout"); ok {
+		data["RepositoryLayout"] = v.(string)
+	}
+	expandMavenRepositoryPolicy(d, "release_policy", "Releases", data)
+	expandMavenRepositoryPolicy(d, "snapshot_policy", "Snapshots", data)
+	serviceEndpoint.Data = &data
 
 	return serviceEndpoint, projectID, nil
 }
 
+// expandMavenRepositoryPolicy adds the keys of a release or snapshot policy, prefixed with dataPrefix, to the endpoint data.
+// Besides RepositoryId, the externalmavenrepository endpoint type stores RepositoryLayout and the
+// ReleasesEnabled, ReleasesUpdatePolicy, ReleasesChecksumPolicy, SnapshotsEnabled, SnapshotsUpdatePolicy
+// and SnapshotsChecksumPolicy data keys, which mirror the <releases> and <snapshots> elements of a Maven repository.
+func expandMavenRepositoryPolicy(d *schema.ResourceData, key string, dataPrefix string, data map[string]string) {
+	policies := d.Get(key).([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return
+	}
+	policy := policies[0].(map[string]interface{})
+	data[dataPrefix+"Enabled"] = strconv.FormatBool(policy["enabled"].(bool))
+	if v := policy["update_policy"].(string); v != "" {
+		data[dataPrefix+"UpdatePolicy"] = v
+	}
+	if v := policy["checksum_policy"].(string); v != "" {
+		data[dataPrefix+"ChecksumPolicy"] = v
+	}
+}
+
+func flattenMavenRepositoryPolicy(data map[string]string, dataPrefix string) []interface{} {
+	enabled, ok := data[dataPrefix+"Enabled"]
+	if !ok {
+		return nil
+	}
+	enabledValue, _ := strconv.ParseBool(enabled)
+	return []interface{}{map[string]interface{}{
+		"enabled":         enabledValue,
+		"update_policy":   data[dataPrefix+"UpdatePolicy"],
+		"checksum_policy": data[dataPrefix+"ChecksumPolicy"],
+	}}
+}
+
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointMaven(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
@@ -223,5 +301,11 @@ func flattenServiceEndpointMaven(d *schema.ResourceData, serviceEndpoint *servic
 		}
 	}
 	d.Set("url", *serviceEndpoint.Url)
-	d.Set("repository_id", (*serviceEndpoint.Data)["RepositoryId"])
+	if serviceEndpoint.Data != nil {
+		data := *serviceEndpoint.Data
+		d.Set("repository_id", data["RepositoryId"])
+		d.Set("repository_layout", data["RepositoryLayout"])
+		d.Set("release_policy", flattenMavenRepositoryPolicy(data, "Releases"))
+		d.Set("snapshot_policy", flattenMavenRepositoryPolicy(data, "Snapshots"))
+	}
 }
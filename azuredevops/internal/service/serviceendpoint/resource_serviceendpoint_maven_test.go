@@ -104,6 +104,62 @@ func TestServiceEndpointMaven_ExpandFlatten_RoundtripToken(t *testing.T) {
 	testServiceEndpointMaven_ExpandFlatten_Roundtrip(t, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID)
 }
 
+func TestServiceEndpointMaven_ExpandFlatten_RoundtripRepositoryPolicies(t *testing.T) {
+	ep := mavenTestServiceEndpoint
+	ep.Data = &map[string]string{
+		"RepositoryId":           "MAVEN_TEST_REPO",
+		"RepositoryLayout":       "legacy",
+		"ReleasesEnabled":        "true",
+		"ReleasesUpdatePolicy":   "daily",
+		"ReleasesChecksumPolicy": "fail",
+		"SnapshotsEnabled":       "false",
+	}
+	testServiceEndpointMaven_ExpandFlatten_Roundtrip(t, &ep, mavenTestServiceEndpointProjectID)
+
+	ep.Data = &map[string]string{
+		"RepositoryId":            "MAVEN_TEST_REPO",
+		"RepositoryLayout":        "default",
+		"ReleasesEnabled":         "false",
+		"ReleasesUpdatePolicy":    "never",
+		"ReleasesChecksumPolicy":  "ignore",
+		"SnapshotsEnabled":        "true",
+		"SnapshotsUpdatePolicy":   "always",
+		"SnapshotsChecksumPolicy": "warn",
+	}
+	testServiceEndpointMaven_ExpandFlatten_Roundtrip(t, &ep, mavenTestServiceEndpointProjectID)
+}
+
+// verifies that the release and snapshot policies are written to the data keys of the endpoint type
+func TestServiceEndpointMaven_Expand_RepositoryPolicyDataKeys(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointMaven().Schema, map[string]interface{}{
+		"project_id":            mavenTestServiceEndpointProjectID.String(),
+		"service_endpoint_name": "UNIT_TEST_CONN_NAME",
+		"url":                   "https://www.maven.com",
+		"repository_id":         "MAVEN_TEST_REPO",
+		"repository_layout":     "default",
+		"authentication_token":  []interface{}{map[string]interface{}{"token": "token"}},
+		"release_policy": []interface{}{map[string]interface{}{
+			"enabled":         true,
+			"update_policy":   "daily",
+			"checksum_policy": "fail",
+		}},
+		"snapshot_policy": []interface{}{map[string]interface{}{
+			"enabled": false,
+		}},
+	})
+
+	serviceEndpoint, _, err := expandServiceEndpointMaven(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"RepositoryId":           "MAVEN_TEST_REPO",
+		"RepositoryLayout":       "default",
+		"ReleasesEnabled":        "true",
+		"ReleasesUpdatePolicy":   "daily",
+		"ReleasesChecksumPolicy": "fail",
+		"SnapshotsEnabled":       "false",
+	}, *serviceEndpoint.Data)
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func testServiceEndpointMaven_Create_DoesNotSwallowError(t *testing.T, ep *serviceendpoint.ServiceEndpoint, id *uuid.UUID) {
 	ctrl := gomock.NewController(t)
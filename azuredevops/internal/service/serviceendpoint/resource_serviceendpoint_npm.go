@@ -2,6 +2,7 @@ package serviceendpoint
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,11 +41,29 @@ func ResourceServiceEndpointNpm() *schema.Resource {
 
 	r.Schema["access_token"] = &schema.Schema{
 		Type:         schema.TypeString,
-		Required:     true,
+		Optional:     true,
 		Sensitive:    true,
 		ValidateFunc: validation.StringIsNotWhiteSpace,
+		ExactlyOneOf: []string{"access_token", "username"},
 		Description:  "The access token for npm registry",
 	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		RequiredWith: []string{"password"},
+		Description:  "The username for npm registry",
+	}
+
+	r.Schema["password"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		RequiredWith: []string{"username"},
+		Description:  "The password for npm registry",
+	}
 	return r
 }
 
@@ -91,7 +110,7 @@ func resourceServiceEndpointNpmUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -114,11 +133,21 @@ func resourceServiceEndpointNpmDelete(d *schema.ResourceData, m interface{}) err
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointNpm(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
-	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
-		Parameters: &map[string]string{
-			"apitoken": d.Get("access_token").(string),
-		},
-		Scheme: converter.String("Token"),
+	if username, ok := d.GetOk("username"); ok {
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"username": username.(string),
+				"password": d.Get("password").(string),
+			},
+			Scheme: converter.String("UsernamePassword"),
+		}
+	} else {
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": d.Get("access_token").(string),
+			},
+			Scheme: converter.String("Token"),
+		}
 	}
 	serviceEndpoint.Type = converter.String("externalnpmregistry")
 	serviceEndpoint.Url = converter.String(d.Get("url").(string))
@@ -130,5 +159,11 @@ func flattenServiceEndpointNpm(d *schema.ResourceData, serviceEndpoint *servicee
 	doBaseFlattening(d, serviceEndpoint, projectID)
 
 	d.Set("url", *serviceEndpoint.Url)
-	d.Set("access_token", d.Get("access_token").(string))
+	if serviceEndpoint.Authorization != nil && strings.EqualFold(converter.ToString(serviceEndpoint.Authorization.Scheme, ""), "UsernamePassword") {
+		if serviceEndpoint.Authorization.Parameters != nil {
+			if username := (*serviceEndpoint.Authorization.Parameters)["username"]; username != "" {
+				d.Set("username", username)
+			}
+		}
+	}
 }
@@ -59,6 +59,27 @@ func TestServiceEndpointNpm_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// verifies that the flatten/expand round trip yields the same service endpoint when using username/password
+func TestServiceEndpointNpm_ExpandFlatten_RoundtripUsernamePassword(t *testing.T) {
+	ep := npmTestServiceEndpoint
+	ep.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "UNIT_TEST_USERNAME",
+			"password": "",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointNpm().Schema, nil)
+	flattenServiceEndpointNpm(resourceData, &ep, npmTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointNpm(resourceData)
+
+	require.Equal(t, ep, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, npmTestServiceEndpointProjectID, projectID)
+	require.Nil(t, err)
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointNpm_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -153,6 +174,12 @@ func TestServiceEndpointNpm_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: npmTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
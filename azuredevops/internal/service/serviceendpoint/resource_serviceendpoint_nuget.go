@@ -115,7 +115,7 @@ func resourceServiceEndpointNuGetUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -149,7 +149,6 @@ func expandServiceEndpointNuGet(d *schema.ResourceData) (*serviceendpoint.Servic
 	}
 
 	if pat := d.Get("personal_access_token"); pat != "" {
-		serviceEndpoint.Type = converter.String("externalnugetfeed")
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"apitoken": pat.(string),
@@ -159,7 +158,6 @@ func expandServiceEndpointNuGet(d *schema.ResourceData) (*serviceendpoint.Servic
 	}
 
 	if uname := d.Get("username"); uname != "" {
-		serviceEndpoint.Type = converter.String("externalnugetfeed")
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"username": uname.(string),
@@ -175,13 +173,13 @@ func flattenServiceEndpointNuGet(d *schema.ResourceData, serviceEndpoint *servic
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("feed_url", *serviceEndpoint.Url)
 
-	switch *serviceEndpoint.Authorization.Scheme {
-	case "None":
-		d.Set("api_key", d.Get("api_key"))
-	case "Token":
-		d.Set("personal_access_token", d.Get("personal_access_token"))
-	case "UsernamePassword":
-		d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
-		d.Set("password", d.Get("password"))
+	// 'nugetkey', 'apitoken' and 'password' are confidential and cannot be read from Azure DevOps
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Scheme == nil {
+		return
+	}
+	if *serviceEndpoint.Authorization.Scheme == "UsernamePassword" && serviceEndpoint.Authorization.Parameters != nil {
+		if username := (*serviceEndpoint.Authorization.Parameters)["username"]; username != "" {
+			d.Set("username", username)
+		}
 	}
 }
@@ -2,6 +2,7 @@ package serviceendpoint
 
 import (
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -38,6 +39,7 @@ func ResourceServiceEndpointOctopusDeploy() *schema.Resource {
 	r.Schema["api_key"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
+		Sensitive:    true,
 		ValidateFunc: validation.StringIsNotEmpty,
 	}
 	r.Schema["ignore_ssl_error"] = &schema.Schema{
@@ -91,7 +93,7 @@ func resourceServiceEndpointOctopusDeployUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -132,10 +134,14 @@ func flattenServiceEndpointOctopusDeploy(d *schema.ResourceData, serviceEndpoint
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("url", *serviceEndpoint.Url)
 
+	if serviceEndpoint.Data == nil {
+		return
+	}
 	if v, ok := (*serviceEndpoint.Data)["ignoreSslErrors"]; ok && v != "" {
 		ignoreSslErrors, err := strconv.ParseBool(v)
 		if err != nil {
-			panic(fmt.Errorf(" Failed to parse OctopusDeploy.ignore_ssl_error.(Project: %s), (service endpoint:%s) ,Error: %+v", *serviceEndpoint.Name, projectID, err))
+			log.Printf("[WARN] Failed to parse OctopusDeploy ignoreSslErrors value %q (project: %s, service endpoint: %s): %+v", v, projectID, converter.ToString(serviceEndpoint.Name, ""), err)
+			return
 		}
 		d.Set("ignore_ssl_error", ignoreSslErrors)
 	}
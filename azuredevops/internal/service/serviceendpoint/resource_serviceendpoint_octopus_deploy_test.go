@@ -0,0 +1,171 @@
+//go:build (all || resource_serviceendpoint_octopus_deploy) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_octopus_deploy
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var octopusDeployTestServiceEndpointID = uuid.New()
+var octopusDeployTestServiceEndpointProjectID = uuid.New()
+
+var octopusDeployTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Data: &map[string]string{
+		"ignoreSslErrors": "true",
+	},
+	Id:          &octopusDeployTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+	Type:        converter.String("OctopusEndpoint"),
+	Url:         converter.String("https://octopus.example.com"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &octopusDeployTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointOctopusDeploy_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointOctopusDeploy().Schema, nil)
+	flattenServiceEndpointOctopusDeploy(resourceData, &octopusDeployTestServiceEndpoint, octopusDeployTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointOctopusDeploy(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, octopusDeployTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, octopusDeployTestServiceEndpointProjectID, *projectID)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointOctopusDeploy_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointOctopusDeploy()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointOctopusDeploy(resourceData, &octopusDeployTestServiceEndpoint, octopusDeployTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &octopusDeployTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointOctopusDeploy_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointOctopusDeploy()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointOctopusDeploy(resourceData, &octopusDeployTestServiceEndpoint, octopusDeployTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: octopusDeployTestServiceEndpoint.Id,
+		Project:    converter.String(octopusDeployTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointOctopusDeploy_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointOctopusDeploy()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointOctopusDeploy(resourceData, &octopusDeployTestServiceEndpoint, octopusDeployTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: octopusDeployTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			octopusDeployTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointOctopusDeploy_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointOctopusDeploy()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenServiceEndpointOctopusDeploy(resourceData, &octopusDeployTestServiceEndpoint, octopusDeployTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &octopusDeployTestServiceEndpoint,
+		EndpointId: octopusDeployTestServiceEndpoint.Id,
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -0,0 +1,231 @@
+//go:build (all || resource_serviceendpoint_servicebus) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_servicebus
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var serviceBusTestServiceEndpointProjectID = uuid.New()
+
+func newServiceBusTestServiceEndpoint(description string, params map[string]string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &params,
+			Scheme:     converter.String("None"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("AzureServiceBus"),
+		Url:         converter.String("https://servicebus.windows.net"),
+		Description: converter.String(description),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &serviceBusTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String(description),
+			},
+		},
+	}
+}
+
+// the connection string cannot be read back from Azure DevOps, so each case carries the configuration providing it
+var serviceBusTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newServiceBusTestServiceEndpoint("UNIT_TEST_CONN_DESCRIPTION", map[string]string{
+			"serviceBusConnectionString": "Endpoint=sb://orders.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=SEND_KEY",
+			"serviceBusQueueName":        "orders",
+		}),
+		config: map[string]interface{}{
+			"connection_string": "Endpoint=sb://orders.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=SEND_KEY",
+		},
+	},
+	{
+		endpoint: newServiceBusTestServiceEndpoint("", map[string]string{
+			"serviceBusConnectionString": "Endpoint=sb://events.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=ROOT_KEY;EntityPath=deployments",
+			"serviceBusQueueName":        "deployments",
+		}),
+		config: map[string]interface{}{
+			"connection_string": "Endpoint=sb://events.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=ROOT_KEY;EntityPath=deployments",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointServiceBus_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range serviceBusTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointServiceBus().Schema, tc.config)
+		flattenServiceEndpointServiceBus(resourceData, &tc.endpoint, serviceBusTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointServiceBus(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, serviceBusTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the queue name is read back while the connection string is kept from the configuration
+func TestServiceEndpointServiceBus_Flatten_KeepsConnectionString(t *testing.T) {
+	tc := serviceBusTestCases[0]
+	ep := newServiceBusTestServiceEndpoint("UNIT_TEST_CONN_DESCRIPTION", map[string]string{
+		"serviceBusQueueName": "renamed",
+	})
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointServiceBus().Schema, tc.config)
+	flattenServiceEndpointServiceBus(resourceData, &ep, serviceBusTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "renamed", resourceData.Get("queue_name"))
+	require.Equal(t, tc.config["connection_string"], resourceData.Get("connection_string"))
+	require.Equal(t, "None", resourceData.Get("authorization").(map[string]interface{})["scheme"])
+}
+
+// verifies that flattening does not fail when Azure DevOps returns no authorization
+func TestServiceEndpointServiceBus_Flatten_NoAuthorization(t *testing.T) {
+	ep := newServiceBusTestServiceEndpoint("UNIT_TEST_CONN_DESCRIPTION", nil)
+	ep.Authorization = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointServiceBus().Schema, map[string]interface{}{
+		"queue_name": "orders",
+	})
+	flattenServiceEndpointServiceBus(resourceData, &ep, serviceBusTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "orders", resourceData.Get("queue_name"))
+	require.Equal(t, "UNIT_TEST_CONN_NAME", resourceData.Get("service_endpoint_name"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointServiceBus_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range serviceBusTestCases {
+		r := ResourceServiceEndpointServiceBus()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointServiceBus(resourceData, &tc.endpoint, serviceBusTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointServiceBus_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range serviceBusTestCases {
+		r := ResourceServiceEndpointServiceBus()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointServiceBus(resourceData, &tc.endpoint, serviceBusTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(serviceBusTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointServiceBus_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range serviceBusTestCases {
+		r := ResourceServiceEndpointServiceBus()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointServiceBus(resourceData, &tc.endpoint, serviceBusTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				serviceBusTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointServiceBus_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range serviceBusTestCases {
+		r := ResourceServiceEndpointServiceBus()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointServiceBus(resourceData, &tc.endpoint, serviceBusTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
@@ -0,0 +1,310 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointShare schema and implementation for sharing a service endpoint with other projects
+func ResourceServiceEndpointShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceEndpointShareCreate,
+		Read:   resourceServiceEndpointShareRead,
+		Update: resourceServiceEndpointShareUpdate,
+		Delete: resourceServiceEndpointShareDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The ID of the project that owns the service endpoint",
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The ID of the service endpoint to share",
+			},
+			"project_reference": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the project the service endpoint is shared with",
+						},
+						"service_endpoint_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "The name of the service endpoint in the target project. Defaults to the name of the shared service endpoint",
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+							Description:  "The description of the service endpoint in the target project",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceEndpointShareCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	endpoint, err := getSharedServiceEndpoint(d, clients)
+	if err != nil {
+		return err
+	}
+	if endpoint == nil {
+		return fmt.Errorf(" Service endpoint %s not found in project %s", d.Get("service_endpoint_id").(string), d.Get("project_id").(string))
+	}
+
+	if err := shareServiceEndpoint(clients, endpoint, d.Get("project_reference").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	d.SetId(endpoint.Id.String())
+	return resourceServiceEndpointShareRead(d, m)
+}
+
+func resourceServiceEndpointShareRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	if d.Get("service_endpoint_id").(string) == "" {
+		// imported resources only know about the ID
+		d.Set("service_endpoint_id", d.Id())
+	}
+
+	endpoint, err := getSharedServiceEndpoint(d, clients)
+	if err != nil {
+		return err
+	}
+	if endpoint == nil {
+		d.SetId("")
+		return nil
+	}
+
+	references := flattenServiceEndpointShareReferences(d, endpoint)
+	if len(references) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(endpoint.Id.String())
+	d.Set("project_reference", references)
+	return nil
+}
+
+func resourceServiceEndpointShareUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if d.HasChange("project_reference") {
+		oldReferences, newReferences := d.GetChange("project_reference")
+
+		var removedProjectIDs []string
+		newProjectIDs := serviceEndpointShareProjectIDs(newReferences.(*schema.Set).List())
+		for projectID := range serviceEndpointShareProjectIDs(oldReferences.(*schema.Set).List()) {
+			if _, ok := newProjectIDs[projectID]; !ok {
+				removedProjectIDs = append(removedProjectIDs, projectID)
+			}
+		}
+
+		endpoint, err := getSharedServiceEndpoint(d, clients)
+		if err != nil {
+			return err
+		}
+		if endpoint == nil {
+			return fmt.Errorf(" Service endpoint %s not found in project %s", d.Id(), d.Get("project_id").(string))
+		}
+
+		if len(removedProjectIDs) > 0 {
+			if err := unshareServiceEndpoint(clients, endpoint.Id, removedProjectIDs); err != nil {
+				return err
+			}
+		}
+
+		if err := shareServiceEndpoint(clients, endpoint, newReferences.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceEndpointShareRead(d, m)
+}
+
+func resourceServiceEndpointShareDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	endpointID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing the service endpoint ID: %+v", err)
+	}
+
+	var projectIDs []string
+	for projectID := range serviceEndpointShareProjectIDs(d.Get("project_reference").(*schema.Set).List()) {
+		projectIDs = append(projectIDs, projectID)
+	}
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	if err := unshareServiceEndpoint(clients, &endpointID, projectIDs); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSharedServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient) (*serviceendpoint.ServiceEndpoint, error) {
+	endpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf(" parsing the service endpoint ID: %+v", err)
+	}
+
+	endpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: &endpointID,
+		Project:    converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(" looking up service endpoint given ID (%s) and project ID (%s): %+v", endpointID, d.Get("project_id").(string), err)
+	}
+	if endpoint == nil || endpoint.Id == nil {
+		return nil, nil
+	}
+	return endpoint, nil
+}
+
+func shareServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, references []interface{}) error {
+	projectReferences := expandServiceEndpointShareReferences(endpoint, references)
+	err := clients.ServiceEndpointClient.ShareServiceEndpoint(clients.Ctx, serviceendpoint.ShareServiceEndpointArgs{
+		EndpointId:                endpoint.Id,
+		EndpointProjectReferences: &projectReferences,
+	})
+	if err != nil {
+		return fmt.Errorf(" sharing service endpoint %s: %+v", endpoint.Id, err)
+	}
+	return nil
+}
+
+// unshareServiceEndpoint removes the service endpoint from the given projects only, the endpoint
+// stays available in the owning project.
+func unshareServiceEndpoint(clients *client.AggregatedClient, endpointID *uuid.UUID, projectIDs []string) error {
+	err := clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: endpointID,
+		ProjectIds: &projectIDs,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing service endpoint %s from projects %s: %+v", endpointID, strings.Join(projectIDs, ","), err)
+	}
+	return nil
+}
+
+func expandServiceEndpointShareReferences(endpoint *serviceendpoint.ServiceEndpoint, references []interface{}) []serviceendpoint.ServiceEndpointProjectReference {
+	projectReferences := make([]serviceendpoint.ServiceEndpointProjectReference, 0, len(references))
+	for _, raw := range references {
+		reference := raw.(map[string]interface{})
+		projectID := uuid.MustParse(reference["project_id"].(string))
+
+		name := endpoint.Name
+		if v := reference["service_endpoint_name"].(string); v != "" {
+			name = converter.String(v)
+		}
+		description := endpoint.Description
+		if v := reference["description"].(string); v != "" {
+			description = converter.String(v)
+		}
+
+		projectReferences = append(projectReferences, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &projectID,
+			},
+			Name:        name,
+			Description: description,
+		})
+	}
+	return projectReferences
+}
+
+// flattenServiceEndpointShareReferences returns the project references of the endpoint that are managed by this
+// resource. References to the owning project and to projects the endpoint was shared with outside of this resource
+// are ignored. If no reference is known yet (e.g. after an import) all references to other projects are adopted.
+func flattenServiceEndpointShareReferences(d *schema.ResourceData, endpoint *serviceendpoint.ServiceEndpoint) []interface{} {
+	if endpoint.ServiceEndpointProjectReferences == nil {
+		return nil
+	}
+
+	configured := map[string]map[string]interface{}{}
+	for _, raw := range d.Get("project_reference").(*schema.Set).List() {
+		reference := raw.(map[string]interface{})
+		configured[strings.ToLower(reference["project_id"].(string))] = reference
+	}
+
+	ownerProjectID := strings.ToLower(d.Get("project_id").(string))
+	references := []interface{}{}
+	for _, projectReference := range *endpoint.ServiceEndpointProjectReferences {
+		if projectReference.ProjectReference == nil || projectReference.ProjectReference.Id == nil {
+			continue
+		}
+		projectID := strings.ToLower(projectReference.ProjectReference.Id.String())
+		if projectID == ownerProjectID {
+			continue
+		}
+
+		current, managed := configured[projectID]
+		if !managed && len(configured) > 0 {
+			continue
+		}
+
+		name := converter.ToString(projectReference.Name, "")
+		if managed && current["service_endpoint_name"].(string) == "" && name == converter.ToString(endpoint.Name, "") {
+			name = ""
+		}
+		description := converter.ToString(projectReference.Description, "")
+		if managed && current["description"].(string) == "" && description == converter.ToString(endpoint.Description, "") {
+			description = ""
+		}
+
+		references = append(references, map[string]interface{}{
+			"project_id":            projectReference.ProjectReference.Id.String(),
+			"service_endpoint_name": name,
+			"description":           description,
+		})
+	}
+	return references
+}
+
+func serviceEndpointShareProjectIDs(references []interface{}) map[string]struct{} {
+	projectIDs := map[string]struct{}{}
+	for _, raw := range references {
+		projectIDs[raw.(map[string]interface{})["project_id"].(string)] = struct{}{}
+	}
+	return projectIDs
+}
@@ -0,0 +1,160 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_share
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var shareTestServiceEndpointID = uuid.New()
+var shareTestOwnerProjectID = uuid.New()
+var shareTestTargetProjectID = uuid.New()
+var shareTestUnmanagedProjectID = uuid.New()
+
+var shareTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Id:          &shareTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestOwnerProjectID},
+			Name:             converter.String("UNIT_TEST_CONN_NAME"),
+			Description:      converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestTargetProjectID},
+			Name:             converter.String("UNIT_TEST_CONN_NAME"),
+			Description:      converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestUnmanagedProjectID},
+			Name:             converter.String("UNMANAGED"),
+			Description:      converter.String("UNMANAGED"),
+		},
+	},
+}
+
+func getServiceEndpointShareResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          shareTestOwnerProjectID.String(),
+		"service_endpoint_id": shareTestServiceEndpointID.String(),
+		"project_reference": []interface{}{
+			map[string]interface{}{
+				"project_id": shareTestTargetProjectID.String(),
+			},
+		},
+	})
+	resourceData.SetId(shareTestServiceEndpointID.String())
+	return resourceData
+}
+
+// verifies that only the project references owned by the resource are read back
+func TestServiceEndpointShare_Flatten_OnlyManagedReferences(t *testing.T) {
+	resourceData := getServiceEndpointShareResourceData(t)
+
+	references := flattenServiceEndpointShareReferences(resourceData, &shareTestServiceEndpoint)
+
+	require.Len(t, references, 1)
+	reference := references[0].(map[string]interface{})
+	require.Equal(t, shareTestTargetProjectID.String(), reference["project_id"])
+	require.Equal(t, "", reference["service_endpoint_name"])
+	require.Equal(t, "", reference["description"])
+}
+
+// verifies that an imported resource adopts every reference except the owning project
+func TestServiceEndpointShare_Flatten_AdoptsReferencesOnImport(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          shareTestOwnerProjectID.String(),
+		"service_endpoint_id": shareTestServiceEndpointID.String(),
+	})
+
+	references := flattenServiceEndpointShareReferences(resourceData, &shareTestServiceEndpoint)
+
+	require.Len(t, references, 2)
+	for _, raw := range references {
+		require.NotEqual(t, shareTestOwnerProjectID.String(), raw.(map[string]interface{})["project_id"])
+	}
+}
+
+// verifies that the endpoint name and description are used when not configured for the target project
+func TestServiceEndpointShare_Expand_DefaultsToEndpointName(t *testing.T) {
+	references := expandServiceEndpointShareReferences(&shareTestServiceEndpoint, []interface{}{
+		map[string]interface{}{
+			"project_id":            shareTestTargetProjectID.String(),
+			"service_endpoint_name": "",
+			"description":           "",
+		},
+	})
+
+	require.Len(t, references, 1)
+	require.Equal(t, shareTestTargetProjectID, *references[0].ProjectReference.Id)
+	require.Equal(t, "UNIT_TEST_CONN_NAME", *references[0].Name)
+	require.Equal(t, "UNIT_TEST_CONN_DESCRIPTION", *references[0].Description)
+}
+
+// verifies that if an error is produced on share, the error is not swallowed
+func TestServiceEndpointShare_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointShare()
+	resourceData := getServiceEndpointShareResourceData(t)
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &shareTestServiceEndpointID,
+			Project:    converter.String(shareTestOwnerProjectID.String()),
+		}).
+		Return(&shareTestServiceEndpoint, nil).
+		Times(1)
+
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(errors.New("ShareServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "ShareServiceEndpoint() Failed")
+}
+
+// verifies that delete only removes the endpoint from the managed projects
+func TestServiceEndpointShare_Delete_OnlyManagedProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointShare()
+	resourceData := getServiceEndpointShareResourceData(t)
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: &shareTestServiceEndpointID,
+			ProjectIds: &[]string{shareTestTargetProjectID.String()},
+		}).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
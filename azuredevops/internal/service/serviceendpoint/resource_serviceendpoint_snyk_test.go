@@ -0,0 +1,356 @@
+//go:build (all || resource_serviceendpoint_snyk) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_snyk
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var snykTestServiceEndpointProjectID = uuid.New()
+
+func newSnykTestServiceEndpoint(url string, apiToken string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": apiToken,
+			},
+			Scheme: converter.String("Token"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("SnykAuth"),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &snykTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the API token cannot be read back from Azure DevOps, so each case carries the configuration providing it
+var snykTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newSnykTestServiceEndpoint("https://snyk.io", "SNYK_TEST_api_token"),
+		config: map[string]interface{}{
+			"api_token": "SNYK_TEST_api_token",
+		},
+	},
+	{
+		endpoint: newSnykTestServiceEndpoint("https://app.eu.snyk.io", "SNYK_TEST_eu_api_token"),
+		config: map[string]interface{}{
+			"api_token": "SNYK_TEST_eu_api_token",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointSnyk_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range snykTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointSnyk().Schema, tc.config)
+		flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointSnyk(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, snykTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the url is read back while the API token is kept from the configuration
+func TestServiceEndpointSnyk_Flatten_KeepsApiToken(t *testing.T) {
+	tc := snykTestCases[0]
+	ep := newSnykTestServiceEndpoint("https://app.au.snyk.io", "")
+	ep.Authorization.Parameters = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointSnyk().Schema, tc.config)
+	flattenServiceEndpointSnyk(resourceData, &ep, snykTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "https://app.au.snyk.io", resourceData.Get("url"))
+	require.Equal(t, "SNYK_TEST_api_token", resourceData.Get("api_token"))
+	require.Equal(t, "Token", resourceData.Get("authorization").(map[string]interface{})["scheme"])
+}
+
+// validates that an error is thrown if the url ends with a slash
+func TestServiceEndpointSnyk_UrlTrailingSlashIsError(t *testing.T) {
+	urlSchema := ResourceServiceEndpointSnyk().Schema["url"]
+
+	_, errors := urlSchema.ValidateFunc("https://snyk.io", "url")
+	require.Equal(t, 0, len(errors), "Url unexpectedly did not pass validation")
+
+	_, errors = urlSchema.ValidateFunc("https://snyk.io/", "url")
+	require.NotEqual(t, 0, len(errors), "Url unexpectedly passed validation")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointSnyk_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range snykTestCases {
+		r := ResourceServiceEndpointSnyk()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointSnyk_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range snykTestCases {
+		r := ResourceServiceEndpointSnyk()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(snykTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointSnyk_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range snykTestCases {
+		r := ResourceServiceEndpointSnyk()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				snykTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointSnyk_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range snykTestCases {
+		r := ResourceServiceEndpointSnyk()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that a service endpoint failing the verification after its creation is deleted again
+func TestServiceEndpointSnyk_Create_VerifyConnectionFailureDeletesEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tc := snykTestCases[0]
+	r := ResourceServiceEndpointSnyk()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"api_token":         tc.config["api_token"],
+		"verify_connection": true,
+	})
+	flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+	resourceData.SetId("")
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	createdServiceEndpoint := tc.endpoint
+	createdServiceEndpoint.IsReady = converter.Bool(true)
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(&createdServiceEndpoint, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(snykTestServiceEndpointProjectID.String()),
+		}).
+		Return(&createdServiceEndpoint, nil).
+		AnyTimes()
+	buildClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ExecuteServiceEndpointRequest() Failed")).
+		Times(1)
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			ProjectIds: &[]string{snykTestServiceEndpointProjectID.String()},
+			EndpointId: tc.endpoint.Id,
+		}).
+		Return(nil).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "ExecuteServiceEndpointRequest() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a failing verification prevents the update when verify_connection is set
+func TestServiceEndpointSnyk_Update_VerifyConnectionFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tc := snykTestCases[0]
+	r := ResourceServiceEndpointSnyk()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"api_token":         tc.config["api_token"],
+		"verify_connection": true,
+	})
+	flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ExecuteServiceEndpointRequest() Failed")).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "ExecuteServiceEndpointRequest() Failed")
+}
+
+// verifies that the update proceeds once the verification succeeds
+func TestServiceEndpointSnyk_Update_VerifyConnectionSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tc := snykTestCases[0]
+	r := ResourceServiceEndpointSnyk()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"api_token":         tc.config["api_token"],
+		"verify_connection": true,
+	})
+	flattenServiceEndpointSnyk(resourceData, &tc.endpoint, snykTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, serviceendpoint.ExecuteServiceEndpointRequestArgs{
+			ServiceEndpointRequest: &serviceendpoint.ServiceEndpointRequest{
+				DataSourceDetails: &serviceendpoint.DataSourceDetails{
+					DataSourceName: converter.String("TestConnection"),
+				},
+				ResultTransformationDetails: &serviceendpoint.ResultTransformationDetails{},
+				ServiceEndpointDetails: &serviceendpoint.ServiceEndpointDetails{
+					Data:          tc.endpoint.Data,
+					Authorization: tc.endpoint.Authorization,
+					Url:           tc.endpoint.Url,
+					Type:          tc.endpoint.Type,
+				},
+			},
+			Project:    converter.String(snykTestServiceEndpointProjectID.String()),
+			EndpointId: converter.String(tc.endpoint.Id.String()),
+		}).
+		Return(&serviceendpoint.ServiceEndpointRequestResult{StatusCode: converter.String("ok")}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
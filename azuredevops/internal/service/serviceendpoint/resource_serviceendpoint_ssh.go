@@ -34,7 +34,7 @@ func ResourceServiceEndpointSSH() *schema.Resource {
 		Type:         schema.TypeString,
 		Required:     true,
 		ValidateFunc: validation.StringIsNotEmpty,
-		Description:  "The Organization Url.",
+		Description:  "The host name or IP address of the remote machine.",
 	}
 
 	r.Schema["username"] = &schema.Schema{
@@ -46,7 +46,7 @@ func ResourceServiceEndpointSSH() *schema.Resource {
 	r.Schema["port"] = &schema.Schema{
 		Type:         schema.TypeInt,
 		Optional:     true,
-		ValidateFunc: validation.IntAtLeast(0),
+		ValidateFunc: validation.IsPortNumber,
 		Default:      22,
 	}
 
@@ -55,8 +55,11 @@ func ResourceServiceEndpointSSH() *schema.Resource {
 		Optional:     true,
 		Sensitive:    true,
 		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "The password, or the passphrase of the private key when private_key is set.",
 	}
 
+	// the private key is never returned by Azure DevOps, so the configured value is kept in the state and
+	// changes to it are only detected against the configuration
 	r.Schema["private_key"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -109,7 +112,7 @@ func resourceServiceEndpointSSHUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint)
+	updatedServiceEndpoint, err := updateServiceEndpoint(d, clients, serviceEndpoint)
 
 	if err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
@@ -157,10 +160,15 @@ func expandServiceEndpointSSH(d *schema.ResourceData) (*serviceendpoint.ServiceE
 
 func flattenServiceEndpointSSH(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
-	d.Set("host", (*serviceEndpoint.Data)["Host"])
-	if portStr, ok := (*serviceEndpoint.Data)["Port"]; ok {
-		port, _ := strconv.ParseInt(portStr, 10, 64)
-		d.Set("port", port)
+	if serviceEndpoint.Data != nil {
+		d.Set("host", (*serviceEndpoint.Data)["Host"])
+		if portStr, ok := (*serviceEndpoint.Data)["Port"]; ok {
+			if port, err := strconv.Atoi(portStr); err == nil {
+				d.Set("port", port)
+			}
+		}
+	}
+	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
+		d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
 	}
-	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
 }
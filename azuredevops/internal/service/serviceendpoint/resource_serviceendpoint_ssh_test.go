@@ -0,0 +1,197 @@
+//go:build (all || resource_serviceendpoint_ssh) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_ssh
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var sshTestServiceEndpointID = uuid.New()
+var sshTestServiceEndpointProjectID = uuid.New()
+
+var sshTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "SSH_TEST_username",
+			"password": "SSH_TEST_passphrase",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"Host":       "ssh.contoso.com",
+		"Port":       "2222",
+		"PrivateKey": "SSH_TEST_private_key",
+	},
+	Id:          &sshTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+	Type:        converter.String("ssh"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &sshTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// the secrets cannot be read back from Azure DevOps, so they are provided through the configuration
+var sshTestConfig = map[string]interface{}{
+	"password":    "SSH_TEST_passphrase",
+	"private_key": "SSH_TEST_private_key",
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointSSH_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointSSH().Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointSSH(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, sshTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, sshTestServiceEndpointProjectID, *projectID)
+	require.Equal(t, 2222, resourceData.Get("port"))
+}
+
+// verifies that the private key kept in the state is not overwritten by the masked value returned by Azure DevOps
+func TestServiceEndpointSSH_Flatten_KeepsPrivateKey(t *testing.T) {
+	serviceEndpoint := sshTestServiceEndpoint
+	serviceEndpoint.Data = &map[string]string{
+		"Host":       "ssh.contoso.com",
+		"Port":       "22",
+		"PrivateKey": "",
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointSSH().Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &serviceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "SSH_TEST_private_key", resourceData.Get("private_key"))
+	require.Equal(t, "ssh.contoso.com", resourceData.Get("host"))
+	require.Equal(t, 22, resourceData.Get("port"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointSSH_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &sshTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointSSH_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: sshTestServiceEndpoint.Id,
+		Project:    converter.String(sshTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointSSH_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: sshTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			sshTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointSSH_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, sshTestConfig)
+	flattenServiceEndpointSSH(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &sshTestServiceEndpoint,
+		EndpointId: sshTestServiceEndpoint.Id,
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
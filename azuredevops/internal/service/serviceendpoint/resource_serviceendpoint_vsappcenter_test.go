@@ -0,0 +1,228 @@
+//go:build (all || resource_serviceendpoint_vsappcenter) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_vsappcenter
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var vsAppCenterTestServiceEndpointProjectID = uuid.New()
+
+func newVsAppCenterTestServiceEndpoint(url string, apiToken string) serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	return serviceendpoint.ServiceEndpoint{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": apiToken,
+			},
+			Scheme: converter.String("Token"),
+		},
+		Id:          &id,
+		Name:        converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:        converter.String("vsmobilecenter"),
+		Url:         converter.String(url),
+		Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &vsAppCenterTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+// the API token cannot be read back from Azure DevOps, so each case carries the configuration providing it
+var vsAppCenterTestCases = []struct {
+	endpoint serviceendpoint.ServiceEndpoint
+	config   map[string]interface{}
+}{
+	{
+		endpoint: newVsAppCenterTestServiceEndpoint("https://api.appcenter.ms/v0.1", "VSAPPCENTER_TEST_api_token"),
+		config: map[string]interface{}{
+			"api_token": "VSAPPCENTER_TEST_api_token",
+		},
+	},
+	{
+		endpoint: newVsAppCenterTestServiceEndpoint("https://appcenter.contoso.com/v0.1", "VSAPPCENTER_TEST_other_api_token"),
+		config: map[string]interface{}{
+			"url":       "https://appcenter.contoso.com/v0.1",
+			"api_token": "VSAPPCENTER_TEST_other_api_token",
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointVsAppCenter_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, tc := range vsAppCenterTestCases {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointVsAppCenter().Schema, tc.config)
+		flattenServiceEndpointVsAppCenter(resourceData, &tc.endpoint, vsAppCenterTestServiceEndpointProjectID.String())
+
+		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointVsAppCenter(resourceData)
+
+		require.Nil(t, err)
+		require.Equal(t, tc.endpoint, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, vsAppCenterTestServiceEndpointProjectID, *projectID)
+	}
+}
+
+// verifies that the public App Center API is used when no url is configured
+func TestServiceEndpointVsAppCenter_Expand_DefaultUrl(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointVsAppCenter().Schema, map[string]interface{}{
+		"project_id": vsAppCenterTestServiceEndpointProjectID.String(),
+		"api_token":  "VSAPPCENTER_TEST_api_token",
+	})
+
+	serviceEndpoint, _, err := expandServiceEndpointVsAppCenter(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "https://api.appcenter.ms/v0.1", *serviceEndpoint.Url)
+	require.Equal(t, "Token", *serviceEndpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{"apitoken": "VSAPPCENTER_TEST_api_token"}, *serviceEndpoint.Authorization.Parameters)
+}
+
+// verifies that the url is read back while the API token is kept from the configuration
+func TestServiceEndpointVsAppCenter_Flatten_KeepsApiToken(t *testing.T) {
+	tc := vsAppCenterTestCases[1]
+	ep := newVsAppCenterTestServiceEndpoint("https://appcenter.fabrikam.com/v0.1", "")
+	ep.Authorization.Parameters = nil
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointVsAppCenter().Schema, tc.config)
+	flattenServiceEndpointVsAppCenter(resourceData, &ep, vsAppCenterTestServiceEndpointProjectID.String())
+
+	require.Equal(t, "https://appcenter.fabrikam.com/v0.1", resourceData.Get("url"))
+	require.Equal(t, "VSAPPCENTER_TEST_other_api_token", resourceData.Get("api_token"))
+	require.Equal(t, "Token", resourceData.Get("authorization").(map[string]interface{})["scheme"])
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointVsAppCenter_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vsAppCenterTestCases {
+		r := ResourceServiceEndpointVsAppCenter()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointVsAppCenter(resourceData, &tc.endpoint, vsAppCenterTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &tc.endpoint}
+		buildClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on read, the error is not swallowed
+func TestServiceEndpointVsAppCenter_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vsAppCenterTestCases {
+		r := ResourceServiceEndpointVsAppCenter()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointVsAppCenter(resourceData, &tc.endpoint, vsAppCenterTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: tc.endpoint.Id,
+			Project:    converter.String(vsAppCenterTestServiceEndpointProjectID.String()),
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("GetServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Read(resourceData, clients)
+		require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointVsAppCenter_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vsAppCenterTestCases {
+		r := ResourceServiceEndpointVsAppCenter()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointVsAppCenter(resourceData, &tc.endpoint, vsAppCenterTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: tc.endpoint.Id,
+			ProjectIds: &[]string{
+				vsAppCenterTestServiceEndpointProjectID.String(),
+			},
+		}
+		buildClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(errors.New("DeleteServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Delete(resourceData, clients)
+		require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+	}
+}
+
+// verifies that if an error is produced on a update, it is not swallowed
+func TestServiceEndpointVsAppCenter_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range vsAppCenterTestCases {
+		r := ResourceServiceEndpointVsAppCenter()
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+		flattenServiceEndpointVsAppCenter(resourceData, &tc.endpoint, vsAppCenterTestServiceEndpointProjectID.String())
+
+		buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+		clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+		expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &tc.endpoint,
+			EndpointId: tc.endpoint.Id,
+		}
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+	}
+}
@@ -33,6 +33,18 @@ func DataAgentPool() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"agent_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"online_agent_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"enabled_agent_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -62,11 +74,34 @@ func dataSourceAgentPoolRead(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(strconv.Itoa(*pool.Id))
 	d.Set("name", pool.Name)
-	d.Set("pool_type", *pool.PoolType)
-	d.Set("auto_provision", *pool.AutoProvision)
+	d.Set("pool_type", pool.PoolType)
+	d.Set("auto_provision", pool.AutoProvision)
 
 	if pool.AutoUpdate != nil {
 		d.Set("auto_update", *pool.AutoUpdate)
 	}
+
+	agents, err := clients.TaskAgentClient.GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+		PoolId: pool.Id,
+	})
+	if err != nil {
+		return fmt.Errorf(" reading agents of agent pool %s: %+v", poolName, err)
+	}
+
+	agentCount, onlineCount, enabledCount := 0, 0, 0
+	if agents != nil {
+		for _, agent := range *agents {
+			agentCount++
+			if agent.Status != nil && *agent.Status == taskagent.TaskAgentStatusValues.Online {
+				onlineCount++
+			}
+			if converter.ToBool(agent.Enabled, false) {
+				enabledCount++
+			}
+		}
+	}
+	d.Set("agent_count", agentCount)
+	d.Set("online_agent_count", onlineCount)
+	d.Set("enabled_agent_count", enabledCount)
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,3 +70,55 @@ func TestDataSourceAgentPool_Read_TestMultipleAgentPoolsFound(t *testing.T) {
 	err := dataSourceAgentPoolRead(resourceData, clients)
 	require.Contains(t, err.Error(), "Found multiple agent pools for name")
 }
+
+func TestDataSourceAgentPool_Read_CountsAgents(t *testing.T) {
+	name := "selfHostedPool"
+	agentPoolList := []taskagent.TaskAgentPool{{
+		Id:            converter.Int(1),
+		Name:          &name,
+		PoolType:      &taskagent.TaskAgentPoolTypeValues.Automation,
+		AutoProvision: converter.Bool(true),
+		AutoUpdate:    converter.Bool(false),
+	}}
+	online := taskagent.TaskAgentStatusValues.Online
+	offline := taskagent.TaskAgentStatusValues.Offline
+	agents := []taskagent.TaskAgent{
+		{Status: &online, Enabled: converter.Bool(true)},
+		{Status: &online, Enabled: converter.Bool(false)},
+		{Status: &offline, Enabled: converter.Bool(true)},
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.Ctx, taskagent.GetAgentPoolsArgs{
+			PoolName: &name,
+		}).
+		Return(&agentPoolList, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+			PoolId: converter.Int(1),
+		}).
+		Return(&agents, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgentPool().Schema, nil)
+	resourceData.Set("name", &name)
+	err := dataSourceAgentPoolRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "1", resourceData.Id())
+	require.True(t, resourceData.Get("auto_provision").(bool))
+	require.False(t, resourceData.Get("auto_update").(bool))
+	require.Equal(t, 3, resourceData.Get("agent_count"))
+	require.Equal(t, 2, resourceData.Get("online_agent_count"))
+	require.Equal(t, 2, resourceData.Get("enabled_agent_count"))
+}
@@ -131,6 +131,7 @@ func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error reading the agent queue resource: %+v", err)
 	}
 
+	d.Set(agentQueueName, queue.Name)
 	if queue.Pool != nil && queue.Pool.Id != nil {
 		d.Set(agentPoolID, *queue.Pool.Id)
 	}
@@ -143,3 +143,29 @@ func generateMocks(ctrl *gomock.Controller) (*azdosdkmocks.MockTaskagentClient,
 		Ctx:             context.Background(),
 	}
 }
+
+// The queue name should be read back so that imported queues are fully populated
+func TestAgentQueue_Read_SetsNameAndPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := generateResourceData(t, &agentQueueProject, nil, &agentQueueID)
+	agentClient, clients := generateMocks(ctrl)
+
+	agentClient.
+		EXPECT().
+		GetAgentQueue(clients.Ctx, taskagent.GetAgentQueueArgs{
+			QueueId: &agentQueueID,
+			Project: &agentQueueProject,
+		}).
+		Return(&taskagent.TaskAgentQueue{
+			Id:   &agentQueueID,
+			Name: &agentQueuePoolName,
+			Pool: &taskagent.TaskAgentPoolReference{Id: &agentQueuePoolID},
+		}, nil)
+
+	err := resourceAgentQueueRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, agentQueuePoolName, resourceData.Get(agentQueueName))
+	require.Equal(t, agentQueuePoolID, resourceData.Get(agentPoolID))
+}
@@ -36,6 +36,7 @@ const (
 	vgContentType       = "content_type"
 	vgEnabled           = "enabled"
 	vgExpires           = "expires"
+	vgRotationTrigger   = "rotation_trigger"
 )
 
 const (
@@ -162,6 +163,13 @@ func ResourceVariableGroup() *schema.Resource {
 					},
 				},
 			},
+			vgRotationTrigger: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -4,6 +4,21 @@
 
 package taskagent
 
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/stretchr/testify/require"
+)
+
 // The tests in this file use the mock clients in mock_client.go to mock out
 // the Azure DevOps client operations.
 
@@ -147,3 +162,53 @@ package taskagent
 //	providerDataActual, _ := json.Marshal(variableGroupParams.ProviderData)
 //	require.Equal(t, providerDataExpected, providerDataActual)
 //}
+
+// verifies that changing rotation_trigger updates the variable group in place and sends the secret values again
+func TestVariableGroup_Update_RotationTriggerResendsSecrets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceVariableGroup()
+	projectID := uuid.New().String()
+	rawConfig := func(version string) map[string]interface{} {
+		return map[string]interface{}{
+			vgProjectID:   projectID,
+			vgName:        "Name",
+			vgAllowAccess: false,
+			vgVariable: []interface{}{map[string]interface{}{
+				vgName:        "secret",
+				secretVgValue: "UNIT_TEST_SECRET",
+				vgIsSecret:    true,
+			}},
+			vgRotationTrigger: map[string]interface{}{"version": version},
+		}
+	}
+	stateData := schema.TestResourceDataRaw(t, r.Schema, rawConfig("1"))
+	stateData.SetId("100")
+	state := stateData.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig("2")), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		UpdateVariableGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.UpdateVariableGroupArgs) (*taskagent.VariableGroup, error) {
+			require.Equal(t, 100, *args.GroupId)
+			variable := (*args.VariableGroupParameters.Variables)["secret"].(taskagent.VariableValue)
+			require.Equal(t, "UNIT_TEST_SECRET", *variable.Value)
+			require.True(t, *variable.IsSecret)
+			return nil, errors.New("UpdateVariableGroup() Failed")
+		}).
+		Times(1)
+
+	err = r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateVariableGroup() Failed")
+}
@@ -0,0 +1,220 @@
+package tokens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tokens"
+)
+
+// ResourcePatToken schema and implementation for personal access token resource
+func ResourcePatToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePatTokenCreate,
+		Read:   resourcePatTokenRead,
+		Update: resourcePatTokenUpdate,
+		Delete: resourcePatTokenDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"valid_to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"all_orgs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"rotate_when_changed": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"valid_from": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourcePatTokenCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	validTo, err := expandPatTokenValidTo(d)
+	if err != nil {
+		return err
+	}
+
+	result, err := clients.TokensClient.CreatePat(clients.Ctx, &tokens.CreatePatArgs{
+		Token: &tokens.PatTokenCreateRequest{
+			AllOrgs:     converter.Bool(d.Get("all_orgs").(bool)),
+			DisplayName: converter.String(d.Get("display_name").(string)),
+			Scope:       converter.String(expandPatTokenScopes(d.Get("scopes").(*schema.Set))),
+			ValidTo:     validTo,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" creating personal access token: %+v", err)
+	}
+	if result == nil || result.PatToken == nil || result.PatToken.AuthorizationId == nil {
+		return fmt.Errorf(" creating personal access token: the service did not return an authorization ID")
+	}
+
+	d.SetId(result.PatToken.AuthorizationId.String())
+	d.Set("token", converter.ToString(result.PatToken.Token, ""))
+	return resourcePatTokenRead(d, m)
+}
+
+func resourcePatTokenRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	authorizationID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing personal access token authorization ID: %+v", err)
+	}
+
+	result, err := clients.TokensClient.GetPat(clients.Ctx, &tokens.GetPatArgs{
+		AuthorizationId: &authorizationID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) || patTokenWasNotFound(result) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading personal access token with authorization ID %s: %+v", d.Id(), err)
+	}
+	if result == nil || result.PatToken == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenPatToken(d, result.PatToken)
+	return nil
+}
+
+func resourcePatTokenUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	authorizationID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing personal access token authorization ID: %+v", err)
+	}
+
+	validTo, err := expandPatTokenValidTo(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.TokensClient.UpdatePat(clients.Ctx, &tokens.UpdatePatArgs{
+		Token: &tokens.PatTokenUpdateRequest{
+			AllOrgs:         converter.Bool(d.Get("all_orgs").(bool)),
+			AuthorizationId: &authorizationID,
+			DisplayName:     converter.String(d.Get("display_name").(string)),
+			Scope:           converter.String(expandPatTokenScopes(d.Get("scopes").(*schema.Set))),
+			ValidTo:         validTo,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" updating personal access token with authorization ID %s: %+v", d.Id(), err)
+	}
+
+	return resourcePatTokenRead(d, m)
+}
+
+func resourcePatTokenDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	authorizationID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing personal access token authorization ID: %+v", err)
+	}
+
+	err = clients.TokensClient.RevokePat(clients.Ctx, &tokens.RevokePatArgs{
+		AuthorizationId: &authorizationID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" revoking personal access token with authorization ID %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandPatTokenValidTo(d *schema.ResourceData) (*azuredevops.Time, error) {
+	validTo, err := time.Parse(time.RFC3339, d.Get("valid_to").(string))
+	if err != nil {
+		return nil, fmt.Errorf(" parsing valid_to: %+v", err)
+	}
+	return &azuredevops.Time{Time: validTo}, nil
+}
+
+// The service expects the scopes as a single space separated string
+func expandPatTokenScopes(scopes *schema.Set) string {
+	values := make([]string, 0, scopes.Len())
+	for _, scope := range scopes.List() {
+		values = append(values, scope.(string))
+	}
+	sort.Strings(values)
+	return strings.Join(values, " ")
+}
+
+func flattenPatToken(d *schema.ResourceData, patToken *tokens.PatToken) {
+	d.Set("display_name", converter.ToString(patToken.DisplayName, ""))
+	if patToken.Scope != nil {
+		d.Set("scopes", strings.Fields(*patToken.Scope))
+	}
+	if patToken.ValidFrom != nil {
+		d.Set("valid_from", patToken.ValidFrom.Time.Format(time.RFC3339))
+	}
+	if patToken.ValidTo != nil {
+		// keep the configured representation as long as it points to the same instant
+		configured, err := time.Parse(time.RFC3339, d.Get("valid_to").(string))
+		if err != nil || !configured.Equal(patToken.ValidTo.Time) {
+			d.Set("valid_to", patToken.ValidTo.Time.Format(time.RFC3339))
+		}
+	}
+}
+
+func patTokenWasNotFound(result *tokens.PatTokenResult) bool {
+	return result != nil && result.PatTokenError != nil &&
+		strings.EqualFold(*result.PatTokenError, tokens.PatTokenErrorAuthorizationNotFound)
+}
@@ -0,0 +1,133 @@
+//go:build (all || resource_pat_token) && !exclude_tokens
+// +build all resource_pat_token
+// +build !exclude_tokens
+
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tokens"
+	"github.com/stretchr/testify/require"
+)
+
+var patTokenAuthorizationID = uuid.New()
+
+func getPatTokenResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourcePatToken().Schema, map[string]interface{}{
+		"display_name": "terraform",
+		"scopes":       []interface{}{"vso.code", "vso.build"},
+		"valid_to":     "2030-01-01T00:00:00Z",
+	})
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestPatToken_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{TokensClient: tokensClient, Ctx: context.Background()}
+
+	expectedArgs := &tokens.CreatePatArgs{
+		Token: &tokens.PatTokenCreateRequest{
+			AllOrgs:     converter.Bool(false),
+			DisplayName: converter.String("terraform"),
+			Scope:       converter.String("vso.build vso.code"),
+			ValidTo:     &azuredevops.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	tokensClient.
+		EXPECT().
+		CreatePat(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreatePat() Failed")).
+		Times(1)
+
+	err := resourcePatTokenCreate(getPatTokenResourceData(t), clients)
+	require.Contains(t, err.Error(), "CreatePat() Failed")
+}
+
+// verifies that the token is removed from the state if the authorization no longer exists
+func TestPatToken_Read_RemovesMissingToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{TokensClient: tokensClient, Ctx: context.Background()}
+
+	resourceData := getPatTokenResourceData(t)
+	resourceData.SetId(patTokenAuthorizationID.String())
+
+	tokensClient.
+		EXPECT().
+		GetPat(clients.Ctx, &tokens.GetPatArgs{AuthorizationId: &patTokenAuthorizationID}).
+		Return(&tokens.PatTokenResult{PatTokenError: converter.String("authorizationNotFound")}, errors.New("PAT lifecycle request failed: authorizationNotFound")).
+		Times(1)
+
+	err := resourcePatTokenRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+// verifies that the token value survives a read and the configured expiration is kept for the same instant
+func TestPatToken_Read_KeepsTokenAndConfiguredValidTo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{TokensClient: tokensClient, Ctx: context.Background()}
+
+	resourceData := getPatTokenResourceData(t)
+	resourceData.SetId(patTokenAuthorizationID.String())
+	resourceData.Set("token", "secret")
+
+	tokensClient.
+		EXPECT().
+		GetPat(clients.Ctx, &tokens.GetPatArgs{AuthorizationId: &patTokenAuthorizationID}).
+		Return(&tokens.PatTokenResult{
+			PatToken: &tokens.PatToken{
+				AuthorizationId: &patTokenAuthorizationID,
+				DisplayName:     converter.String("terraform"),
+				Scope:           converter.String("vso.build vso.code"),
+				ValidTo:         &azuredevops.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		}, nil).
+		Times(1)
+
+	err := resourcePatTokenRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "secret", resourceData.Get("token"))
+	require.Equal(t, "2030-01-01T00:00:00Z", resourceData.Get("valid_to"))
+	require.Equal(t, 2, resourceData.Get("scopes").(*schema.Set).Len())
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestPatToken_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{TokensClient: tokensClient, Ctx: context.Background()}
+
+	resourceData := getPatTokenResourceData(t)
+	resourceData.SetId(patTokenAuthorizationID.String())
+
+	tokensClient.
+		EXPECT().
+		RevokePat(clients.Ctx, &tokens.RevokePatArgs{AuthorizationId: &patTokenAuthorizationID}).
+		Return(errors.New("RevokePat() Failed")).
+		Times(1)
+
+	err := resourcePatTokenDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "RevokePat() Failed")
+}
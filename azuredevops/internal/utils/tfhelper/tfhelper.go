@@ -37,6 +37,22 @@ func ParseGitRepoBranchID(id string) (string, string, error) {
 	return parseTwoPartID(id, ":", "repositoryID:branchName")
 }
 
+func ParseGitRepoTagID(id string) (string, string, error) {
+	return parseTwoPartID(id, ":", "repositoryID:tagName")
+}
+
+func ParseGitRepoPullRequestID(id string) (string, int, error) {
+	repoID, pullRequestID, err := parseTwoPartID(id, ":", "repositoryID:pullRequestID")
+	if err != nil {
+		return "", 0, err
+	}
+	parsedPullRequestID, err := strconv.Atoi(pullRequestID)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), pull request ID %s is not a number", id, pullRequestID)
+	}
+	return repoID, parsedPullRequestID, nil
+}
+
 func parseTwoPartID(id, sep, want string) (string, string, error) {
 	parts := strings.SplitN(id, sep, 2)
 	if len(parts) != 2 || strings.EqualFold(parts[0], "") || strings.EqualFold(parts[1], "") {
@@ -8,9 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/advancedsecurity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/approvalsandchecks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/audit"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/feed"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/graph"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/identity"
@@ -22,6 +25,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/servicehook"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/tokens"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk"
 )
@@ -32,6 +36,7 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_resource_authorization":                 build.ResourceResourceAuthorization(),
 			"azuredevops_pipeline_authorization":                 build.ResourcePipelineAuthorization(),
+			"azuredevops_advanced_security":                      advancedsecurity.ResourceAdvancedSecurity(),
 			"azuredevops_branch_policy_build_validation":         branch.ResourceBranchPolicyBuildValidation(),
 			"azuredevops_branch_policy_min_reviewers":            branch.ResourceBranchPolicyMinReviewers(),
 			"azuredevops_branch_policy_auto_reviewers":           branch.ResourceBranchPolicyAutoReviewers(),
@@ -58,6 +63,7 @@ func Provider() *schema.Provider {
 			"azuredevops_check_business_hours":                   approvalsandchecks.ResourceCheckBusinessHours(),
 			"azuredevops_check_required_template":                approvalsandchecks.ResourceCheckRequiredTemplate(),
 			"azuredevops_securityrole_assignment":                securityroles.ResourceSecurityRoleAssignment(),
+			"azuredevops_pat_token":                              tokens.ResourcePatToken(),
 			"azuredevops_serviceendpoint_argocd":                 serviceendpoint.ResourceServiceEndpointArgoCD(),
 			"azuredevops_serviceendpoint_artifactory":            serviceendpoint.ResourceServiceEndpointArtifactory(),
 			"azuredevops_serviceendpoint_jfrog_artifactory_v2":   serviceendpoint.ResourceServiceEndpointJFrogArtifactoryV2(),
@@ -66,7 +72,9 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_jfrog_xray_v2":          serviceendpoint.ResourceServiceEndpointJFrogXRayV2(),
 			"azuredevops_serviceendpoint_aws":                    serviceendpoint.ResourceServiceEndpointAws(),
 			"azuredevops_serviceendpoint_azurerm":                serviceendpoint.ResourceServiceEndpointAzureRM(),
+			"azuredevops_serviceendpoint_blackduck":              serviceendpoint.ResourceServiceEndpointBlackDuck(),
 			"azuredevops_serviceendpoint_bitbucket":              serviceendpoint.ResourceServiceEndpointBitBucket(),
+			"azuredevops_serviceendpoint_datadog":                serviceendpoint.ResourceServiceEndpointDatadog(),
 			"azuredevops_serviceendpoint_azuredevops":            serviceendpoint.ResourceServiceEndpointAzureDevOps(),
 			"azuredevops_serviceendpoint_dockerregistry":         serviceendpoint.ResourceServiceEndpointDockerRegistry(),
 			"azuredevops_serviceendpoint_azurecr":                serviceendpoint.ResourceServiceEndpointAzureCR(),
@@ -74,6 +82,7 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_gcp_terraform":          serviceendpoint.ResourceServiceEndpointGcp(),
 			"azuredevops_serviceendpoint_incomingwebhook":        serviceendpoint.ResourceServiceEndpointIncomingWebhook(),
 			"azuredevops_serviceendpoint_github_enterprise":      serviceendpoint.ResourceServiceEndpointGitHubEnterprise(),
+			"azuredevops_serviceendpoint_hashicorp_vault":        serviceendpoint.ResourceServiceEndpointHashiCorpVault(),
 			"azuredevops_serviceendpoint_kubernetes":             serviceendpoint.ResourceServiceEndpointKubernetes(),
 			"azuredevops_serviceendpoint_maven":                  serviceendpoint.ResourceServiceEndpointMaven(),
 			"azuredevops_serviceendpoint_nuget":                  serviceendpoint.ResourceServiceEndpointNuGet(),
@@ -82,27 +91,43 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_octopusdeploy":          serviceendpoint.ResourceServiceEndpointOctopusDeploy(),
 			"azuredevops_serviceendpoint_runpipeline":            serviceendpoint.ResourceServiceEndpointRunPipeline(),
 			"azuredevops_serviceendpoint_servicefabric":          serviceendpoint.ResourceServiceEndpointServiceFabric(),
+			"azuredevops_serviceendpoint_servicebus":             serviceendpoint.ResourceServiceEndpointServiceBus(),
 			"azuredevops_serviceendpoint_sonarqube":              serviceendpoint.ResourceServiceEndpointSonarQube(),
 			"azuredevops_serviceendpoint_sonarcloud":             serviceendpoint.ResourceServiceEndpointSonarCloud(),
+			"azuredevops_serviceendpoint_snyk":                   serviceendpoint.ResourceServiceEndpointSnyk(),
 			"azuredevops_serviceendpoint_ssh":                    serviceendpoint.ResourceServiceEndpointSSH(),
+			"azuredevops_serviceendpoint_vsappcenter":            serviceendpoint.ResourceServiceEndpointVsAppCenter(),
 			"azuredevops_serviceendpoint_npm":                    serviceendpoint.ResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_generic":                serviceendpoint.ResourceServiceEndpointGeneric(),
 			"azuredevops_serviceendpoint_generic_git":            serviceendpoint.ResourceServiceEndpointGenericGit(),
+			"azuredevops_serviceendpoint_generic_raw":            serviceendpoint.ResourceServiceEndpointGenericRaw(),
+			"azuredevops_serviceendpoint_gitlab":                 serviceendpoint.ResourceServiceEndpointGitLab(),
 			"azuredevops_serviceendpoint_externaltfs":            serviceendpoint.ResourceServiceEndpointExternalTFS(),
+			"azuredevops_serviceendpoint_share":                  serviceendpoint.ResourceServiceEndpointShare(),
 			"azuredevops_git_repository":                         git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                  git.ResourceGitRepositoryBranch(),
+			"azuredevops_git_repository_tag":                     git.ResourceGitRepositoryTag(),
+			"azuredevops_git_pull_request":                       git.ResourceGitPullRequest(),
 			"azuredevops_git_repository_file":                    git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_files":                   git.ResourceGitRepositoryFiles(),
 			"azuredevops_user_entitlement":                       memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_group_entitlement":                      memberentitlementmanagement.ResourceGroupEntitlement(),
 			"azuredevops_group_membership":                       graph.ResourceGroupMembership(),
+			"azuredevops_identity_group_membership":              identity.ResourceIdentityGroupMembership(),
+			"azuredevops_membership_propagation_wait":            graph.ResourceMembershipPropagationWait(),
 			"azuredevops_agent_pool":                             taskagent.ResourceAgentPool(),
 			"azuredevops_elastic_pool":                           taskagent.ResourceAgentPoolVMSS(),
 			"azuredevops_agent_queue":                            taskagent.ResourceAgentQueue(),
 			"azuredevops_group":                                  graph.ResourceGroup(),
+			"azuredevops_aad_group_link":                         graph.ResourceAadGroupLink(),
 			"azuredevops_project_permissions":                    permissions.ResourceProjectPermissions(),
 			"azuredevops_git_permissions":                        permissions.ResourceGitPermissions(),
 			"azuredevops_workitemquery_permissions":              permissions.ResourceWorkItemQueryPermissions(),
 			"azuredevops_area_permissions":                       permissions.ResourceAreaPermissions(),
+			"azuredevops_auditstream_azuremonitorlogs":           audit.ResourceAuditStreamAzureMonitorLogs(),
+			"azuredevops_auditstream_datadog":                    audit.ResourceAuditStreamDatadog(),
+			"azuredevops_auditstream_eventgrid":                  audit.ResourceAuditStreamEventGrid(),
+			"azuredevops_auditstream_splunk":                     audit.ResourceAuditStreamSplunk(),
 			"azuredevops_iteration_permissions":                  permissions.ResourceIterationPermissions(),
 			"azuredevops_build_definition_permissions":           permissions.ResourceBuildDefinitionPermissions(),
 			"azuredevops_build_folder_permissions":               permissions.ResourceBuildFolderPermissions(),
@@ -116,6 +141,12 @@ func Provider() *schema.Provider {
 			"azuredevops_tagging_permissions":                    permissions.ResourceTaggingPermissions(),
 			"azuredevops_environment":                            taskagent.ResourceEnvironment(),
 			"azuredevops_environment_resource_kubernetes":        taskagent.ResourceEnvironmentKubernetes(),
+			"azuredevops_feed":                                   feed.ResourceFeed(),
+			"azuredevops_feed_permission":                        feed.ResourceFeedPermission(),
+			"azuredevops_feed_permissions":                       feed.ResourceFeedPermissions(),
+			"azuredevops_feed_package_promotion":                 feed.ResourceFeedPackagePromotion(),
+			"azuredevops_feed_retention_policy":                  feed.ResourceFeedRetentionPolicy(),
+			"azuredevops_feed_view":                              feed.ResourceFeedView(),
 			"azuredevops_workitem":                               workitemtracking.ResourceWorkItem(),
 			"azuredevops_servicehook_storage_queue_pipelines":    servicehook.ResourceServicehookStorageQueuePipelines(),
 		},
@@ -125,21 +156,35 @@ func Provider() *schema.Provider {
 			"azuredevops_agent_pools":                taskagent.DataAgentPools(),
 			"azuredevops_agent_queue":                taskagent.DataAgentQueue(),
 			"azuredevops_client_config":              service.DataClientConfig(),
+			"azuredevops_deleted_feeds":              feed.DataDeletedFeeds(),
 			"azuredevops_environment":                taskagent.DataEnvironment(),
+			"azuredevops_feed":                       feed.DataFeed(),
+			"azuredevops_feeds":                      feed.DataFeeds(),
+			"azuredevops_pipeline_approvals":         approvalsandchecks.DataPipelineApprovals(),
 			"azuredevops_group":                      graph.DataGroup(),
+			"azuredevops_descriptor":                 graph.DataDescriptor(),
+			"azuredevops_service_principal":          graph.DataServicePrincipal(),
 			"azuredevops_project":                    core.DataProject(),
 			"azuredevops_projects":                   core.DataProjects(),
 			"azuredevops_git_repositories":           git.DataGitRepositories(),
+			"azuredevops_git_repositories_all":       git.DataGitRepositoriesAll(),
 			"azuredevops_git_repository":             git.DataGitRepository(),
 			"azuredevops_users":                      graph.DataUsers(),
+			"azuredevops_user_entitlement":           memberentitlementmanagement.DataUserEntitlement(),
+			"azuredevops_user_entitlements":          memberentitlementmanagement.DataUserEntitlements(),
 			"azuredevops_area":                       workitemtracking.DataArea(),
+			"azuredevops_auditstream":                audit.DataAuditStream(),
+			"azuredevops_auditstreams":               audit.DataAuditStreams(),
 			"azuredevops_iteration":                  workitemtracking.DataIteration(),
 			"azuredevops_team":                       core.DataTeam(),
 			"azuredevops_teams":                      core.DataTeams(),
 			"azuredevops_groups":                     graph.DataGroups(),
+			"azuredevops_group_entitlement":          memberentitlementmanagement.DataGroupEntitlement(),
+			"azuredevops_group_entitlement_members":  memberentitlementmanagement.DataGroupEntitlementMembers(),
 			"azuredevops_identity_groups":            identity.DataIdentityGroups(),
 			"azuredevops_identity_group":             identity.DataIdentityGroup(),
 			"azuredevops_identity_user":              identity.DataIdentityUser(),
+			"azuredevops_identities":                 identity.DataIdentities(),
 			"azuredevops_variable_group":             taskagent.DataVariableGroup(),
 			"azuredevops_securityrole_definitions":   securityroles.DataSecurityRoleDefinitions(),
 			"azuredevops_serviceendpoint_azurerm":    serviceendpoint.DataServiceEndpointAzureRM(),
@@ -34,6 +34,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_resource_authorization",
 		"azuredevops_pipeline_authorization",
+		"azuredevops_advanced_security",
 		"azuredevops_build_definition",
 		"azuredevops_build_definition_permissions",
 		"azuredevops_branch_policy_build_validation",
@@ -52,29 +53,38 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_check_business_hours",
 		"azuredevops_check_required_template",
 		"azuredevops_securityrole_assignment",
+		"azuredevops_pat_token",
 		"azuredevops_serviceendpoint_gcp_terraform",
 		"azuredevops_serviceendpoint_github",
 		"azuredevops_serviceendpoint_github_enterprise",
+		"azuredevops_serviceendpoint_hashicorp_vault",
 		"azuredevops_serviceendpoint_dockerregistry",
 		"azuredevops_serviceendpoint_azuredevops",
 		"azuredevops_serviceendpoint_azurerm",
+		"azuredevops_serviceendpoint_blackduck",
 		"azuredevops_serviceendpoint_azurecr",
 		"azuredevops_serviceendpoint_runpipeline",
 		"azuredevops_serviceendpoint_bitbucket",
+		"azuredevops_serviceendpoint_datadog",
 		"azuredevops_serviceendpoint_kubernetes",
 		"azuredevops_serviceendpoint_jenkins",
 		"azuredevops_serviceendpoint_maven",
 		"azuredevops_serviceendpoint_nexus",
 		"azuredevops_serviceendpoint_servicefabric",
+		"azuredevops_serviceendpoint_servicebus",
 		"azuredevops_serviceendpoint_argocd",
 		"azuredevops_serviceendpoint_aws",
 		"azuredevops_serviceendpoint_artifactory",
 		"azuredevops_serviceendpoint_sonarqube",
 		"azuredevops_serviceendpoint_sonarcloud",
+		"azuredevops_serviceendpoint_snyk",
 		"azuredevops_serviceendpoint_ssh",
+		"azuredevops_serviceendpoint_vsappcenter",
 		"azuredevops_serviceendpoint_npm",
 		"azuredevops_serviceendpoint_generic",
 		"azuredevops_serviceendpoint_generic_git",
+		"azuredevops_serviceendpoint_generic_raw",
+		"azuredevops_serviceendpoint_gitlab",
 		"azuredevops_serviceendpoint_octopusdeploy",
 		"azuredevops_serviceendpoint_incomingwebhook",
 		"azuredevops_serviceendpoint_jfrog_artifactory_v2",
@@ -83,6 +93,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_jfrog_xray_v2",
 		"azuredevops_serviceendpoint_externaltfs",
 		"azuredevops_serviceendpoint_nuget",
+		"azuredevops_serviceendpoint_share",
 		"azuredevops_variable_group",
 		"azuredevops_repository_policy_author_email_pattern",
 		"azuredevops_repository_policy_case_enforcement",
@@ -93,11 +104,17 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_repository_policy_check_credentials",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
+		"azuredevops_git_repository_tag",
+		"azuredevops_git_pull_request",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_files",
 		"azuredevops_user_entitlement",
 		"azuredevops_group_entitlement",
 		"azuredevops_group_membership",
+		"azuredevops_identity_group_membership",
+		"azuredevops_membership_propagation_wait",
 		"azuredevops_group",
+		"azuredevops_aad_group_link",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_queue",
 		"azuredevops_elastic_pool",
@@ -105,6 +122,10 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_git_permissions",
 		"azuredevops_workitemquery_permissions",
 		"azuredevops_area_permissions",
+		"azuredevops_auditstream_azuremonitorlogs",
+		"azuredevops_auditstream_datadog",
+		"azuredevops_auditstream_eventgrid",
+		"azuredevops_auditstream_splunk",
 		"azuredevops_iteration_permissions",
 		"azuredevops_team",
 		"azuredevops_team_members",
@@ -117,6 +138,12 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_library_permissions",
 		"azuredevops_environment",
 		"azuredevops_environment_resource_kubernetes",
+		"azuredevops_feed",
+		"azuredevops_feed_permission",
+		"azuredevops_feed_permissions",
+		"azuredevops_feed_package_promotion",
+		"azuredevops_feed_retention_policy",
+		"azuredevops_feed_view",
 		"azuredevops_build_folder",
 		"azuredevops_build_folder_permissions",
 		"azuredevops_workitem",
@@ -135,22 +162,36 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 	expectedDataSources := []string{
 		"azuredevops_build_definition",
 		"azuredevops_client_config",
+		"azuredevops_deleted_feeds",
 		"azuredevops_group",
+		"azuredevops_descriptor",
+		"azuredevops_service_principal",
 		"azuredevops_project",
 		"azuredevops_projects",
 		"azuredevops_git_repositories",
+		"azuredevops_git_repositories_all",
 		"azuredevops_git_repository",
 		"azuredevops_users",
+		"azuredevops_user_entitlement",
+		"azuredevops_user_entitlements",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
 		"azuredevops_agent_queue",
 		"azuredevops_area",
+		"azuredevops_auditstream",
+		"azuredevops_auditstreams",
 		"azuredevops_environment",
+		"azuredevops_feed",
+		"azuredevops_feeds",
+		"azuredevops_pipeline_approvals",
 		"azuredevops_iteration",
 		"azuredevops_team",
 		"azuredevops_teams",
 		"azuredevops_groups",
+		"azuredevops_group_entitlement",
+		"azuredevops_group_entitlement_members",
 		"azuredevops_identity_user",
+		"azuredevops_identities",
 		"azuredevops_identity_group",
 		"azuredevops_identity_groups",
 		"azuredevops_variable_group",
@@ -0,0 +1,175 @@
+package advancedsecurity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+// The Advanced Security management API is hosted by the advsec service and
+// is not registered with the location service, so requests are sent to fixed routes.
+const (
+	advSecApiVersion = "7.2-preview.1"
+	advSecRoute      = "_apis/management"
+)
+
+type Client interface {
+	GetProjectEnablement(ctx context.Context, args *GetProjectEnablementArgs) (*ProjectEnablementSettings, error)
+	UpdateProjectEnablement(ctx context.Context, args *UpdateProjectEnablementArgs) error
+	GetRepositoryEnablement(ctx context.Context, args *GetRepositoryEnablementArgs) (*RepositoryEnablementSettings, error)
+	UpdateRepositoryEnablement(ctx context.Context, args *UpdateRepositoryEnablementArgs) error
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	baseUrl := GetAdvancedSecurityServiceUrl(connection.BaseUrl)
+	client := connection.GetClientByUrl(baseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: baseUrl,
+	}
+}
+
+// GetAdvancedSecurityServiceUrl maps an organization URL to the URL of the Advanced Security service (advsec)
+// of that organization. URLs that do not belong to Azure DevOps Services are returned as is.
+func GetAdvancedSecurityServiceUrl(organizationUrl string) string {
+	u, err := url.Parse(strings.TrimSuffix(organizationUrl, "/"))
+	if err != nil {
+		return organizationUrl
+	}
+
+	host := strings.ToLower(u.Host)
+	switch {
+	case host == "dev.azure.com":
+		u.Host = "advsec.dev.azure.com"
+	case strings.HasSuffix(host, ".visualstudio.com") && !strings.Contains(host, ".advsec."):
+		u.Path = "/" + strings.TrimSuffix(host, ".visualstudio.com")
+		u.Host = "advsec.dev.azure.com"
+	}
+	return u.String()
+}
+
+// Arguments for the GetProjectEnablement function
+type GetProjectEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+}
+
+func (client *ClientImpl) GetProjectEnablement(ctx context.Context, args *GetProjectEnablementArgs) (*ProjectEnablementSettings, error) {
+	if args == nil || args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+
+	resp, err := client.send(ctx, http.MethodGet, url.PathEscape(*args.Project)+"/"+advSecRoute+"/enablement", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue ProjectEnablementSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateProjectEnablement function
+type UpdateProjectEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The new enablement state of the project
+	Settings *ProjectEnablementUpdate
+}
+
+func (client *ClientImpl) UpdateProjectEnablement(ctx context.Context, args *UpdateProjectEnablementArgs) error {
+	if args == nil || args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Settings == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Settings"}
+	}
+
+	_, err := client.send(ctx, http.MethodPatch, url.PathEscape(*args.Project)+"/"+advSecRoute+"/enablement", args.Settings)
+	return err
+}
+
+// Arguments for the GetRepositoryEnablement function
+type GetRepositoryEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Repository ID or repository name
+	Repository *string
+}
+
+func (client *ClientImpl) GetRepositoryEnablement(ctx context.Context, args *GetRepositoryEnablementArgs) (*RepositoryEnablementSettings, error) {
+	if args == nil || args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Repository == nil || *args.Repository == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Repository"}
+	}
+
+	route := url.PathEscape(*args.Project) + "/" + advSecRoute + "/repositories/" + url.PathEscape(*args.Repository) + "/enablement"
+	resp, err := client.send(ctx, http.MethodGet, route, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue RepositoryEnablementSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateRepositoryEnablement function
+type UpdateRepositoryEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Repository ID or repository name
+	Repository *string
+	// (required) The new enablement state of the repository
+	Settings *RepositoryEnablementUpdate
+}
+
+func (client *ClientImpl) UpdateRepositoryEnablement(ctx context.Context, args *UpdateRepositoryEnablementArgs) error {
+	if args == nil || args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Repository == nil || *args.Repository == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Repository"}
+	}
+	if args.Settings == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Settings"}
+	}
+
+	route := url.PathEscape(*args.Project) + "/" + advSecRoute + "/repositories/" + url.PathEscape(*args.Repository) + "/enablement"
+	_, err := client.send(ctx, http.MethodPatch, route, args.Settings)
+	return err
+}
+
+func (client *ClientImpl) send(ctx context.Context, httpMethod string, route string, payload interface{}) (*http.Response, error) {
+	requestUrl := client.BaseUrl + "/" + route
+
+	var body io.Reader
+	mediaType := ""
+	if payload != nil {
+		content, marshalErr := json.Marshal(payload)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		body = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	req, err := client.Client.CreateRequestMessage(ctx, httpMethod, requestUrl, advSecApiVersion, body, mediaType, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Client.SendRequest(req)
+}
@@ -0,0 +1,35 @@
+package advancedsecurity
+
+import (
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+// Advanced Security enablement state of a project
+type ProjectEnablementSettings struct {
+	AdvSecEnabled                   *bool             `json:"advSecEnabled,omitempty"`
+	AdvSecEnablementLastChangedDate *azuredevops.Time `json:"advSecEnablementLastChangedDate,omitempty"`
+	BlockPushes                     *bool             `json:"blockPushes,omitempty"`
+	EnableOnCreate                  *bool             `json:"enableOnCreate,omitempty"`
+	ProjectId                       *uuid.UUID        `json:"projectId,omitempty"`
+}
+
+type ProjectEnablementUpdate struct {
+	AdvSecEnabled  *bool `json:"advSecEnabled,omitempty"`
+	BlockPushes    *bool `json:"blockPushes,omitempty"`
+	EnableOnCreate *bool `json:"enableOnCreate,omitempty"`
+}
+
+// Advanced Security enablement state of a repository
+type RepositoryEnablementSettings struct {
+	AdvSecEnabled                   *bool             `json:"advSecEnabled,omitempty"`
+	AdvSecEnablementLastChangedDate *azuredevops.Time `json:"advSecEnablementLastChangedDate,omitempty"`
+	BlockPushes                     *bool             `json:"blockPushes,omitempty"`
+	ProjectId                       *uuid.UUID        `json:"projectId,omitempty"`
+	RepositoryId                    *uuid.UUID        `json:"repositoryId,omitempty"`
+}
+
+type RepositoryEnablementUpdate struct {
+	AdvSecEnabled *bool `json:"advSecEnabled,omitempty"`
+	BlockPushes   *bool `json:"blockPushes,omitempty"`
+}
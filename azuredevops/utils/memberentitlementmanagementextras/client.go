@@ -0,0 +1,115 @@
+// This is a partial copy of github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement/client.go
+// The existing version does not return the "continuationToken" of the user entitlement search and of the group members,
+// so only the first page can be read
+
+// This file cannot be under "internal", because azdosdkmocks/memberentitlementmanagementextras_sdk_mock.go depends on it.
+
+package memberentitlementmanagementextras
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+)
+
+var ResourceAreaId, _ = uuid.Parse("68ddce18-2501-45f1-a17b-7931a9922690")
+
+type Client interface {
+	// [Preview API] Get direct members of a Group Entitlement.
+	GetGroupMembers(context.Context, GetGroupMembersArgs) (*PagedGraphMemberList, error)
+	// [Preview API] Get a paged set of user entitlements matching the filter and sort criteria built with properties that match the select input.
+	SearchUserEntitlements(context.Context, SearchUserEntitlementsArgs) (*PagedUserEntitlements, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Get direct members of a Group Entitlement.
+func (client *ClientImpl) GetGroupMembers(ctx context.Context, args GetGroupMembersArgs) (*PagedGraphMemberList, error) {
+	routeValues := make(map[string]string)
+	if args.GroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.GroupId"}
+	}
+	routeValues["groupId"] = (*args.GroupId).String()
+
+	queryParams := url.Values{}
+	if args.MaxResults != nil {
+		queryParams.Add("maxResults", strconv.Itoa(*args.MaxResults))
+	}
+	if args.PagingToken != nil {
+		queryParams.Add("pagingToken", *args.PagingToken)
+	}
+	locationId, _ := uuid.Parse("45a36e53-5286-4518-aa72-2d29f7acc5d8")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "7.1-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue PagedGraphMemberList
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetGroupMembers function
+type GetGroupMembersArgs struct {
+	// (required) Id of the Group.
+	GroupId *uuid.UUID
+	// (optional) Maximum number of results to retrieve.
+	MaxResults *int
+	// (optional) Paging Token from the previous page fetched. If the 'pagingToken' is null, the results would be fetched from the beginning of the Members List.
+	PagingToken *string
+}
+
+// [Preview API] Get a paged set of user entitlements matching the filter and sort criteria built with properties that match the select input.
+func (client *ClientImpl) SearchUserEntitlements(ctx context.Context, args SearchUserEntitlementsArgs) (*PagedUserEntitlements, error) {
+	queryParams := url.Values{}
+	if args.ContinuationToken != nil {
+		queryParams.Add("continuationToken", *args.ContinuationToken)
+	}
+	if args.Select != nil {
+		queryParams.Add("select", string(*args.Select))
+	}
+	if args.Filter != nil {
+		queryParams.Add("$filter", *args.Filter)
+	}
+	if args.OrderBy != nil {
+		queryParams.Add("$orderBy", *args.OrderBy)
+	}
+	locationId, _ := uuid.Parse("387f832c-dbf2-4643-88e9-c1aa94dbb737")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "7.1-preview.3", nil, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue PagedUserEntitlements
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the SearchUserEntitlements function
+type SearchUserEntitlementsArgs struct {
+	// (optional) Continuation token for getting the next page of data set. If null is passed, gets the first page.
+	ContinuationToken *string
+	// (optional) Comma (",") separated list of properties to select in the result entitlements. names of the properties are - 'Projects, 'Extensions' and 'Grouprules'.
+	Select *memberentitlementmanagement.UserEntitlementProperty
+	// (optional) Equality operators relating to searching user entitlements seperated by and clauses. Valid filters include: licenseId, licenseStatus, userType, and name.
+	Filter *string
+	// (optional) PropertyName and Order (separated by a space ( )) to sort on (e.g. lastAccessed desc). Order defaults to ascending. valid properties to order by are dateCreated, lastAccessed, and name
+	OrderBy *string
+}
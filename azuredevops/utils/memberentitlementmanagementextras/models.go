@@ -0,0 +1,23 @@
+package memberentitlementmanagementextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+)
+
+// A page of the members of a group entitlement
+type PagedGraphMemberList struct {
+	// Token to request the next page, empty for the last page.
+	ContinuationToken *string `json:"continuationToken,omitempty"`
+	// The user entitlements of the page.
+	Members *[]memberentitlementmanagement.UserEntitlement `json:"members,omitempty"`
+}
+
+// A page of user entitlements
+type PagedUserEntitlements struct {
+	// Token to request the next page, empty for the last page.
+	ContinuationToken *string `json:"continuationToken,omitempty"`
+	// The user entitlements of the page.
+	Members *[]memberentitlementmanagement.UserEntitlement `json:"members,omitempty"`
+	// The total number of user entitlements matching the filter.
+	TotalCount *int `json:"totalCount,omitempty"`
+}
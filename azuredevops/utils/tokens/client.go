@@ -0,0 +1,163 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+// The PAT lifecycle management API is hosted by the identity service (vssps) and
+// is not registered with the location service, so requests are sent to fixed routes.
+const (
+	patApiVersion = "7.1-preview.1"
+	patRoute      = "_apis/tokens/pats"
+)
+
+type Client interface {
+	CreatePat(ctx context.Context, args *CreatePatArgs) (*PatTokenResult, error)
+	GetPat(ctx context.Context, args *GetPatArgs) (*PatTokenResult, error)
+	UpdatePat(ctx context.Context, args *UpdatePatArgs) (*PatTokenResult, error)
+	RevokePat(ctx context.Context, args *RevokePatArgs) error
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	baseUrl := GetIdentityServiceUrl(connection.BaseUrl)
+	client := connection.GetClientByUrl(baseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: baseUrl,
+	}
+}
+
+// GetIdentityServiceUrl maps an organization URL to the URL of the identity service (vssps)
+// of that organization. URLs that do not belong to Azure DevOps Services are returned as is.
+func GetIdentityServiceUrl(organizationUrl string) string {
+	u, err := url.Parse(strings.TrimSuffix(organizationUrl, "/"))
+	if err != nil {
+		return organizationUrl
+	}
+
+	host := strings.ToLower(u.Host)
+	switch {
+	case host == "dev.azure.com":
+		u.Host = "vssps.dev.azure.com"
+	case strings.HasSuffix(host, ".visualstudio.com") && !strings.Contains(host, ".vssps."):
+		u.Host = strings.TrimSuffix(host, ".visualstudio.com") + ".vssps.visualstudio.com"
+	}
+	return u.String()
+}
+
+// Arguments for the CreatePat function
+type CreatePatArgs struct {
+	Token *PatTokenCreateRequest
+}
+
+func (client *ClientImpl) CreatePat(ctx context.Context, args *CreatePatArgs) (*PatTokenResult, error) {
+	if args == nil || args.Token == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Token"}
+	}
+
+	return client.send(ctx, http.MethodPost, nil, args.Token)
+}
+
+// Arguments for the GetPat function
+type GetPatArgs struct {
+	AuthorizationId *uuid.UUID
+}
+
+func (client *ClientImpl) GetPat(ctx context.Context, args *GetPatArgs) (*PatTokenResult, error) {
+	if args == nil || args.AuthorizationId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.AuthorizationId"}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("authorizationId", args.AuthorizationId.String())
+	return client.send(ctx, http.MethodGet, queryParams, nil)
+}
+
+// Arguments for the UpdatePat function
+type UpdatePatArgs struct {
+	Token *PatTokenUpdateRequest
+}
+
+func (client *ClientImpl) UpdatePat(ctx context.Context, args *UpdatePatArgs) (*PatTokenResult, error) {
+	if args == nil || args.Token == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Token"}
+	}
+
+	return client.send(ctx, http.MethodPut, nil, args.Token)
+}
+
+// Arguments for the RevokePat function
+type RevokePatArgs struct {
+	AuthorizationId *uuid.UUID
+}
+
+func (client *ClientImpl) RevokePat(ctx context.Context, args *RevokePatArgs) error {
+	if args == nil || args.AuthorizationId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.AuthorizationId"}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("authorizationId", args.AuthorizationId.String())
+	requestUrl := client.BaseUrl + "/" + patRoute + "?" + queryParams.Encode()
+	req, err := client.Client.CreateRequestMessage(ctx, http.MethodDelete, requestUrl, patApiVersion, nil, "", "application/json", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Client.SendRequest(req)
+	return err
+}
+
+func (client *ClientImpl) send(ctx context.Context, httpMethod string, queryParams url.Values, payload interface{}) (*PatTokenResult, error) {
+	requestUrl := client.BaseUrl + "/" + patRoute
+	if len(queryParams) > 0 {
+		requestUrl += "?" + queryParams.Encode()
+	}
+
+	var body io.Reader
+	mediaType := ""
+	if payload != nil {
+		content, marshalErr := json.Marshal(payload)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		body = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	req, err := client.Client.CreateRequestMessage(ctx, httpMethod, requestUrl, patApiVersion, body, mediaType, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue PatTokenResult
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if responseValue.PatTokenError != nil && !strings.EqualFold(*responseValue.PatTokenError, PatTokenErrorNone) {
+		return &responseValue, fmt.Errorf("PAT lifecycle request failed: %s", *responseValue.PatTokenError)
+	}
+	return &responseValue, nil
+}
@@ -0,0 +1,42 @@
+package tokens
+
+import (
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+type PatToken struct {
+	AuthorizationId *uuid.UUID        `json:"authorizationId,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	Scope           *string           `json:"scope,omitempty"`
+	TargetAccounts  *[]uuid.UUID      `json:"targetAccounts,omitempty"`
+	Token           *string           `json:"token,omitempty"`
+	ValidFrom       *azuredevops.Time `json:"validFrom,omitempty"`
+	ValidTo         *azuredevops.Time `json:"validTo,omitempty"`
+}
+
+type PatTokenResult struct {
+	PatToken      *PatToken `json:"patToken,omitempty"`
+	PatTokenError *string   `json:"patTokenError,omitempty"`
+}
+
+type PatTokenCreateRequest struct {
+	AllOrgs     *bool             `json:"allOrgs,omitempty"`
+	DisplayName *string           `json:"displayName,omitempty"`
+	Scope       *string           `json:"scope,omitempty"`
+	ValidTo     *azuredevops.Time `json:"validTo,omitempty"`
+}
+
+type PatTokenUpdateRequest struct {
+	AllOrgs         *bool             `json:"allOrgs,omitempty"`
+	AuthorizationId *uuid.UUID        `json:"authorizationId,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	Scope           *string           `json:"scope,omitempty"`
+	ValidTo         *azuredevops.Time `json:"validTo,omitempty"`
+}
+
+// Errors reported by the service in the patTokenError field
+const (
+	PatTokenErrorNone                  = "none"
+	PatTokenErrorAuthorizationNotFound = "authorizationNotFound"
+)
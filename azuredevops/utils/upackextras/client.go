@@ -0,0 +1,91 @@
+// This is a partial copy of the Universal Packages client of github.com/microsoft/azure-devops-go-api/azuredevops
+// The upackpackaging package has no UpdatePackageVersion, so a Universal Package cannot be promoted to a view with it
+
+// This file cannot be under "internal", because azdosdkmocks/upackextras_sdk_mock.go depends on it.
+
+package upackextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/upackpackaging"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+)
+
+type Client interface {
+	// [Preview API] Update information for a package version.
+	UpdatePackageVersion(context.Context, UpdatePackageVersionArgs) error
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, upackpackaging.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Update information for a package version.
+func (client *ClientImpl) UpdatePackageVersion(ctx context.Context, args UpdatePackageVersionArgs) error {
+	if args.PackageVersionDetails == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.PackageVersionDetails"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.FeedId == nil || *args.FeedId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.FeedId"}
+	}
+	routeValues["feedId"] = *args.FeedId
+	if args.PackageName == nil || *args.PackageName == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.PackageName"}
+	}
+	routeValues["packageName"] = *args.PackageName
+	if args.PackageVersion == nil || *args.PackageVersion == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.PackageVersion"}
+	}
+	routeValues["packageVersion"] = *args.PackageVersion
+
+	body, marshalErr := json.Marshal(*args.PackageVersionDetails)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	locationId, _ := uuid.Parse("72f61ca4-e07c-4d57-8ffe-50fa09d7f9ed")
+	_, err := client.Client.Send(ctx, http.MethodPatch, locationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Arguments for the UpdatePackageVersion function
+type UpdatePackageVersionArgs struct {
+	// (required) New state to apply to the referenced package.
+	PackageVersionDetails *PackageVersionDetails
+	// (required) Name or ID of the feed.
+	FeedId *string
+	// (required) Name of the package.
+	PackageName *string
+	// (required) Version of the package.
+	PackageVersion *string
+	// (optional) Project ID or project name
+	Project *string
+}
+
+type PackageVersionDetails struct {
+	// The view to which the package version will be added
+	Views *webapi.JsonPatchOperation `json:"views,omitempty"`
+}